@@ -0,0 +1,105 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr string
+	}{
+		{name: "expression", expr: "0 1 * * *"},
+		{name: "descriptor", expr: "@daily"},
+		{name: "lists ranges and steps", expr: "0,30 9-17/2 1-15 */3 1-5"},
+		{name: "surrounding spaces", expr: "  0 1 * * *  "},
+		{name: "too few fields", expr: "0 1 * *", wantErr: `cron: expected 5 fields in "0 1 * *", found 4`},
+		{name: "too many fields", expr: "0 0 1 * * *", wantErr: `cron: expected 5 fields in "0 0 1 * * *", found 6`},
+		{name: "unknown descriptor", expr: "@every 5m", wantErr: `cron: expected 5 fields in "@every 5m", found 2`},
+		{name: "invalid value", expr: "a * * * *", wantErr: `cron: invalid value in minute field "a"`},
+		{name: "invalid range end", expr: "* 1-b * * *", wantErr: `cron: invalid value in hour field "1-b"`},
+		{name: "invalid step", expr: "*/0 * * * *", wantErr: `cron: invalid step in minute field "*/0"`},
+		{name: "minute out of range", expr: "60 * * * *", wantErr: `cron: minute field "60" out of range 0-59`},
+		{name: "day of month out of range", expr: "0 0 0 * *", wantErr: `cron: day of month field "0" out of range 1-31`},
+		{name: "month out of range", expr: "0 0 * 13 *", wantErr: `cron: month field "13" out of range 1-12`},
+		{name: "day of week out of range", expr: "0 0 * * 8", wantErr: `cron: day of week field "8" out of range 0-7`},
+		{name: "reversed range", expr: "0 5-1 * * *", wantErr: `cron: hour field "5-1" out of range 0-23`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.expr)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, got)
+		})
+	}
+}
+
+func TestSchedule_Next(t *testing.T) {
+	// a wednesday
+	now := time.Date(2022, 1, 5, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		expr string
+		now  time.Time
+		want time.Time
+	}{
+		{name: "daily report", expr: "0 1 * * *", want: time.Date(2022, 1, 6, 1, 0, 0, 0, time.UTC)},
+		{name: "weekly report", expr: "0 2 * * 1", want: time.Date(2022, 1, 10, 2, 0, 0, 0, time.UTC)},
+		{name: "monthly report", expr: "0 3 1 * *", want: time.Date(2022, 2, 1, 3, 0, 0, 0, time.UTC)},
+		{name: "strictly after a matching time", expr: "30 10 * * *", want: time.Date(2022, 1, 6, 10, 30, 0, 0, time.UTC)},
+		{
+			name: "seconds of now are dropped",
+			expr: "31 10 * * *",
+			now:  time.Date(2022, 1, 5, 10, 30, 59, 0, time.UTC),
+			want: time.Date(2022, 1, 5, 10, 31, 0, 0, time.UTC),
+		},
+		{name: "day of month or day of week, the day of week first", expr: "0 0 13 * 5", want: time.Date(2022, 1, 7, 0, 0, 0, 0, time.UTC)},
+		{name: "day of month or day of week, the day of month first", expr: "0 0 6 * 1", want: time.Date(2022, 1, 6, 0, 0, 0, 0, time.UTC)},
+		{name: "day of month and any day of week", expr: "0 0 13 * *", want: time.Date(2022, 1, 13, 0, 0, 0, 0, time.UTC)},
+		{name: "sunday as 7", expr: "0 0 * * 7", want: time.Date(2022, 1, 9, 0, 0, 0, 0, time.UTC)},
+		{name: "impossible date", expr: "0 0 30 2 *", want: time.Time{}},
+		{name: "leap day", expr: "0 0 29 2 *", want: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{name: "hourly", expr: "@hourly", want: time.Date(2022, 1, 5, 11, 0, 0, 0, time.UTC)},
+		{name: "daily", expr: "@daily", want: time.Date(2022, 1, 6, 0, 0, 0, 0, time.UTC)},
+		{name: "weekly", expr: "@weekly", want: time.Date(2022, 1, 9, 0, 0, 0, 0, time.UTC)},
+		{name: "monthly", expr: "@monthly", want: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "yearly", expr: "@yearly", want: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "step", expr: "*/15 * * * *", want: time.Date(2022, 1, 5, 10, 45, 0, 0, time.UTC)},
+		{name: "step from a value", expr: "5/20 * * * *", want: time.Date(2022, 1, 5, 10, 45, 0, 0, time.UTC)},
+		{name: "range", expr: "0 9-17 * * 1-5", want: time.Date(2022, 1, 5, 11, 0, 0, 0, time.UTC)},
+		{name: "range out of hours", expr: "0 9-10 * * 1-5", want: time.Date(2022, 1, 6, 9, 0, 0, 0, time.UTC)},
+		{name: "range with step", expr: "0 0-12/6 * * *", want: time.Date(2022, 1, 5, 12, 0, 0, 0, time.UTC)},
+		{name: "list", expr: "0 8,20 * * *", want: time.Date(2022, 1, 5, 20, 0, 0, 0, time.UTC)},
+		{name: "next month", expr: "0 0 * 3 *", want: time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{
+			name: "location of now",
+			expr: "0 1 * * *",
+			now:  time.Date(2022, 1, 5, 10, 30, 0, 0, time.FixedZone("WIB", 7*60*60)),
+			want: time.Date(2022, 1, 6, 1, 0, 0, 0, time.FixedZone("WIB", 7*60*60)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Parse(tt.expr)
+			require.NoError(t, err)
+
+			from := tt.now
+			if from.IsZero() {
+				from = now
+			}
+			got := s.Next(from)
+			assert.True(t, tt.want.Equal(got), "Next() = %v, want %v", got, tt.want)
+		})
+	}
+}
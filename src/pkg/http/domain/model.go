@@ -1,5 +1,7 @@
 package domain
 
+import "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/validation"
+
 // HTTPServerConfig, config for server to serve http connections
 type HTTPServerConfig struct {
 	Host string
@@ -11,6 +13,23 @@ type RequestModel[T any] struct {
 }
 
 type ResponseModel[T any] struct {
-	Data  *T     `json:"data,omitempty"`
-	Error string `json:"error,omitempty"`
+	Data   *T                      `json:"data,omitempty"`
+	Meta   *PageMeta               `json:"meta,omitempty"`
+	Cursor *CursorMeta             `json:"cursor,omitempty"`
+	Error  string                  `json:"error,omitempty"`
+	Errors []validation.FieldError `json:"errors,omitempty"`
+}
+
+// PageMeta, pagination details of a listing response
+type PageMeta struct {
+	Page     int   `json:"page"`
+	PageSize int   `json:"page_size"`
+	Total    int64 `json:"total"`
+}
+
+// CursorMeta, pagination details of a cursor paginated listing response, NextCursor is passed back to get the
+// next page and is empty on the last page
+type CursorMeta struct {
+	Limit      int    `json:"limit"`
+	NextCursor string `json:"next_cursor,omitempty"`
 }
@@ -0,0 +1,27 @@
+package gin
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/http/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/validation"
+)
+
+// AbortWithBindError, responds to a request that failed binding, invalid fields are listed with 422
+// while malformed bodies are rejected with 400
+func AbortWithBindError[T any](ctx *gin.Context, err error) {
+	ctx.Error(err)
+
+	if verr, ok := validation.AsError(err); ok {
+		ctx.JSON(http.StatusUnprocessableEntity, domain.ResponseModel[T]{
+			Error:  "invalid request",
+			Errors: verr.Fields,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusBadRequest, domain.ResponseModel[T]{
+		Error: "invalid body type",
+	})
+}
@@ -0,0 +1,56 @@
+package gin
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/http/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/validation"
+)
+
+func TestAbortWithBindError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode int
+		want     domain.ResponseModel[string]
+	}{
+		{
+			name:     "validation error",
+			err:      validation.NewError("name", "is required"),
+			wantCode: http.StatusUnprocessableEntity,
+			want: domain.ResponseModel[string]{
+				Error:  "invalid request",
+				Errors: []validation.FieldError{{Field: "name", Message: "is required"}},
+			},
+		},
+		{
+			name:     "malformed body",
+			err:      errors.New("unexpected EOF"),
+			wantCode: http.StatusBadRequest,
+			want: domain.ResponseModel[string]{
+				Error: "invalid body type",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(recorder)
+
+			AbortWithBindError[string](ctx, tt.err)
+
+			var response domain.ResponseModel[string]
+			json.Unmarshal(recorder.Body.Bytes(), &response)
+
+			assert.Equal(t, tt.wantCode, recorder.Code)
+			assert.Equal(t, tt.want, response)
+			assert.Len(t, ctx.Errors, 1)
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// DefaultCurrency, currency used when an amount does not specify one
+const DefaultCurrency = "IDR"
+
+// ErrCurrencyMismatch, returned when combining amounts of different currencies
+var ErrCurrencyMismatch = errors.New("currency mismatch")
+
+// exponents, number of minor unit digits of currencies not using the usual 2 digits
+var exponents = map[string]int{
+	"JPY": 0,
+	"KRW": 0,
+	"VND": 0,
+	"BHD": 3,
+	"KWD": 3,
+}
+
+// Money, an amount of money in integer minor units of its ISO 4217 currency e.g. cents,
+// stored as two columns when embedded in a gorm model
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency" gorm:"size:3"`
+}
+
+// New, constructor for an amount of minor units of currency
+func New(amount int64, currency string) Money {
+	return Money{
+		Amount:   amount,
+		Currency: strings.ToUpper(currency),
+	}
+}
+
+// FromMajor, converts an amount in major units e.g. 12.5 dollars into Money, rounding to the nearest minor unit
+func FromMajor(amount float64, currency string) Money {
+	currency = strings.ToUpper(currency)
+	return Money{
+		Amount:   int64(math.Round(amount * math.Pow10(Exponent(currency)))),
+		Currency: currency,
+	}
+}
+
+// Exponent, number of minor unit digits of currency
+func Exponent(currency string) int {
+	if exp, ok := exponents[strings.ToUpper(currency)]; ok {
+		return exp
+	}
+	return 2
+}
+
+// IsZero, reports whether the amount is zero
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// Major, the amount in major units, only meant for ratios and display, never for further arithmetic
+func (m Money) Major() float64 {
+	return float64(m.Amount) / math.Pow10(Exponent(m.Currency))
+}
+
+// Add, sums two amounts of the same currency, a zero value without currency takes the currency of the other amount
+func (m Money) Add(o Money) (Money, error) {
+	currency, err := m.commonCurrency(o)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: m.Amount + o.Amount, Currency: currency}, nil
+}
+
+// Sub, subtracts o from m, both amounts must be of the same currency
+func (m Money) Sub(o Money) (Money, error) {
+	currency, err := m.commonCurrency(o)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: m.Amount - o.Amount, Currency: currency}, nil
+}
+
+// Mul, multiplies the amount e.g. a unit price by a quantity
+func (m Money) Mul(n int64) Money {
+	return Money{Amount: m.Amount * n, Currency: m.Currency}
+}
+
+// Div, divides the amount rounding half away from zero, dividing by zero returns a zero amount
+func (m Money) Div(n int64) Money {
+	return m.MulDiv(1, n)
+}
+
+// MulDiv, multiplies the amount by num and divides it by den rounding half away from zero, e.g. to pro-rate an
+// amount. The product is never truncated to int64, dividing by zero returns a zero amount
+func (m Money) MulDiv(num, den int64) Money {
+	if den == 0 {
+		return Money{Currency: m.Currency}
+	}
+
+	p := new(big.Int).Mul(big.NewInt(m.Amount), big.NewInt(num))
+	d := big.NewInt(den)
+	q, r := new(big.Int).QuoRem(p, d, new(big.Int))
+	// QuoRem truncates towards zero, round away from zero once the remainder is at least half the divisor
+	if r.Lsh(r.Abs(r), 1).CmpAbs(d) >= 0 {
+		q.Add(q, big.NewInt(int64(p.Sign()*d.Sign())))
+	}
+	return Money{Amount: q.Int64(), Currency: m.Currency}
+}
+
+// Convert, converts the amount into currency given how many units of currency one unit of the amount's
+// currency is worth, rounding to the nearest minor unit of currency
+func (m Money) Convert(rate float64, currency string) Money {
+	currency = strings.ToUpper(currency)
+	if m.Currency == currency {
+		return m
+	}
+
+	shift := math.Pow10(Exponent(currency) - Exponent(m.Currency))
+	return Money{
+		Amount:   int64(math.Round(float64(m.Amount) * rate * shift)),
+		Currency: currency,
+	}
+}
+
+// String, formats the amount in major units e.g. IDR 1500.00
+func (m Money) String() string {
+	exp := Exponent(m.Currency)
+
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	if exp == 0 {
+		return fmt.Sprintf("%s %s%d", m.Currency, sign, amount)
+	}
+
+	unit := int64(math.Pow10(exp))
+	return fmt.Sprintf("%s %s%d.%0*d", m.Currency, sign, amount/unit, exp, amount%unit)
+}
+
+// commonCurrency, the currency of the result of combining m and o
+func (m Money) commonCurrency(o Money) (string, error) {
+	switch {
+	case m.Currency == o.Currency:
+		return m.Currency, nil
+	case m.Currency == "" && m.Amount == 0:
+		return o.Currency, nil
+	case o.Currency == "" && o.Amount == 0:
+		return m.Currency, nil
+	default:
+		return "", fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, o.Currency)
+	}
+}
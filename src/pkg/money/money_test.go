@@ -0,0 +1,191 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromMajor(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   float64
+		currency string
+		want     Money
+	}{
+		{name: "two digit currency", amount: 12.5, currency: "usd", want: New(1250, "USD")},
+		{name: "rounds half away from zero", amount: 0.125, currency: "IDR", want: New(13, "IDR")},
+		{name: "rounds down below half", amount: 1.234, currency: "IDR", want: New(123, "IDR")},
+		{name: "negative amount", amount: -0.125, currency: "IDR", want: New(-13, "IDR")},
+		{name: "zero exponent currency", amount: 1500.5, currency: "JPY", want: New(1501, "JPY")},
+		{name: "three digit currency", amount: 1.2345, currency: "KWD", want: New(1235, "KWD")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, FromMajor(tt.amount, tt.currency))
+		})
+	}
+}
+
+func TestMoney_Major(t *testing.T) {
+	tests := []struct {
+		name  string
+		money Money
+		want  float64
+	}{
+		{name: "two digit currency", money: New(150050, "IDR"), want: 1500.5},
+		{name: "negative amount", money: New(-150050, "IDR"), want: -1500.5},
+		{name: "zero exponent currency", money: New(1500, "JPY"), want: 1500},
+		{name: "three digit currency", money: New(1235, "BHD"), want: 1.235},
+		{name: "zero value", money: Money{}, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, tt.money.Major(), 1e-9)
+		})
+	}
+}
+
+func TestMoney_Add(t *testing.T) {
+	tests := []struct {
+		name    string
+		m, o    Money
+		want    Money
+		wantErr error
+	}{
+		{name: "same currency", m: New(100, "IDR"), o: New(-250, "IDR"), want: New(-150, "IDR")},
+		{name: "zero value takes the other currency", m: Money{}, o: New(100, "JPY"), want: New(100, "JPY")},
+		{name: "other zero value", m: New(100, "JPY"), o: Money{}, want: New(100, "JPY")},
+		{name: "currency mismatch", m: New(100, "IDR"), o: New(100, "USD"), wantErr: ErrCurrencyMismatch},
+		{name: "zero amount of another currency", m: New(0, "IDR"), o: New(100, "USD"), wantErr: ErrCurrencyMismatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.m.Add(tt.o)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMoney_Sub(t *testing.T) {
+	tests := []struct {
+		name    string
+		m, o    Money
+		want    Money
+		wantErr error
+	}{
+		{name: "same currency", m: New(100, "IDR"), o: New(250, "IDR"), want: New(-150, "IDR")},
+		{name: "zero value", m: Money{}, o: New(100, "JPY"), want: New(-100, "JPY")},
+		{name: "currency mismatch", m: New(100, "JPY"), o: New(100, "KRW"), wantErr: ErrCurrencyMismatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.m.Sub(tt.o)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMoney_Mul(t *testing.T) {
+	tests := []struct {
+		name  string
+		money Money
+		n     int64
+		want  Money
+	}{
+		{name: "quantity", money: New(1250, "IDR"), n: 3, want: New(3750, "IDR")},
+		{name: "negative amount", money: New(-1250, "IDR"), n: 3, want: New(-3750, "IDR")},
+		{name: "zero", money: New(1250, "JPY"), n: 0, want: New(0, "JPY")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.money.Mul(tt.n))
+		})
+	}
+}
+
+func TestMoney_Div(t *testing.T) {
+	tests := []struct {
+		name  string
+		money Money
+		n     int64
+		want  Money
+	}{
+		{name: "exact", money: New(900, "IDR"), n: 3, want: New(300, "IDR")},
+		{name: "rounds down below half", money: New(1000, "IDR"), n: 3, want: New(333, "IDR")},
+		{name: "rounds half away from zero", money: New(5, "IDR"), n: 2, want: New(3, "IDR")},
+		{name: "negative rounds half away from zero", money: New(-5, "IDR"), n: 2, want: New(-3, "IDR")},
+		{name: "negative divisor", money: New(5, "IDR"), n: -2, want: New(-3, "IDR")},
+		{name: "zero exponent currency", money: New(2000, "VND"), n: 3, want: New(667, "VND")},
+		{name: "division by zero", money: New(100, "IDR"), n: 0, want: New(0, "IDR")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.money.Div(tt.n))
+		})
+	}
+}
+
+func TestMoney_MulDiv(t *testing.T) {
+	tests := []struct {
+		name     string
+		money    Money
+		num, den int64
+		want     Money
+	}{
+		{name: "pro-rates", money: New(1000, "IDR"), num: 4500, den: 5000, want: New(900, "IDR")},
+		{name: "rounds half away from zero", money: New(3100000000, "IDR"), num: 5999999999, den: 6200000000, want: New(3000000000, "IDR")},
+		{name: "negative rounds half away from zero", money: New(-3100000000, "IDR"), num: 5999999999, den: 6200000000, want: New(-3000000000, "IDR")},
+		{name: "product beyond int64", money: New(3100000000, "IDR"), num: 3000000000, den: 3100000000, want: New(3000000000, "IDR")},
+		{name: "division by zero", money: New(100, "IDR"), num: 1, den: 0, want: New(0, "IDR")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.money.MulDiv(tt.num, tt.den))
+		})
+	}
+}
+
+func TestMoney_Convert(t *testing.T) {
+	tests := []struct {
+		name     string
+		money    Money
+		rate     float64
+		currency string
+		want     Money
+	}{
+		{name: "same currency", money: New(1000, "IDR"), rate: 2, currency: "idr", want: New(1000, "IDR")},
+		{name: "two digit currencies", money: New(100, "USD"), rate: 15000, currency: "IDR", want: New(1500000, "IDR")},
+		{name: "rounds to the nearest minor unit", money: New(150, "USD"), rate: 0.925, currency: "EUR", want: New(139, "EUR")},
+		{name: "into zero exponent currency", money: New(150, "USD"), rate: 150, currency: "JPY", want: New(225, "JPY")},
+		{name: "from zero exponent currency", money: New(1000, "JPY"), rate: 0.0067, currency: "USD", want: New(670, "USD")},
+		{name: "negative amount", money: New(-100, "USD"), rate: 0.925, currency: "EUR", want: New(-93, "EUR")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.money.Convert(tt.rate, tt.currency))
+		})
+	}
+}
+
+func TestMoney_String(t *testing.T) {
+	tests := []struct {
+		name  string
+		money Money
+		want  string
+	}{
+		{name: "two digit currency", money: New(150005, "IDR"), want: "IDR 1500.05"},
+		{name: "less than a major unit", money: New(5, "USD"), want: "USD 0.05"},
+		{name: "negative amount", money: New(-150005, "IDR"), want: "IDR -1500.05"},
+		{name: "negative less than a major unit", money: New(-5, "USD"), want: "USD -0.05"},
+		{name: "zero exponent currency", money: New(-1500, "JPY"), want: "JPY -1500"},
+		{name: "three digit currency", money: New(1005, "KWD"), want: "KWD 1.005"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.money.String())
+		})
+	}
+}
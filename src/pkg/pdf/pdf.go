@@ -0,0 +1,145 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Font, one of the standard PDF fonts every reader provides, no font file is embedded
+type Font int
+
+const (
+	Regular Font = iota
+	Bold
+	Mono
+)
+
+// fontNames, base font of every Font, in resource order F1, F2, ...
+var fontNames = []string{"Helvetica", "Helvetica-Bold", "Courier"}
+
+// A4 page size in points
+const (
+	PageWidth  = 595.28
+	PageHeight = 841.89
+)
+
+// monoCharWidth, width of every Courier glyph relative to the font size
+const monoCharWidth = 0.6
+
+// Document, a minimal PDF document of A4 pages holding text and lines. Coordinates are in points from the
+// top left corner of the page, text is positioned by its baseline
+type Document struct {
+	pages []*bytes.Buffer
+}
+
+// New, constructor for a document with a single empty page
+func New() *Document {
+	d := &Document{}
+	d.AddPage()
+	return d
+}
+
+// AddPage, appends an empty page, following drawing goes to it
+func (d *Document) AddPage() {
+	d.pages = append(d.pages, new(bytes.Buffer))
+}
+
+// Pages, number of pages of the document
+func (d *Document) Pages() int {
+	return len(d.pages)
+}
+
+// Text, draws s with font at size, characters outside of Latin-1 are replaced by '?'
+func (d *Document) Text(font Font, size, x, y float64, s string) {
+	fmt.Fprintf(d.current(), "BT /F%d %s Tf %s %s Td (%s) Tj ET\n", int(font)+1, num(size), num(x), num(PageHeight-y), escape(s))
+}
+
+// Line, draws a thin line from x1, y1 to x2, y2
+func (d *Document) Line(x1, y1, x2, y2 float64) {
+	fmt.Fprintf(d.current(), "0.5 w %s %s m %s %s l S\n", num(x1), num(PageHeight-y1), num(x2), num(PageHeight-y2))
+}
+
+// MonoWidth, width of s drawn with the Mono font at size, used to right align text
+func MonoWidth(size float64, s string) float64 {
+	return float64(utf8.RuneCountInString(s)) * monoCharWidth * size
+}
+
+// WriteTo, writes the document in PDF format
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	var (
+		buf     bytes.Buffer
+		offsets []int
+	)
+
+	object := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	// objects 1 and 2 are the catalog and the page tree, then the fonts, then a page and its content per page
+	firstPage := 3 + len(fontNames)
+	kids := make([]string, 0, len(d.pages))
+	for i := range d.pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", firstPage+2*i))
+	}
+
+	fonts := make([]string, 0, len(fontNames))
+	for i := range fontNames {
+		fonts = append(fonts, fmt.Sprintf("/F%d %d 0 R", i+1, 3+i))
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	for _, name := range fontNames {
+		object(fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", name))
+	}
+	for i, page := range d.pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /Font << %s >> >> /Contents %d 0 R >>",
+			num(PageWidth), num(PageHeight), strings.Join(fonts, " "), firstPage+2*i+1))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", page.Len(), page.String()))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return buf.WriteTo(w)
+}
+
+// current, content of the last page
+func (d *Document) current() *bytes.Buffer {
+	return d.pages[len(d.pages)-1]
+}
+
+// num, formats a coordinate or size, two decimals are far below what a printer can resolve
+func num(v float64) string {
+	return strconv.FormatFloat(math.Round(v*100)/100, 'f', -1, 64)
+}
+
+// escape, encodes s as the content of a PDF string in WinAnsiEncoding, which matches Latin-1 for printable characters
+func escape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r >= 0x20 && r < 0x7f:
+			b.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
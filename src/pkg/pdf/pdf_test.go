@@ -0,0 +1,94 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_escape(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{name: "plain", s: "Invoice INV/2022/2/0001", want: "Invoice INV/2022/2/0001"},
+		{name: "parentheses", s: "Shoes (red)", want: `Shoes \(red\)`},
+		{name: "unbalanced parenthesis", s: "a)b", want: `a\)b`},
+		{name: "backslash", s: `C:\path`, want: `C:\\path`},
+		{name: "escaped delimiters", s: `\(`, want: `\\\(`},
+		{name: "latin-1", s: "Café ©", want: `Caf\351 \251`},
+		{name: "outside latin-1", s: "Rp€ 日本", want: "Rp? ??"},
+		{name: "control characters", s: "a\nb\tc", want: "a?b?c"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, escape(tt.s))
+		})
+	}
+}
+
+func TestDocument_Text(t *testing.T) {
+	d := New()
+	d.Text(Bold, 12, 40, 100, `Total (incl. \ tax)`)
+
+	assert.Equal(t, `BT /F2 12 Tf 40 741.89 Td (Total \(incl. \\ tax\)) Tj ET`+"\n", d.current().String())
+}
+
+func TestDocument_WriteTo(t *testing.T) {
+	d := New()
+	d.Text(Regular, 10, 40, 40, "first page (1)")
+	d.Line(40, 50, 555.28, 50)
+	d.AddPage()
+	d.Text(Mono, 10, 40, 40, "second page")
+
+	var buf bytes.Buffer
+	n, err := d.WriteTo(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, int64(buf.Len()), n)
+
+	out := buf.String()
+	assert.True(t, strings.HasPrefix(out, "%PDF-1.4\n"))
+	assert.True(t, strings.HasSuffix(out, "%%EOF\n"))
+
+	// startxref points at the cross-reference table
+	m := regexp.MustCompile(`startxref\n(\d+)\n%%EOF\n$`).FindStringSubmatch(out)
+	require.Len(t, m, 2)
+	xref, err := strconv.Atoi(m[1])
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(out[xref:], "xref\n"))
+
+	// catalog, page tree, 3 fonts and a page and its content per page
+	const objects = 2 + 3 + 2*2
+	entries := strings.Split(out[xref:], "\n")
+	require.Equal(t, fmt.Sprintf("0 %d", objects+1), entries[1])
+	assert.Equal(t, "0000000000 65535 f ", entries[2])
+	for i := 1; i <= objects; i++ {
+		entry := entries[2+i]
+		require.Len(t, entry, 19, "xref entry %d", i)
+		require.True(t, strings.HasSuffix(entry, " 00000 n "), "xref entry %d", i)
+
+		offset, err := strconv.Atoi(entry[:10])
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(out[offset:], fmt.Sprintf("%d 0 obj\n", i)), "object %d not at offset %d", i, offset)
+	}
+	assert.Contains(t, out, fmt.Sprintf("trailer\n<< /Size %d /Root 1 0 R >>", objects+1))
+
+	// stream lengths match their content
+	for _, m := range regexp.MustCompile(`<< /Length (\d+) >>\nstream\n`).FindAllStringSubmatchIndex(out, -1) {
+		length, err := strconv.Atoi(out[m[2]:m[3]])
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(out[m[1]+length:], "endstream"), "stream at %d", m[1])
+	}
+}
+
+func TestMonoWidth(t *testing.T) {
+	assert.InDelta(t, 30.0, MonoWidth(10, "Rp 10"), 1e-9)
+	assert.InDelta(t, 12.0, MonoWidth(10, "é€"), 1e-9)
+}
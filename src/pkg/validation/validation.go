@@ -0,0 +1,141 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// FieldError, describes why a single request field is invalid
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Error, a validation error carrying every invalid field of a request
+type Error struct {
+	Fields []FieldError
+}
+
+// NewError, constructor for a validation error with an initial invalid field
+func NewError(field, message string) *Error {
+	return &Error{
+		Fields: []FieldError{{Field: field, Message: message}},
+	}
+}
+
+// Add, appends an invalid field to the validation error
+func (e *Error) Add(field, message string) {
+	e.Fields = append(e.Fields, FieldError{Field: field, Message: message})
+}
+
+// Error, implements error interface
+func (e *Error) Error() string {
+	msgs := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		msgs = append(msgs, f.Field+" "+f.Message)
+	}
+	return "validation failed: " + strings.Join(msgs, ", ")
+}
+
+// AsError, unwraps err into a validation error, this covers both errors returned from
+// gin binding and errors returned as *Error by usecases
+func AsError(err error) (*Error, bool) {
+	var verr *Error
+	if errors.As(err, &verr) {
+		return verr, true
+	}
+
+	var bindErrs validator.ValidationErrors
+	if !errors.As(err, &bindErrs) {
+		return nil, false
+	}
+
+	verr = &Error{}
+	for _, fe := range bindErrs {
+		verr.Add(fieldName(fe), message(fe))
+	}
+	return verr, true
+}
+
+// UseJSONFieldNames, makes gin's validator report fields by their json name, or form name for query
+// parameters, instead of the go field name
+func UseJSONFieldNames() {
+	v, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return
+	}
+
+	v.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		for _, tag := range []string{"json", "form"} {
+			name := strings.SplitN(fld.Tag.Get(tag), ",", 2)[0]
+			if name == "-" {
+				return ""
+			}
+			if name != "" {
+				return name
+			}
+		}
+		return fld.Name
+	})
+}
+
+// fieldName, strips the top level struct name from the field namespace e.g. Request.products[0].product_id
+func fieldName(fe validator.FieldError) string {
+	ns := fe.Namespace()
+	if idx := strings.Index(ns, "."); idx >= 0 {
+		return ns[idx+1:]
+	}
+	return ns
+}
+
+// message, human readable message of a failed validation tag
+func message(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "min":
+		switch fe.Kind() {
+		case reflect.Slice, reflect.Map:
+			return fmt.Sprintf("must contain at least %s item(s)", fe.Param())
+		case reflect.String:
+			return fmt.Sprintf("must be at least %s character(s) long", fe.Param())
+		}
+		return fmt.Sprintf("must be at least %s", fe.Param())
+	case "max":
+		switch fe.Kind() {
+		case reflect.Slice, reflect.Map:
+			return fmt.Sprintf("must contain at most %s item(s)", fe.Param())
+		case reflect.String:
+			return fmt.Sprintf("must be at most %s character(s) long", fe.Param())
+		}
+		return fmt.Sprintf("must be at most %s", fe.Param())
+	case "len":
+		if fe.Kind() == reflect.String {
+			return fmt.Sprintf("must be exactly %s character(s) long", fe.Param())
+		}
+		return fmt.Sprintf("must contain exactly %s item(s)", fe.Param())
+	case "alpha":
+		return "must only contain letters"
+	case "gt":
+		return fmt.Sprintf("must be greater than %s", fe.Param())
+	case "gte":
+		return fmt.Sprintf("must be greater than or equal to %s", fe.Param())
+	case "lte":
+		return fmt.Sprintf("must be less than or equal to %s", fe.Param())
+	case "oneof":
+		return fmt.Sprintf("must be one of [%s]", fe.Param())
+	case "email":
+		return "must be a valid email"
+	case "url":
+		return "must be a valid url"
+	case "datetime":
+		return fmt.Sprintf("must be formatted as %s", fe.Param())
+	default:
+		return fmt.Sprintf("failed on '%s' validation", fe.Tag())
+	}
+}
@@ -0,0 +1,102 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type item struct {
+	ProductID int64 `json:"product_id" binding:"gt=0"`
+}
+
+type request struct {
+	Name     string   `json:"name" binding:"omitempty,min=3,max=5,alpha"`
+	Code     string   `json:"code" binding:"omitempty,len=3"`
+	Qty      int      `json:"qty" binding:"omitempty,min=1,max=10"`
+	Tags     []string `json:"tags" binding:"omitempty,min=1,max=2"`
+	Pair     []int    `json:"pair" binding:"omitempty,len=2"`
+	Rating   int      `json:"rating" binding:"omitempty,gte=1,lte=5"`
+	Status   string   `json:"status" binding:"omitempty,oneof=new completed"`
+	Email    string   `json:"email" binding:"omitempty,email"`
+	Image    string   `json:"image" binding:"omitempty,url"`
+	Date     string   `form:"date" binding:"omitempty,datetime=2006-01-02"`
+	Phone    string   `json:"phone" binding:"omitempty,e164"`
+	Items    []item   `json:"items" binding:"dive"`
+	Internal string   `json:"-" binding:"omitempty,min=2"`
+}
+
+type requiredRequest struct {
+	Name string `json:"name" binding:"required"`
+}
+
+func TestAsError_messages(t *testing.T) {
+	UseJSONFieldNames()
+
+	tests := []struct {
+		name string
+		req  any
+		want FieldError
+	}{
+		{name: "required", req: requiredRequest{}, want: FieldError{Field: "name", Message: "is required"}},
+		{name: "min string", req: request{Name: "ab"}, want: FieldError{Field: "name", Message: "must be at least 3 character(s) long"}},
+		{name: "max string", req: request{Name: "abcdef"}, want: FieldError{Field: "name", Message: "must be at most 5 character(s) long"}},
+		{name: "alpha", req: request{Name: "ab1"}, want: FieldError{Field: "name", Message: "must only contain letters"}},
+		{name: "len string", req: request{Code: "ab"}, want: FieldError{Field: "code", Message: "must be exactly 3 character(s) long"}},
+		{name: "min number", req: request{Qty: -1}, want: FieldError{Field: "qty", Message: "must be at least 1"}},
+		{name: "max number", req: request{Qty: 11}, want: FieldError{Field: "qty", Message: "must be at most 10"}},
+		{name: "min slice", req: request{Tags: []string{}}, want: FieldError{Field: "tags", Message: "must contain at least 1 item(s)"}},
+		{name: "max slice", req: request{Tags: []string{"a", "b", "c"}}, want: FieldError{Field: "tags", Message: "must contain at most 2 item(s)"}},
+		{name: "len slice", req: request{Pair: []int{1}}, want: FieldError{Field: "pair", Message: "must contain exactly 2 item(s)"}},
+		{name: "gt", req: request{Items: []item{{ProductID: 0}}}, want: FieldError{Field: "items[0].product_id", Message: "must be greater than 0"}},
+		{name: "gte", req: request{Rating: -1}, want: FieldError{Field: "rating", Message: "must be greater than or equal to 1"}},
+		{name: "lte", req: request{Rating: 6}, want: FieldError{Field: "rating", Message: "must be less than or equal to 5"}},
+		{name: "oneof", req: request{Status: "paid"}, want: FieldError{Field: "status", Message: "must be one of [new completed]"}},
+		{name: "email", req: request{Email: "buyer@"}, want: FieldError{Field: "email", Message: "must be a valid email"}},
+		{name: "url", req: request{Image: "not a url"}, want: FieldError{Field: "image", Message: "must be a valid url"}},
+		{name: "datetime", req: request{Date: "01/02/2022"}, want: FieldError{Field: "date", Message: "must be formatted as 2006-01-02"}},
+		{name: "other tag", req: request{Phone: "0812"}, want: FieldError{Field: "phone", Message: "failed on 'e164' validation"}},
+		{name: "field without name", req: request{Internal: "a"}, want: FieldError{Field: "Internal", Message: "must be at least 2 character(s) long"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verr, ok := AsError(binding.Validator.ValidateStruct(tt.req))
+			require.True(t, ok)
+			assert.Equal(t, []FieldError{tt.want}, verr.Fields)
+		})
+	}
+}
+
+func TestAsError(t *testing.T) {
+	verr := NewError("items[0].quantity", "exceeds the quantity left to refund, 1 left")
+
+	tests := []struct {
+		name   string
+		err    error
+		want   *Error
+		wantOk bool
+	}{
+		{name: "validation error", err: verr, want: verr, wantOk: true},
+		{name: "wrapped validation error", err: fmt.Errorf("refund: %w", verr), want: verr, wantOk: true},
+		{name: "other error", err: errors.New("mock error")},
+		{name: "nil", err: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := AsError(tt.err)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestError_Error(t *testing.T) {
+	verr := NewError("name", "is required")
+	verr.Add("items[0].product_id", "must be greater than 0")
+
+	assert.Equal(t, "validation failed: name is required, items[0].product_id must be greater than 0", verr.Error())
+}
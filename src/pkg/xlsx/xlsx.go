@@ -0,0 +1,152 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// ContentType, media type of xlsx files
+const ContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+// Cell, a string or number cell of a sheet, the zero value is an empty cell
+type Cell struct {
+	text   string
+	number float64
+	kind   cellKind
+}
+
+type cellKind int
+
+const (
+	emptyCell cellKind = iota
+	stringCell
+	numberCell
+)
+
+// String, a text cell
+func String(s string) Cell {
+	return Cell{text: s, kind: stringCell}
+}
+
+// Number, a numeric cell
+func Number(n float64) Cell {
+	return Cell{number: n, kind: numberCell}
+}
+
+// Text, the cell as text e.g. for csv, numbers in their shortest representation
+func (c Cell) Text() string {
+	if c.kind == numberCell {
+		return strconv.FormatFloat(c.number, 'f', -1, 64)
+	}
+	return c.text
+}
+
+// Workbook, a minimal xlsx workbook of a single sheet holding strings and numbers, without styles or shared
+// strings
+type Workbook struct {
+	sheet string
+	rows  [][]Cell
+}
+
+// New, constructor for a workbook with an empty sheet named sheet
+func New(sheet string) *Workbook {
+	return &Workbook{sheet: sheet}
+}
+
+// AddRow, appends a row to the sheet
+func (wb *Workbook) AddRow(cells ...Cell) {
+	wb.rows = append(wb.rows, cells)
+}
+
+// WriteTo, writes the workbook in xlsx format
+func (wb *Workbook) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: w}
+	zw := zip.NewWriter(cw)
+
+	parts := []struct {
+		name, body string
+	}{
+		{"[Content_Types].xml", xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+			`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+			`<Default Extension="xml" ContentType="application/xml"/>` +
+			`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+			`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+			`</Types>`},
+		{"_rels/.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+			`</Relationships>`},
+		{"xl/workbook.xml", xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+			`<sheets><sheet name="` + escape(wb.sheet) + `" sheetId="1" r:id="rId1"/></sheets>` +
+			`</workbook>`},
+		{"xl/_rels/workbook.xml.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+			`</Relationships>`},
+		{"xl/worksheets/sheet1.xml", wb.sheetXML()},
+	}
+	for _, part := range parts {
+		f, err := zw.Create(part.name)
+		if err != nil {
+			return cw.n, err
+		}
+		if _, err := io.WriteString(f, part.body); err != nil {
+			return cw.n, err
+		}
+	}
+
+	err := zw.Close()
+	return cw.n, err
+}
+
+// sheetXML, the worksheet part holding the rows, strings are written inline
+func (wb *Workbook) sheetXML() string {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	buf.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	for i, row := range wb.rows {
+		fmt.Fprintf(&buf, `<row r="%d">`, i+1)
+		for j, cell := range row {
+			ref := columnName(j) + strconv.Itoa(i+1)
+			switch cell.kind {
+			case stringCell:
+				fmt.Fprintf(&buf, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, ref, escape(cell.text))
+			case numberCell:
+				fmt.Fprintf(&buf, `<c r="%s"><v>%s</v></c>`, ref, cell.Text())
+			}
+		}
+		buf.WriteString(`</row>`)
+	}
+	buf.WriteString(`</sheetData></worksheet>`)
+	return buf.String()
+}
+
+// columnName, the letters of the zero based column e.g. A, Z, AA
+func columnName(column int) string {
+	name := ""
+	for column++; column > 0; column = (column - 1) / 26 {
+		name = string(rune('A'+(column-1)%26)) + name
+	}
+	return name
+}
+
+// escape, s escaped for xml text and attributes
+func escape(s string) string {
+	var buf bytes.Buffer
+	xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
+
+// countingWriter, counts the bytes written through it for WriteTo
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err
+}
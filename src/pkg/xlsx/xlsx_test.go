@@ -0,0 +1,150 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_columnName(t *testing.T) {
+	tests := []struct {
+		column int
+		want   string
+	}{
+		{column: 0, want: "A"},
+		{column: 25, want: "Z"},
+		{column: 26, want: "AA"},
+		{column: 51, want: "AZ"},
+		{column: 52, want: "BA"},
+		{column: 701, want: "ZZ"},
+		{column: 702, want: "AAA"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, columnName(tt.column))
+		})
+	}
+}
+
+func Test_escape(t *testing.T) {
+	assert.Equal(t, "a &lt;b&gt; &amp; &#34;c&#34; &#39;d&#39;", escape(`a <b> & "c" 'd'`))
+}
+
+func TestCell_Text(t *testing.T) {
+	tests := []struct {
+		name string
+		cell Cell
+		want string
+	}{
+		{name: "string", cell: String("1.50"), want: "1.50"},
+		{name: "integer", cell: Number(15), want: "15"},
+		{name: "fraction", cell: Number(1.5), want: "1.5"},
+		{name: "negative", cell: Number(-0.25), want: "-0.25"},
+		{name: "empty", cell: Cell{}, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.cell.Text())
+		})
+	}
+}
+
+// sheet, the parts of a worksheet the tests read back
+type sheet struct {
+	Rows []struct {
+		R     int `xml:"r,attr"`
+		Cells []struct {
+			R string `xml:"r,attr"`
+			T string `xml:"t,attr"`
+			V string `xml:"v"`
+			S string `xml:"is>t"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+func TestWorkbook_WriteTo(t *testing.T) {
+	wb := New(`Sales & "Orders"`)
+	wb.AddRow(String("date"), String("revenue <IDR>"), String("orders"))
+	wb.AddRow(String("2022-04-01"), Number(1500.5), Number(3))
+	wide := make([]Cell, 28)
+	wide[0] = String("first")
+	wide[25] = Number(26)
+	wide[27] = String("AB & more")
+	wb.AddRow(wide...)
+
+	var buf bytes.Buffer
+	n, err := wb.WriteTo(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, int64(buf.Len()), n)
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	parts := make(map[string][]byte)
+	var names []string
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		body, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+
+		names = append(names, f.Name)
+		parts[f.Name] = body
+		// every part must be well formed xml
+		dec := xml.NewDecoder(bytes.NewReader(body))
+		for {
+			_, err := dec.Token()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err, f.Name)
+		}
+	}
+	assert.Equal(t, []string{
+		"[Content_Types].xml",
+		"_rels/.rels",
+		"xl/workbook.xml",
+		"xl/_rels/workbook.xml.rels",
+		"xl/worksheets/sheet1.xml",
+	}, names)
+
+	var workbook struct {
+		Sheets []struct {
+			Name string `xml:"name,attr"`
+		} `xml:"sheets>sheet"`
+	}
+	require.NoError(t, xml.Unmarshal(parts["xl/workbook.xml"], &workbook))
+	require.Len(t, workbook.Sheets, 1)
+	assert.Equal(t, `Sales & "Orders"`, workbook.Sheets[0].Name)
+
+	var got sheet
+	require.NoError(t, xml.Unmarshal(parts["xl/worksheets/sheet1.xml"], &got))
+	require.Len(t, got.Rows, 3)
+
+	type cell struct{ ref, kind, value string }
+	var cells [][]cell
+	for i, row := range got.Rows {
+		assert.Equal(t, i+1, row.R)
+		var rowCells []cell
+		for _, c := range row.Cells {
+			value := c.V
+			if c.T == "inlineStr" {
+				value = c.S
+			}
+			rowCells = append(rowCells, cell{c.R, c.T, value})
+		}
+		cells = append(cells, rowCells)
+	}
+	assert.Equal(t, [][]cell{
+		{{"A1", "inlineStr", "date"}, {"B1", "inlineStr", "revenue <IDR>"}, {"C1", "inlineStr", "orders"}},
+		{{"A2", "inlineStr", "2022-04-01"}, {"B2", "", "1500.5"}, {"C2", "", "3"}},
+		// empty cells are left out
+		{{"A3", "inlineStr", "first"}, {"Z3", "", "26"}, {"AB3", "inlineStr", "AB & more"}},
+	}, cells)
+}
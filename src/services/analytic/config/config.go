@@ -5,6 +5,7 @@ import (
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
 	mhttp "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/http/domain"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/messagequeue"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
 	"go.uber.org/fx"
 )
 
@@ -14,6 +15,10 @@ var Module = fx.Provide(
 	NewDatabaseCfg,
 	NewRabbitMQCfg,
 	NewSubscriberCfg,
+	fx.Annotate(NewOrderSubscriberCfg, fx.ResultTags(`name:"orderSubscriber"`)),
+	fx.Annotate(NewAlertPublisherCfg, fx.ResultTags(`name:"alertPublisher"`)),
+	NewReportCfg,
+	NewAdminCfg,
 )
 
 type Config struct {
@@ -21,6 +26,10 @@ type Config struct {
 	Database            yugabyte.YugabyteDBConfig
 	RabbitMQ            messagequeue.RabbitMQConfig
 	StatisticSubscriber messagequeue.SubscriberConfig
+	OrderSubscriber     messagequeue.SubscriberConfig
+	AlertPublisher      messagequeue.PublisherConfig
+	Report              domain.ReportConfig
+	Admin               domain.AdminConfig
 }
 
 func NewHTTPServerCfg(cfg *Config) mhttp.HTTPServerConfig {
@@ -38,3 +47,22 @@ func NewRabbitMQCfg(cfg *Config) messagequeue.RabbitMQConfig {
 func NewSubscriberCfg(cfg *Config) messagequeue.SubscriberConfig {
 	return cfg.StatisticSubscriber
 }
+
+func NewOrderSubscriberCfg(cfg *Config) messagequeue.SubscriberConfig {
+	return cfg.OrderSubscriber
+}
+
+// NewAlertPublisherCfg, provides alert event mq publisher config to dependency injection
+func NewAlertPublisherCfg(cfg *Config) messagequeue.PublisherConfig {
+	return cfg.AlertPublisher
+}
+
+// NewReportCfg, provides scheduled report config to dependency injection
+func NewReportCfg(cfg *Config) domain.ReportConfig {
+	return cfg.Report
+}
+
+// NewAdminCfg, provides admin endpoints config to dependency injection
+func NewAdminCfg(cfg *Config) domain.AdminConfig {
+	return cfg.Admin
+}
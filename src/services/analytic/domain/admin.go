@@ -0,0 +1,7 @@
+package domain
+
+// AdminConfig, config of the admin endpoints
+type AdminConfig struct {
+	// Token, expected in the X-Admin-Token header, admin endpoints are disabled when empty
+	Token string
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	"gorm.io/datatypes"
+)
+
+// ErrAlertNotFound, returned when the alert to acknowledge does not exist
+var ErrAlertNotFound = errors.New("alert not found")
+
+const (
+	// AlertMetricCancellationRate, the day's cancellation rate spiked
+	AlertMetricCancellationRate = "cancellation_order_rate"
+	// AlertMetricNetRevenue, the day's net revenue collapsed
+	AlertMetricNetRevenue = "net_revenue"
+)
+
+const (
+	// AlertReasonThreshold, the metric crossed a fixed limit
+	AlertReasonThreshold = "threshold"
+	// AlertReasonZScore, the metric is unusually far from its trailing window
+	AlertReasonZScore = "z_score"
+)
+
+const (
+	// AnomalyWindowDays, days before the checked day its metrics are compared with
+	AnomalyWindowDays = 14
+	// AnomalyMinHistory, days of the window that must have analytic for a metric to be checked
+	AnomalyMinHistory = 7
+	// AnomalyZScore, distance in standard deviations from the window's mean making a metric anomalous
+	AnomalyZScore = 3
+	// AnomalyMinOrders, orders a day needs before its cancellation rate is checked
+	AnomalyMinOrders = 10
+	// AnomalyCancellationRateLimit, cancellation rate in percent always alerted on
+	AnomalyCancellationRateLimit = 50
+	// AnomalyRevenueDropRatio, share of the window's average revenue a day falling below is alerted on
+	AnomalyRevenueDropRatio = 0.5
+)
+
+// Alert, an anomalous metric of a seller on Date, MarketplaceSellerID for the whole marketplace. Value is the
+// metric of the day, Baseline its average over the trailing window and ZScore its distance from the baseline in
+// standard deviations, zero when the window doesn't vary. A seller gets at most one alert per metric and day
+type Alert struct {
+	yugabyte.Model
+	SellerID       int64          `json:"seller_id" gorm:"uniqueIndex:idx_alerts_seller_metric_date;index:idx_alerts_seller"`
+	Metric         string         `json:"metric" gorm:"uniqueIndex:idx_alerts_seller_metric_date"`
+	Reason         string         `json:"reason"`
+	Value          float64        `json:"value"`
+	Baseline       float64        `json:"baseline"`
+	ZScore         float64        `json:"z_score"`
+	AcknowledgedAt *time.Time     `json:"acknowledged_at"`
+	DateString     string         `json:"date" gorm:"-"`
+	Date           datatypes.Date `json:"-" gorm:"uniqueIndex:idx_alerts_seller_metric_date"`
+}
+
+// PayloadEventAlert, event published when an alert is raised
+type PayloadEventAlert struct {
+	AlertID  uint    `json:"alert_id"`
+	SellerID int64   `json:"seller_id"`
+	Metric   string  `json:"metric"`
+	Reason   string  `json:"reason"`
+	Value    float64 `json:"value"`
+	Baseline float64 `json:"baseline"`
+	ZScore   float64 `json:"z_score"`
+	Date     string  `json:"date"`
+}
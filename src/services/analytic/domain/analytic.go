@@ -2,25 +2,106 @@ package domain
 
 import (
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
 	"gorm.io/datatypes"
 )
 
 const AnalyticDateFormat = "2006-01-02"
 
+// MarketplaceSellerID, seller id of the analytic aggregated over every seller of the marketplace
+const MarketplaceSellerID int64 = 0
+
+// Analytic, daily analytic of a seller, MarketplaceSellerID holds the marketplace wide analytic.
+// RefundRate is the share of completed orders refunded at least once, NetRevenue the revenue left after refunds.
+// AverageLeadTimeHours is the average time from placing to shipping an order and OnTimeShippingRate the share
+// of shipped orders that made the shipping SLA. GrossRevenue is the revenue before voucher discounts and
+// DiscountRate the share of it vouchers took off. AverageRating is the average stars of the day's reviews,
+// RatingShares the share of reviews per rating and ReviewRate the reviews per completed order.
+// The funnel follows buyers from viewing a product to a completed order: ViewToCartRate is the share of product
+// views added to the cart, CartToOrderRate the orders placed per cart add, CheckoutRate the share of checkout
+// attempts that placed an order, attempts failing on the voucher, the stock or the insert included, and
+// OrderCompletionRate the share of placed orders completed. SalesConvertionRate is the completed orders per
+// product view.
+// UniqueBuyers, NewBuyers and ReturningBuyers count the distinct buyers who ordered that day, the ones ordering for
+// the first time and the ones who ordered before, RepeatPurchaseRate is the share of returning buyers
 type Analytic struct {
 	yugabyte.Model
-	AverageOrderValue     float64 `json:"average_order_value"`
-	SalesConvertionRate   float32 `json:"sales_conversion_rate"`
-	CancellationOrderRate float32 `json:"cancellation_order_rate"`
+	SellerID              int64       `json:"seller_id" gorm:"index:idx_analytics_seller_date"`
+	AverageOrderValue     money.Money `json:"average_order_value" gorm:"embedded;embeddedPrefix:average_order_value_"`
+	SalesConvertionRate   float32     `json:"sales_conversion_rate"`
+	CancellationOrderRate float32     `json:"cancellation_order_rate"`
+	RefundRate            float32     `json:"refund_rate"`
+	NetRevenue            money.Money `json:"net_revenue" gorm:"embedded;embeddedPrefix:net_revenue_"`
+	AverageLeadTimeHours  float32     `json:"average_lead_time_hours"`
+	OnTimeShippingRate    float32     `json:"on_time_shipping_rate"`
+	GrossRevenue          money.Money `json:"gross_revenue" gorm:"embedded;embeddedPrefix:gross_revenue_"`
+	DiscountRate          float32     `json:"discount_rate"`
+
+	AverageRating float32      `json:"average_rating"`
+	RatingShares  RatingShares `json:"rating_shares" gorm:"embedded;embeddedPrefix:rating_share_"`
+	ReviewRate    float32      `json:"review_rate"`
+
+	ViewToCartRate      float32 `json:"view_to_cart_rate"`
+	CartToOrderRate     float32 `json:"cart_to_order_rate"`
+	CheckoutRate        float32 `json:"checkout_rate"`
+	OrderCompletionRate float32 `json:"order_completion_rate"`
+
+	UniqueBuyers       int64   `json:"unique_buyers"`
+	NewBuyers          int64   `json:"new_buyers"`
+	ReturningBuyers    int64   `json:"returning_buyers"`
+	RepeatPurchaseRate float32 `json:"repeat_purchase_rate"`
 
 	DateString string         `json:"date" gorm:"-"`
-	Date       datatypes.Date `json:"-"`
+	Date       datatypes.Date `json:"-" gorm:"index:idx_analytics_seller_date"`
+}
+
+// RatingDistribution, number of reviews per rating
+type RatingDistribution struct {
+	One   int64 `json:"one"`
+	Two   int64 `json:"two"`
+	Three int64 `json:"three"`
+	Four  int64 `json:"four"`
+	Five  int64 `json:"five"`
+}
+
+// RatingShares, share of reviews per rating in percent
+type RatingShares struct {
+	One   float32 `json:"one"`
+	Two   float32 `json:"two"`
+	Three float32 `json:"three"`
+	Four  float32 `json:"four"`
+	Five  float32 `json:"five"`
 }
 
 type StatisticEvent struct {
-	TotalRevenue   float64 `json:"total_revenue"`
-	CompletedOrder int64   `json:"completed_order"`
-	CanceledOrder  int64   `json:"canceled_order"`
-	TotalOrder     int64   `json:"total_order"`
-	Date           string  `json:"date"`
+	SellerID       int64       `json:"seller_id"`
+	TotalRevenue   money.Money `json:"total_revenue"`
+	CompletedOrder int64       `json:"completed_order"`
+	CanceledOrder  int64       `json:"canceled_order"`
+	TotalOrder     int64       `json:"total_order"`
+	RefundedAmount money.Money `json:"refunded_amount"`
+	RefundedOrders int64       `json:"refunded_orders"`
+	// ShippedOrders, OnTimeShipments and ShippingLeadTime, the orders shipped that day, the ones shipped within
+	// the SLA and their summed lead time in seconds
+	ShippedOrders    int64 `json:"shipped_orders"`
+	OnTimeShipments  int64 `json:"on_time_shipments"`
+	ShippingLeadTime int64 `json:"shipping_lead_time"`
+	// DiscountAmount, what vouchers took off the revenue of the completed orders
+	DiscountAmount money.Money `json:"discount_amount"`
+	// ReviewCount, RatingSum and Ratings, the reviews written that day, their summed rating and their number per
+	// rating
+	ReviewCount int64              `json:"review_count"`
+	RatingSum   int64              `json:"rating_sum"`
+	Ratings     RatingDistribution `json:"ratings"`
+	// ProductViews, CartAdds and CheckoutsStarted, how often buyers viewed a product, added one to the cart and
+	// attempted to check out
+	ProductViews     int64 `json:"product_views"`
+	CartAdds         int64 `json:"cart_adds"`
+	CheckoutsStarted int64 `json:"checkouts_started"`
+	// UniqueBuyers, NewBuyers and ReturningBuyers, the distinct buyers who ordered that day, the ones ordering for
+	// the first time and the ones who ordered on an earlier day
+	UniqueBuyers    int64  `json:"unique_buyers"`
+	NewBuyers       int64  `json:"new_buyers"`
+	ReturningBuyers int64  `json:"returning_buyers"`
+	Date            string `json:"date"`
 }
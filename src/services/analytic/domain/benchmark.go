@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+)
+
+var (
+	// ErrSellerCategoryUnknown, returned when none of the seller's orders had a category yet
+	ErrSellerCategoryUnknown = errors.New("seller category unknown")
+	// ErrNotEnoughPeers, returned when no metric has BenchmarkMinPeers peers to compare with
+	ErrNotEnoughPeers = errors.New("not enough peers")
+)
+
+const (
+	// BenchmarkWindowDays, days up to and including today sellers are compared over
+	BenchmarkWindowDays = 30
+	// BenchmarkMinPeers, peers a metric needs before its distribution is shown, fewer could give single sellers away
+	BenchmarkMinPeers = 5
+)
+
+const (
+	// BenchmarkMetricAverageOrderValue, revenue per completed order in major units, only compared between sellers
+	// reporting in the same currency
+	BenchmarkMetricAverageOrderValue = "average_order_value"
+	// BenchmarkMetricOrderCompletionRate, share of placed orders completed in percent
+	BenchmarkMetricOrderCompletionRate = "order_completion_rate"
+	// BenchmarkMetricCancellationRate, share of placed orders canceled in percent
+	BenchmarkMetricCancellationRate = "cancellation_order_rate"
+)
+
+// SellerCategory, new orders of a seller mostly in a category, the category of a seller is the one most of its
+// orders are in and its peers are the sellers of the same category
+type SellerCategory struct {
+	yugabyte.Model
+	SellerID   int64 `gorm:"uniqueIndex:idx_seller_categories_seller_category"`
+	CategoryID int64 `gorm:"uniqueIndex:idx_seller_categories_seller_category"`
+	Orders     int64
+}
+
+// SellerSales, sales of a seller in a currency summed over the benchmark window
+type SellerSales struct {
+	SellerID        int64
+	Revenue         money.Money `gorm:"embedded;embeddedPrefix:revenue_"`
+	Orders          int64
+	CompletedOrders int64
+	CanceledOrders  int64
+}
+
+// Benchmark, where a seller sits among the anonymous peers of its category over the days From to To
+type Benchmark struct {
+	SellerID   int64             `json:"seller_id"`
+	CategoryID int64             `json:"category_id"`
+	From       string            `json:"from"`
+	To         string            `json:"to"`
+	Metrics    []MetricBenchmark `json:"metrics"`
+}
+
+// MetricBenchmark, distribution of a metric over Peers sellers and the seller's Value. PercentileRank is the
+// percentage of peers with a lower value, ties counting half, and BetterThan the percentage of peers the seller does
+// better than. The seller's figures are left out when it has no value of the metric
+type MetricBenchmark struct {
+	Metric         string   `json:"metric"`
+	Currency       string   `json:"currency,omitempty"`
+	Peers          int      `json:"peers"`
+	P25            float64  `json:"p25"`
+	P50            float64  `json:"p50"`
+	P75            float64  `json:"p75"`
+	P90            float64  `json:"p90"`
+	Value          *float64 `json:"value,omitempty"`
+	PercentileRank *float64 `json:"percentile_rank,omitempty"`
+	BetterThan     *float64 `json:"better_than,omitempty"`
+}
@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"gorm.io/datatypes"
+)
+
+const (
+	// DefaultCohortWeeks, number of cohort weeks reported when none are requested
+	DefaultCohortWeeks = 12
+	// MaxCohortWeeks, most cohort weeks reported at once
+	MaxCohortWeeks = 52
+)
+
+// OrderEvent, an order of a buyer placed on OrderDate was created, completed, cancelled or refunded. TotalRevenue
+// is the amount of the order, RefundedAmount what a refund paid back and CategoryID the category most of the
+// products of a new order belong to
+type OrderEvent struct {
+	OrderID        int64       `json:"order_id"`
+	SellerID       int64       `json:"seller_id"`
+	BuyerID        int64       `json:"buyer_id"`
+	OrderStatus    int64       `json:"order_status"`
+	TotalRevenue   money.Money `json:"total_revenue"`
+	RefundedAmount money.Money `json:"refunded_amount"`
+	OrderDate      string      `json:"order_date"`
+	CategoryID     int64       `json:"category_id"`
+}
+
+// CohortWeek, the monday starting the week of date, cohorts are identified by the week of their first order
+func CohortWeek(date time.Time) time.Time {
+	y, m, d := date.Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, date.Location())
+	return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+}
+
+// CohortBuyer, a buyer of the seller and the week of their first order, the cohort the buyer belongs to
+type CohortBuyer struct {
+	yugabyte.Model
+	SellerID int64          `gorm:"uniqueIndex:idx_cohort_buyers_seller_buyer"`
+	BuyerID  int64          `gorm:"uniqueIndex:idx_cohort_buyers_seller_buyer"`
+	Week     datatypes.Date `gorm:"index"`
+}
+
+// CohortOrder, an order of a buyer of the seller placed on Date in Week, Revenue is what the order earned once
+// completed net of its refunds, in the currency the order was paid in. Void marks a cancelled order, which counts
+// towards neither retention, revenue nor the buyer's RFM scores
+type CohortOrder struct {
+	yugabyte.Model
+	SellerID int64          `gorm:"uniqueIndex:idx_cohort_orders_seller_order"`
+	OrderID  int64          `gorm:"uniqueIndex:idx_cohort_orders_seller_order"`
+	BuyerID  int64          `gorm:"index"`
+	Week     datatypes.Date `gorm:"index"`
+	Date     datatypes.Date
+	Revenue  money.Money `gorm:"embedded;embeddedPrefix:revenue_"`
+	Void     bool
+}
+
+// CohortSize, number of buyers who placed their first order in Week
+type CohortSize struct {
+	Week   time.Time
+	Buyers int64
+}
+
+// CohortActivity, number of buyers of the cohort of CohortWeek who ordered in Week
+type CohortActivity struct {
+	CohortWeek time.Time
+	Week       time.Time
+	Buyers     int64
+}
+
+// CohortRevenue, what the orders the cohort of CohortWeek placed in Week earned in one currency
+type CohortRevenue struct {
+	CohortWeek time.Time
+	Week       time.Time
+	Revenue    money.Money `gorm:"embedded;embeddedPrefix:revenue_"`
+}
+
+// CohortMatrix, the weekly cohorts of the buyers of a seller, MarketplaceSellerID holds the marketplace wide cohorts
+type CohortMatrix struct {
+	SellerID int64    `json:"seller_id"`
+	Cohorts  []Cohort `json:"cohorts"`
+}
+
+// Cohort, the Buyers who placed their first order in the week starting on Week and how they ordered afterwards
+type Cohort struct {
+	Week    string         `json:"week"`
+	Buyers  int64          `json:"buyers"`
+	Periods []CohortPeriod `json:"periods"`
+}
+
+// CohortPeriod, a cohort Offset weeks after its first order week. ActiveBuyers ordered that week and RetentionRate
+// is their share of the cohort. Revenue is what the cohort earned that week, CumulativeRevenue since its first week
+// and LifetimeValue the cumulative revenue per buyer of the cohort, all per currency orders were paid in
+type CohortPeriod struct {
+	Offset            int           `json:"offset"`
+	ActiveBuyers      int64         `json:"active_buyers"`
+	RetentionRate     float32       `json:"retention_rate"`
+	Revenue           []money.Money `json:"revenue"`
+	CumulativeRevenue []money.Money `json:"cumulative_revenue"`
+	LifetimeValue     []money.Money `json:"lifetime_value"`
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"gorm.io/datatypes"
+)
+
+const (
+	// DefaultForecastDays, number of days forecast when none are requested
+	DefaultForecastDays = 7
+	// MaxForecastDays, most days forecast at once
+	MaxForecastDays = 30
+	// ForecastHistoryDays, number of days before today a forecast is fitted on
+	ForecastHistoryDays = 84
+)
+
+const (
+	// ForecastModelHoltWinters, additive Holt-Winters with a weekly season, used once two weeks of history exist
+	ForecastModelHoltWinters = "holt_winters"
+	// ForecastModelWeekdayAverage, average of the same weekday, used on a shorter history
+	ForecastModelWeekdayAverage = "weekday_average"
+)
+
+// DailySales, revenue, placed, completed and canceled orders of a seller on Date as last reported by the statistic
+// service, the history forecasts are fitted on, goals are tracked against and sellers are benchmarked on
+type DailySales struct {
+	yugabyte.Model
+	SellerID        int64          `gorm:"uniqueIndex:idx_daily_sales_seller_date"`
+	Date            datatypes.Date `gorm:"uniqueIndex:idx_daily_sales_seller_date"`
+	Revenue         money.Money    `gorm:"embedded;embeddedPrefix:revenue_"`
+	Orders          int64
+	CompletedOrders int64
+	CanceledOrders  int64
+}
+
+// Forecast, predicted daily revenue and orders of a seller, MarketplaceSellerID for the whole marketplace, and the
+// Model fitted on its history
+type Forecast struct {
+	SellerID int64         `json:"seller_id"`
+	Model    string        `json:"model"`
+	Days     []ForecastDay `json:"days"`
+}
+
+// ForecastDay, predicted revenue and orders of Date, the lower and upper bounds are an approximate 95% confidence band
+type ForecastDay struct {
+	Date         string      `json:"date"`
+	Revenue      money.Money `json:"revenue"`
+	RevenueLower money.Money `json:"revenue_lower"`
+	RevenueUpper money.Money `json:"revenue_upper"`
+	Orders       float64     `json:"orders"`
+	OrdersLower  float64     `json:"orders_lower"`
+	OrdersUpper  float64     `json:"orders_upper"`
+}
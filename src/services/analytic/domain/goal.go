@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+)
+
+var (
+	// ErrGoalNotFound, returned when the goal to update or delete does not exist
+	ErrGoalNotFound = errors.New("goal not found")
+	// ErrGoalExists, returned when the seller already has a goal of the metric for the period
+	ErrGoalExists = errors.New("goal already exists")
+)
+
+// GoalPeriodFormat, goals are set per calendar month
+const GoalPeriodFormat = "2006-01"
+
+const (
+	// GoalMetricRevenue, revenue of the month to reach, in major units of the seller's reporting currency
+	GoalMetricRevenue = "revenue"
+	// GoalMetricOrders, placed orders of the month to reach
+	GoalMetricOrders = "orders"
+	// GoalMetricCancellationRate, cancellation rate of the month in percent to stay at or under
+	GoalMetricCancellationRate = "cancellation_rate"
+)
+
+// GoalMetrics, metrics a goal can be set on
+var GoalMetrics = []string{
+	GoalMetricRevenue,
+	GoalMetricOrders,
+	GoalMetricCancellationRate,
+}
+
+// IsGoalMetric, whether a goal can be set on metric
+func IsGoalMetric(metric string) bool {
+	for _, m := range GoalMetrics {
+		if m == metric {
+			return true
+		}
+	}
+	return false
+}
+
+// IsCeilingGoal, whether the metric of a goal must stay at or under its target rather than reach it
+func IsCeilingGoal(metric string) bool {
+	return metric == GoalMetricCancellationRate
+}
+
+// Goal, the Target a seller, MarketplaceSellerID for the whole marketplace, sets on a Metric for the month of
+// Period formatted as GoalPeriodFormat. A seller has at most one goal per metric and month
+type Goal struct {
+	yugabyte.Model
+	SellerID int64   `json:"seller_id" gorm:"uniqueIndex:idx_goals_seller_period_metric"`
+	Period   string  `json:"period" gorm:"uniqueIndex:idx_goals_seller_period_metric"`
+	Metric   string  `json:"metric" gorm:"uniqueIndex:idx_goals_seller_period_metric"`
+	Target   float64 `json:"target"`
+}
+
+// GoalProgress, progress of a goal. Current is the metric over the elapsed days of the month and Projected its
+// value at the end of the month at the current pace, a rate is projected to stay as it is. Attainment is the
+// percentage of the target reached, for a ceiling goal 100 until the metric exceeds the target, and OnTrack whether
+// the projected value meets the target. Currency is the one of a revenue goal, the seller's reporting currency
+type GoalProgress struct {
+	Goal
+	Currency   string  `json:"currency,omitempty"`
+	Current    float64 `json:"current"`
+	Projected  float64 `json:"projected"`
+	Attainment float64 `json:"attainment"`
+	OnTrack    bool    `json:"on_track"`
+}
+
+// GoalReport, progress of the goals a seller set for the month of Period, ElapsedDays of its PeriodDays have passed
+type GoalReport struct {
+	SellerID    int64          `json:"seller_id"`
+	Period      string         `json:"period"`
+	ElapsedDays int            `json:"elapsed_days"`
+	PeriodDays  int            `json:"period_days"`
+	Goals       []GoalProgress `json:"goals"`
+}
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/xlsx"
+	"gorm.io/datatypes"
+)
+
+// ErrReportNotFound, returned when the report to download does not exist
+var ErrReportNotFound = errors.New("report not found")
+
+const (
+	// ReportPeriodDaily, report of the previous day
+	ReportPeriodDaily = "daily"
+	// ReportPeriodWeekly, report of the previous monday to sunday week
+	ReportPeriodWeekly = "weekly"
+	// ReportPeriodMonthly, report of the previous calendar month
+	ReportPeriodMonthly = "monthly"
+)
+
+// ReportPeriods, periods reports are generated for
+var ReportPeriods = []string{ReportPeriodDaily, ReportPeriodWeekly, ReportPeriodMonthly}
+
+// IsReportPeriod, reports whether period is one of ReportPeriods
+func IsReportPeriod(period string) bool {
+	for _, p := range ReportPeriods {
+		if p == period {
+			return true
+		}
+	}
+	return false
+}
+
+const (
+	ReportFormatCSV  = "csv"
+	ReportFormatXLSX = "xlsx"
+)
+
+// ReportContentType, media type of reports in format
+func ReportContentType(format string) string {
+	if format == ReportFormatXLSX {
+		return xlsx.ContentType
+	}
+	return "text/csv; charset=utf-8"
+}
+
+const (
+	// ReportSinkFilesystem, writes reports to the report directory
+	ReportSinkFilesystem = "filesystem"
+	// ReportSinkSMTP, stand-in for mailing reports, writes the mail it would send to the outbox directory
+	ReportSinkSMTP = "smtp"
+)
+
+// Report, summary of a seller's days FromDate to ToDate in Format, MarketplaceSellerID for the whole marketplace.
+// Content is only loaded to download or deliver the report. A seller gets at most one report per period, format and
+// start day. DeliveredAt is when every sink took the report, nil until then
+type Report struct {
+	yugabyte.Model
+	SellerID    int64          `json:"seller_id" gorm:"uniqueIndex:idx_reports_seller_period_format_from"`
+	Period      string         `json:"period" gorm:"uniqueIndex:idx_reports_seller_period_format_from"`
+	Format      string         `json:"format" gorm:"uniqueIndex:idx_reports_seller_period_format_from"`
+	FileName    string         `json:"file_name"`
+	Size        int64          `json:"size"`
+	Content     []byte         `json:"-"`
+	FromString  string         `json:"from" gorm:"-"`
+	ToString    string         `json:"to" gorm:"-"`
+	FromDate    datatypes.Date `json:"-" gorm:"uniqueIndex:idx_reports_seller_period_format_from"`
+	ToDate      datatypes.Date `json:"-"`
+	DeliveredAt *time.Time     `json:"delivered_at"`
+}
+
+// ReportConfig, config of the scheduled report generation
+type ReportConfig struct {
+	// Schedules, when the reports of each period are generated, reports aren't generated without any
+	Schedules []ReportSchedule
+	// Formats, formats every report is generated in, csv and xlsx when empty
+	Formats []string
+	// Sinks, where generated reports are delivered to besides being kept for download
+	Sinks []string
+	// Directory, where the filesystem sink writes reports
+	Directory string
+	SMTP      ReportSMTPConfig
+}
+
+// ReportSchedule, cron expression generating the reports of Period e.g. "0 1 * * *" for daily at 01:00
+type ReportSchedule struct {
+	Period string
+	Cron   string
+}
+
+// ReportSMTPConfig, sender and recipient of the mailed reports and the outbox the stand-in writes them to
+type ReportSMTPConfig struct {
+	From   string
+	To     string
+	Outbox string
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+)
+
+const (
+	SegmentChampions          = "champions"
+	SegmentLoyal              = "loyal"
+	SegmentNew                = "new"
+	SegmentPotentialLoyalists = "potential_loyalists"
+	SegmentAtRisk             = "at_risk"
+	SegmentHibernating        = "hibernating"
+	SegmentLost               = "lost"
+)
+
+// Segments, every RFM segment in the order they are reported
+var Segments = []string{
+	SegmentChampions,
+	SegmentLoyal,
+	SegmentNew,
+	SegmentPotentialLoyalists,
+	SegmentAtRisk,
+	SegmentHibernating,
+	SegmentLost,
+}
+
+// IsSegment, whether segment is one of Segments
+func IsSegment(segment string) bool {
+	for _, s := range Segments {
+		if s == segment {
+			return true
+		}
+	}
+	return false
+}
+
+// BuyerOrders, the Orders a buyer placed with the seller in one currency, the date of the latest one and the
+// Revenue they earned net of refunds
+type BuyerOrders struct {
+	BuyerID       int64
+	Orders        int64
+	LastOrderDate time.Time
+	Revenue       money.Money `gorm:"embedded;embeddedPrefix:revenue_"`
+}
+
+// BuyerRFM, how recently, how often and how much a buyer ordered from the seller. RecencyDays is the days since
+// the buyer's latest order, Frequency their number of orders and Monetary their revenue per currency. Each figure
+// is scored from 1 to 5 by the quintile of the seller's buyers it falls in, 5 being the most recent, frequent or
+// spending buyers, and the scores put the buyer in a Segment
+type BuyerRFM struct {
+	BuyerID        int64         `json:"buyer_id"`
+	RecencyDays    int64         `json:"recency_days"`
+	Frequency      int64         `json:"frequency"`
+	Monetary       []money.Money `json:"monetary"`
+	RecencyScore   int           `json:"recency_score"`
+	FrequencyScore int           `json:"frequency_score"`
+	MonetaryScore  int           `json:"monetary_score"`
+	Segment        string        `json:"segment"`
+}
+
+// SegmentSummary, number of buyers of a seller per RFM segment, MarketplaceSellerID for the whole marketplace
+type SegmentSummary struct {
+	SellerID int64          `json:"seller_id"`
+	Buyers   int64          `json:"buyers"`
+	Segments []SegmentCount `json:"segments"`
+}
+
+// SegmentCount, number of Buyers in Segment and their share of the seller's buyers in percent
+type SegmentCount struct {
+	Segment string  `json:"segment"`
+	Buyers  int64   `json:"buyers"`
+	Share   float32 `json:"share"`
+}
+
+// SegmentMembers, the buyers of a seller in Segment, best scored first
+type SegmentMembers struct {
+	SellerID int64      `json:"seller_id"`
+	Segment  string     `json:"segment"`
+	Buyers   []BuyerRFM `json:"buyers"`
+}
@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	buyerdomain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+func (h *handler) GetAlerts(ctx *gin.Context) {
+	// alerts of the logged in seller only
+	sellerId := int64(ctx.MustGet(buyerdomain.SellerKey).(uint))
+
+	var acknowledged *bool
+	if strAcknowledged := ctx.Query("acknowledged"); strAcknowledged != "" {
+		value, err := strconv.ParseBool(strAcknowledged)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, GetAlertsResponse{
+				Error: "invalid acknowledged, expect true or false",
+			})
+			return
+		}
+		acknowledged = &value
+	}
+
+	res, err := h.AlertUsecase.GetAlerts(ctx, sellerId, acknowledged)
+	if err != nil {
+		ctx.Error(err)
+		ctx.JSON(http.StatusInternalServerError, GetAlertsResponse{
+			Error: "something happened on our end, please try at a later time",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, GetAlertsResponse{
+		Data: &res,
+	})
+}
+
+func (h *handler) AcknowledgeAlert(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil || id == 0 {
+		ctx.JSON(http.StatusBadRequest, AcknowledgeAlertResponse{
+			Error: "invalid id, expect a positive number",
+		})
+		return
+	}
+
+	// only an alert of the logged in seller is acknowledged
+	sellerId := int64(ctx.MustGet(buyerdomain.SellerKey).(uint))
+
+	res, err := h.AlertUsecase.AcknowledgeAlert(ctx, sellerId, uint(id))
+	if errors.Is(err, domain.ErrAlertNotFound) {
+		ctx.JSON(http.StatusNotFound, AcknowledgeAlertResponse{
+			Error: "alert not found",
+		})
+		return
+	}
+	if err != nil {
+		ctx.Error(err)
+		ctx.JSON(http.StatusInternalServerError, AcknowledgeAlertResponse{
+			Error: "something happened on our end, please try at a later time",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, AcknowledgeAlertResponse{
+		Data: res,
+	})
+}
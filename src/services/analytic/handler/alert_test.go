@@ -0,0 +1,236 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/usecase"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/usecase/mocks"
+)
+
+func TestHandler_GetAlerts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	alerts := []domain.Alert{
+		{SellerID: 2, Metric: domain.AlertMetricNetRevenue, Reason: domain.AlertReasonZScore, Value: 100, Baseline: 1000, ZScore: -4, DateString: "2022-01-01"},
+	}
+	unacknowledged := false
+
+	tests := []struct {
+		name     string
+		target   string
+		sellerId uint
+		usecase  func() usecase.AlertUsecase
+		wantCode int
+		want     GetAlertsResponse
+	}{
+		{
+			name:     "success",
+			target:   "/analytic/alerts?acknowledged=false",
+			sellerId: 2,
+			wantCode: http.StatusOK,
+			usecase: func() usecase.AlertUsecase {
+				m := mocks.NewMockAlertUsecase(ctrl)
+				m.EXPECT().GetAlerts(gomock.Any(), int64(2), &unacknowledged).Return(alerts, nil)
+				return m
+			},
+			want: GetAlertsResponse{
+				Data: &alerts,
+			},
+		},
+		{
+			name:     "another seller requested",
+			target:   "/analytic/alerts?seller_id=3",
+			sellerId: 2,
+			wantCode: http.StatusOK,
+			usecase: func() usecase.AlertUsecase {
+				m := mocks.NewMockAlertUsecase(ctrl)
+				m.EXPECT().GetAlerts(gomock.Any(), int64(2), nil).Return(alerts, nil)
+				return m
+			},
+			want: GetAlertsResponse{
+				Data: &alerts,
+			},
+		},
+		{
+			name:     "not logged in",
+			target:   "/analytic/alerts",
+			wantCode: http.StatusBadRequest,
+			usecase: func() usecase.AlertUsecase {
+				return mocks.NewMockAlertUsecase(ctrl)
+			},
+		},
+		{
+			name:     "invalid acknowledged",
+			target:   "/analytic/alerts?acknowledged=maybe",
+			sellerId: 2,
+			wantCode: http.StatusBadRequest,
+			usecase: func() usecase.AlertUsecase {
+				return mocks.NewMockAlertUsecase(ctrl)
+			},
+			want: GetAlertsResponse{
+				Error: "invalid acknowledged, expect true or false",
+			},
+		},
+		{
+			name:     "error",
+			target:   "/analytic/alerts",
+			sellerId: 2,
+			wantCode: http.StatusInternalServerError,
+			usecase: func() usecase.AlertUsecase {
+				m := mocks.NewMockAlertUsecase(ctrl)
+				m.EXPECT().GetAlerts(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("mock error"))
+				return m
+			},
+			want: GetAlertsResponse{
+				Error: "something happened on our end, please try at a later time",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+
+			sut := NewAnalyticHandler(Params{
+				AlertUsecase: tt.usecase(),
+			})
+
+			req, _ := http.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.sellerId != 0 {
+				req.AddCookie(sellerSession(t, tt.sellerId))
+			}
+			router := ProvideGinEngine(sut)
+			router.ServeHTTP(recorder, req)
+
+			var response GetAlertsResponse
+			json.Unmarshal(recorder.Body.Bytes(), &response)
+
+			assert.Equal(t, tt.want, response)
+			assert.Equal(t, tt.wantCode, recorder.Code)
+		})
+	}
+}
+
+func TestHandler_AcknowledgeAlert(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	alert := &domain.Alert{SellerID: 2, Metric: domain.AlertMetricCancellationRate, Reason: domain.AlertReasonThreshold, Value: 60, DateString: "2022-01-01"}
+
+	tests := []struct {
+		name     string
+		target   string
+		sellerId uint
+		usecase  func() usecase.AlertUsecase
+		wantCode int
+		want     AcknowledgeAlertResponse
+	}{
+		{
+			name:     "success",
+			target:   "/analytic/alerts/1/acknowledge",
+			sellerId: 2,
+			wantCode: http.StatusOK,
+			usecase: func() usecase.AlertUsecase {
+				m := mocks.NewMockAlertUsecase(ctrl)
+				m.EXPECT().AcknowledgeAlert(gomock.Any(), int64(2), uint(1)).Return(alert, nil)
+				return m
+			},
+			want: AcknowledgeAlertResponse{
+				Data: alert,
+			},
+		},
+		{
+			name:     "invalid id",
+			target:   "/analytic/alerts/0/acknowledge",
+			sellerId: 2,
+			wantCode: http.StatusBadRequest,
+			usecase: func() usecase.AlertUsecase {
+				return mocks.NewMockAlertUsecase(ctrl)
+			},
+			want: AcknowledgeAlertResponse{
+				Error: "invalid id, expect a positive number",
+			},
+		},
+		{
+			name:     "not logged in",
+			target:   "/analytic/alerts/1/acknowledge",
+			wantCode: http.StatusBadRequest,
+			usecase: func() usecase.AlertUsecase {
+				return mocks.NewMockAlertUsecase(ctrl)
+			},
+		},
+		{
+			name:     "alert of another seller",
+			target:   "/analytic/alerts/1/acknowledge?seller_id=3",
+			sellerId: 2,
+			wantCode: http.StatusNotFound,
+			usecase: func() usecase.AlertUsecase {
+				m := mocks.NewMockAlertUsecase(ctrl)
+				m.EXPECT().AcknowledgeAlert(gomock.Any(), int64(2), uint(1)).Return(nil, domain.ErrAlertNotFound)
+				return m
+			},
+			want: AcknowledgeAlertResponse{
+				Error: "alert not found",
+			},
+		},
+		{
+			name:     "not found",
+			target:   "/analytic/alerts/1/acknowledge",
+			sellerId: 2,
+			wantCode: http.StatusNotFound,
+			usecase: func() usecase.AlertUsecase {
+				m := mocks.NewMockAlertUsecase(ctrl)
+				m.EXPECT().AcknowledgeAlert(gomock.Any(), int64(2), uint(1)).Return(nil, domain.ErrAlertNotFound)
+				return m
+			},
+			want: AcknowledgeAlertResponse{
+				Error: "alert not found",
+			},
+		},
+		{
+			name:     "error",
+			target:   "/analytic/alerts/1/acknowledge",
+			sellerId: 2,
+			wantCode: http.StatusInternalServerError,
+			usecase: func() usecase.AlertUsecase {
+				m := mocks.NewMockAlertUsecase(ctrl)
+				m.EXPECT().AcknowledgeAlert(gomock.Any(), int64(2), uint(1)).Return(nil, errors.New("mock error"))
+				return m
+			},
+			want: AcknowledgeAlertResponse{
+				Error: "something happened on our end, please try at a later time",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+
+			sut := NewAnalyticHandler(Params{
+				AlertUsecase: tt.usecase(),
+			})
+
+			req, _ := http.NewRequest(http.MethodPost, tt.target, nil)
+			if tt.sellerId != 0 {
+				req.AddCookie(sellerSession(t, tt.sellerId))
+			}
+			router := ProvideGinEngine(sut)
+			router.ServeHTTP(recorder, req)
+
+			var response AcknowledgeAlertResponse
+			json.Unmarshal(recorder.Body.Bytes(), &response)
+
+			assert.Equal(t, tt.want, response)
+			assert.Equal(t, tt.wantCode, recorder.Code)
+		})
+	}
+}
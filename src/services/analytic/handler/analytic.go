@@ -3,6 +3,7 @@ package handler
 import (
 	"log"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -15,20 +16,59 @@ import (
 
 type Handler interface {
 	GetAnalyticByDate(ctx *gin.Context)
+	GetCohorts(ctx *gin.Context)
+	GetSegments(ctx *gin.Context)
+	GetSegmentBuyers(ctx *gin.Context)
+	GetForecast(ctx *gin.Context)
+	GetAlerts(ctx *gin.Context)
+	AcknowledgeAlert(ctx *gin.Context)
+	GetGoals(ctx *gin.Context)
+	CreateGoal(ctx *gin.Context)
+	UpdateGoal(ctx *gin.Context)
+	DeleteGoal(ctx *gin.Context)
+	GetBenchmark(ctx *gin.Context)
+	SellerAuth() gin.HandlerFunc
+	AdminAuth() gin.HandlerFunc
+	GetReports(ctx *gin.Context)
+	DownloadReport(ctx *gin.Context)
 }
 
 type handler struct {
-	AnalyticUsecase usecase.AnalyticUsecase
+	AnalyticUsecase  usecase.AnalyticUsecase
+	CohortUsecase    usecase.CohortUsecase
+	SegmentUsecase   usecase.SegmentUsecase
+	ForecastUsecase  usecase.ForecastUsecase
+	AlertUsecase     usecase.AlertUsecase
+	GoalUsecase      usecase.GoalUsecase
+	BenchmarkUsecase usecase.BenchmarkUsecase
+	ReportUsecase    usecase.ReportUsecase
+	AdminCfg         domain.AdminConfig
 }
 
 type Params struct {
 	fx.In
-	AnalyticUsecase usecase.AnalyticUsecase
+	AnalyticUsecase  usecase.AnalyticUsecase
+	CohortUsecase    usecase.CohortUsecase
+	SegmentUsecase   usecase.SegmentUsecase
+	ForecastUsecase  usecase.ForecastUsecase
+	AlertUsecase     usecase.AlertUsecase
+	GoalUsecase      usecase.GoalUsecase
+	BenchmarkUsecase usecase.BenchmarkUsecase
+	ReportUsecase    usecase.ReportUsecase
+	AdminCfg         domain.AdminConfig
 }
 
 func NewAnalyticHandler(param Params) Handler {
 	return &handler{
-		AnalyticUsecase: param.AnalyticUsecase,
+		AnalyticUsecase:  param.AnalyticUsecase,
+		CohortUsecase:    param.CohortUsecase,
+		SegmentUsecase:   param.SegmentUsecase,
+		ForecastUsecase:  param.ForecastUsecase,
+		AlertUsecase:     param.AlertUsecase,
+		GoalUsecase:      param.GoalUsecase,
+		BenchmarkUsecase: param.BenchmarkUsecase,
+		ReportUsecase:    param.ReportUsecase,
+		AdminCfg:         param.AdminCfg,
 	}
 }
 
@@ -48,7 +88,19 @@ func (h *handler) GetAnalyticByDate(ctx *gin.Context) {
 		}
 	}
 
-	res, err := h.AnalyticUsecase.GetAnalyticByDate(ctx, date)
+	// analytic of the whole marketplace unless a seller is requested
+	sellerId := domain.MarketplaceSellerID
+	if strSellerId := ctx.Query("seller_id"); strSellerId != "" {
+		sellerId, err = strconv.ParseInt(strSellerId, 10, 64)
+		if err != nil || sellerId < 0 {
+			ctx.JSON(http.StatusBadRequest, GetAnalyticByDateResponse{
+				Error: "invalid seller_id, expect a positive number",
+			})
+			return
+		}
+	}
+
+	res, err := h.AnalyticUsecase.GetAnalyticByDate(ctx, sellerId, date)
 	if err != nil {
 		ctx.Error(err)
 		ctx.JSON(http.StatusInternalServerError, GetAnalyticByDateResponse{
@@ -62,19 +114,16 @@ func (h *handler) GetAnalyticByDate(ctx *gin.Context) {
 	})
 }
 
-func SubscribeStatistic(repoCoreRabbitMQ messagequeue.Subscriber[statdomain.PayloadEventStatistic], usecase usecase.AnalyticUsecase) {
+func SubscribeStatistic(repoCoreRabbitMQ messagequeue.Subscriber[statdomain.PayloadEventStatistic], usecase usecase.AnalyticUsecase, forecastUsecase usecase.ForecastUsecase, alertUsecase usecase.AlertUsecase) {
 	go func() {
 		err := repoCoreRabbitMQ.Subscribe(messagequeue.SubscribeConfig{
 			AutoAck: true,
 		}, func(msg statdomain.PayloadEventStatistic) {
 			if msg.Date != "" {
-				usecase.HandleStatisticEvent(domain.StatisticEvent{
-					TotalRevenue:   msg.TotalRevenue,
-					CompletedOrder: msg.CompletedOrder,
-					CanceledOrder:  msg.CanceledOrder,
-					TotalOrder:     msg.TotalOrder,
-					Date:           msg.Date,
-				})
+				statisticEvent := toStatisticEvent(msg)
+				usecase.HandleStatisticEvent(statisticEvent)
+				forecastUsecase.HandleStatisticEvent(statisticEvent)
+				alertUsecase.HandleStatisticEvent(statisticEvent)
 			} else {
 				log.Println("invalid message: date can't be empty")
 			}
@@ -85,3 +134,30 @@ func SubscribeStatistic(repoCoreRabbitMQ messagequeue.Subscriber[statdomain.Payl
 		}
 	}()
 }
+
+// toStatisticEvent, converts the event published by the statistic service to the analytic domain
+func toStatisticEvent(msg statdomain.PayloadEventStatistic) domain.StatisticEvent {
+	return domain.StatisticEvent{
+		SellerID:         msg.SellerID,
+		TotalRevenue:     msg.TotalRevenue,
+		CompletedOrder:   msg.CompletedOrder,
+		CanceledOrder:    msg.CanceledOrder,
+		TotalOrder:       msg.TotalOrder,
+		RefundedAmount:   msg.RefundedAmount,
+		RefundedOrders:   msg.RefundedOrders,
+		ShippedOrders:    msg.ShippedOrders,
+		OnTimeShipments:  msg.OnTimeShipments,
+		ShippingLeadTime: msg.ShippingLeadTime,
+		DiscountAmount:   msg.DiscountAmount,
+		ReviewCount:      msg.ReviewCount,
+		RatingSum:        msg.RatingSum,
+		Ratings:          domain.RatingDistribution(msg.Ratings),
+		ProductViews:     msg.ProductViews,
+		CartAdds:         msg.CartAdds,
+		CheckoutsStarted: msg.CheckoutsStarted,
+		UniqueBuyers:     msg.UniqueBuyers,
+		NewBuyers:        msg.NewBuyers,
+		ReturningBuyers:  msg.ReturningBuyers,
+		Date:             msg.Date,
+	}
+}
@@ -12,9 +12,12 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/messagequeue"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/usecase"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/usecase/mocks"
+	statdomain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/statistic/domain"
 	"gorm.io/datatypes"
 )
 
@@ -66,7 +69,7 @@ func TestHandler_GetAnalyticByDate(t *testing.T) {
 			},
 			usecase: func() usecase.AnalyticUsecase {
 				m := mocks.NewMockAnalyticUsecase(ctrl)
-				m.EXPECT().GetAnalyticByDate(gomock.Any(), gomock.Any()).Return(&domain.Analytic{
+				m.EXPECT().GetAnalyticByDate(gomock.Any(), domain.MarketplaceSellerID, gomock.Any()).Return(&domain.Analytic{
 					Date: datatypes.Date(time.Date(2022, 01, 01, 0, 0, 0, 0, time.Local)),
 				}, nil)
 				return m
@@ -75,6 +78,49 @@ func TestHandler_GetAnalyticByDate(t *testing.T) {
 				Data: &domain.Analytic{},
 			},
 		},
+		{
+			name:     "success seller",
+			wantCode: http.StatusOK,
+			request: func() *http.Request {
+				req, _ := http.NewRequest(http.MethodGet, "/analytic", nil)
+				values := req.URL.Query()
+				values.Add("seller_id", "2")
+				req.URL.RawQuery = values.Encode()
+				req.Header.Set("Content-Type", "application/json")
+				return req
+			},
+			usecase: func() usecase.AnalyticUsecase {
+				m := mocks.NewMockAnalyticUsecase(ctrl)
+				m.EXPECT().GetAnalyticByDate(gomock.Any(), int64(2), gomock.Any()).Return(&domain.Analytic{
+					SellerID: 2,
+				}, nil)
+				return m
+			},
+			want: GetAnalyticByDateResponse{
+				Data: &domain.Analytic{
+					SellerID: 2,
+				},
+			},
+		},
+		{
+			name:     "invalid seller id",
+			wantCode: http.StatusBadRequest,
+			request: func() *http.Request {
+				req, _ := http.NewRequest(http.MethodGet, "/analytic", nil)
+				values := req.URL.Query()
+				values.Add("seller_id", "-1")
+				req.URL.RawQuery = values.Encode()
+				req.Header.Set("Content-Type", "application/json")
+				return req
+			},
+			usecase: func() usecase.AnalyticUsecase {
+				m := mocks.NewMockAnalyticUsecase(ctrl)
+				return m
+			},
+			want: GetAnalyticByDateResponse{
+				Error: "invalid seller_id, expect a positive number",
+			},
+		},
 		{
 			name:     "invalid date format",
 			wantCode: http.StatusBadRequest,
@@ -107,7 +153,7 @@ func TestHandler_GetAnalyticByDate(t *testing.T) {
 			},
 			usecase: func() usecase.AnalyticUsecase {
 				m := mocks.NewMockAnalyticUsecase(ctrl)
-				m.EXPECT().GetAnalyticByDate(gomock.Any(), gomock.Any()).Return(nil, errors.New("mock error"))
+				m.EXPECT().GetAnalyticByDate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("mock error"))
 				return m
 			},
 			want: GetAnalyticByDateResponse{
@@ -138,3 +184,84 @@ func TestHandler_GetAnalyticByDate(t *testing.T) {
 	}
 
 }
+
+// fakeSubscriber, subscriber handing its messages to the handler and closing done afterwards
+type fakeSubscriber[T any] struct {
+	msgs []T
+	done chan struct{}
+}
+
+func (fs fakeSubscriber[T]) Subscribe(subscribe messagequeue.SubscribeConfig, handlerFunc func(msg T)) error {
+	defer close(fs.done)
+	for _, msg := range fs.msgs {
+		handlerFunc(msg)
+	}
+	return nil
+}
+
+func TestSubscribeStatistic(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	msg := statdomain.PayloadEventStatistic{
+		SellerID:         2,
+		TotalRevenue:     money.New(900, "IDR"),
+		CompletedOrder:   3,
+		CanceledOrder:    1,
+		TotalOrder:       4,
+		RefundedAmount:   money.New(100, "IDR"),
+		RefundedOrders:   1,
+		ShippedOrders:    3,
+		OnTimeShipments:  2,
+		ShippingLeadTime: 3600,
+		DiscountAmount:   money.New(50, "IDR"),
+		ReviewCount:      2,
+		RatingSum:        9,
+		Ratings:          statdomain.RatingDistribution{Four: 1, Five: 1},
+		ProductViews:     40,
+		CartAdds:         10,
+		CheckoutsStarted: 5,
+		UniqueBuyers:     3,
+		NewBuyers:        2,
+		ReturningBuyers:  1,
+		Date:             "2022-01-01",
+	}
+	want := domain.StatisticEvent{
+		SellerID:         2,
+		TotalRevenue:     money.New(900, "IDR"),
+		CompletedOrder:   3,
+		CanceledOrder:    1,
+		TotalOrder:       4,
+		RefundedAmount:   money.New(100, "IDR"),
+		RefundedOrders:   1,
+		ShippedOrders:    3,
+		OnTimeShipments:  2,
+		ShippingLeadTime: 3600,
+		DiscountAmount:   money.New(50, "IDR"),
+		ReviewCount:      2,
+		RatingSum:        9,
+		Ratings:          domain.RatingDistribution{Four: 1, Five: 1},
+		ProductViews:     40,
+		CartAdds:         10,
+		CheckoutsStarted: 5,
+		UniqueBuyers:     3,
+		NewBuyers:        2,
+		ReturningBuyers:  1,
+		Date:             "2022-01-01",
+	}
+
+	analyticUsecase := mocks.NewMockAnalyticUsecase(ctrl)
+	// only the message with a date is handled, with every figure the statistic service sent
+	analyticUsecase.EXPECT().HandleStatisticEvent(want).Times(1)
+	alertUsecase := mocks.NewMockAlertUsecase(ctrl)
+	alertUsecase.EXPECT().HandleStatisticEvent(want).Times(1)
+	forecastUsecase := mocks.NewMockForecastUsecase(ctrl)
+	forecastUsecase.EXPECT().HandleStatisticEvent(want).Times(1)
+
+	subscriber := fakeSubscriber[statdomain.PayloadEventStatistic]{
+		msgs: []statdomain.PayloadEventStatistic{msg, {SellerID: 2}},
+		done: make(chan struct{}),
+	}
+	SubscribeStatistic(subscriber, analyticUsecase, forecastUsecase, alertUsecase)
+	<-subscriber.done
+}
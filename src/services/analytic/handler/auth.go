@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-contrib/sessions/cookie"
+	"github.com/gin-gonic/gin"
+	buyerdomain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+// sessionName, cookie of the session the seller login of the buyer service starts
+const sessionName = "sha_session"
+
+// AdminTokenHeader, header carrying the admin token
+const AdminTokenHeader = "X-Admin-Token"
+
+// adminKey, context key AdminAuth sets on requests of an admin
+const adminKey = "admin"
+
+// sessionStore, store reading the sessions of the buyer service, sharing its secret
+func sessionStore() sessions.Store {
+	return cookie.NewStore([]byte("secret"))
+}
+
+// SellerAuth, add the middleware function guarding endpoints only showing a seller its own figures, the seller is
+// the one logged in through the buyer service
+func (h *handler) SellerAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		session := sessions.Default(c)
+		sellerIdRaw := session.Get(buyerdomain.SellerKey)
+		if sellerIdRaw == nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"errors": "no authentication found"})
+			return
+		}
+
+		sellerId, ok := sellerIdRaw.(uint)
+		if !ok || sellerId == 0 {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"errors": "invalid cookie"})
+			return
+		}
+
+		// set seller key into context
+		c.Set(buyerdomain.SellerKey, sellerId)
+
+		c.Next()
+	}
+}
+
+// AdminAuth, add the middleware function guarding admin only endpoints e.g. the ones of the whole marketplace
+func (h *handler) AdminAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if h.AdminCfg.Token == "" {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"errors": "admin endpoints are disabled"})
+			return
+		}
+
+		token := c.GetHeader(AdminTokenHeader)
+		if subtle.ConstantTimeCompare([]byte(token), []byte(h.AdminCfg.Token)) != 1 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"errors": "request does not have valid authentication"})
+			return
+		}
+
+		c.Set(adminKey, true)
+
+		c.Next()
+	}
+}
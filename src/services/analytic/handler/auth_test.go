@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	buyerdomain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+// sellerSession, the session cookie the seller login of the buyer service sets, holding value as the seller
+func sellerSession(t *testing.T, value interface{}) *http.Cookie {
+	router := gin.New()
+	router.Use(sessions.Sessions(sessionName, sessionStore()))
+	router.GET("/login", func(ctx *gin.Context) {
+		session := sessions.Default(ctx)
+		session.Set(buyerdomain.SellerKey, value)
+		session.Save()
+	})
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/login", nil)
+	router.ServeHTTP(recorder, req)
+
+	cookies := recorder.Result().Cookies()
+	require.Len(t, cookies, 1)
+	return cookies[0]
+}
+
+func TestHandler_SellerAuth(t *testing.T) {
+	tests := []struct {
+		name     string
+		cookie   func(t *testing.T) *http.Cookie
+		wantCode int
+		wantBody string
+	}{
+		{
+			name: "success",
+			cookie: func(t *testing.T) *http.Cookie {
+				return sellerSession(t, uint(2))
+			},
+			wantCode: http.StatusOK,
+			wantBody: "2",
+		},
+		{
+			name:     "no session",
+			cookie:   func(t *testing.T) *http.Cookie { return nil },
+			wantCode: http.StatusBadRequest,
+			wantBody: `{"errors":"no authentication found"}`,
+		},
+		{
+			name: "tampered session",
+			cookie: func(t *testing.T) *http.Cookie {
+				cookie := sellerSession(t, uint(2))
+				cookie.Value = cookie.Value[:len(cookie.Value)-2] + "xx"
+				return cookie
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: `{"errors":"no authentication found"}`,
+		},
+		{
+			name: "invalid seller",
+			cookie: func(t *testing.T) *http.Cookie {
+				return sellerSession(t, "2")
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: `{"errors":"invalid cookie"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &handler{}
+			router := gin.New()
+			router.Use(sessions.Sessions(sessionName, sessionStore()))
+			router.GET("/", h.SellerAuth(), func(ctx *gin.Context) {
+				ctx.String(http.StatusOK, "%d", ctx.MustGet(buyerdomain.SellerKey).(uint))
+			})
+
+			recorder := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/", nil)
+			if cookie := tt.cookie(t); cookie != nil {
+				req.AddCookie(cookie)
+			}
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tt.wantCode, recorder.Code)
+			assert.Equal(t, tt.wantBody, recorder.Body.String())
+		})
+	}
+}
+
+func TestHandler_AdminAuth(t *testing.T) {
+	tests := []struct {
+		name     string
+		token    string
+		header   string
+		wantCode int
+		wantBody string
+	}{
+		{
+			name:     "success",
+			token:    "secret",
+			header:   "secret",
+			wantCode: http.StatusOK,
+			wantBody: "true",
+		},
+		{
+			name:     "admin endpoints disabled",
+			header:   "secret",
+			wantCode: http.StatusForbidden,
+			wantBody: `{"errors":"admin endpoints are disabled"}`,
+		},
+		{
+			name:     "missing token",
+			token:    "secret",
+			wantCode: http.StatusUnauthorized,
+			wantBody: `{"errors":"request does not have valid authentication"}`,
+		},
+		{
+			name:     "invalid token",
+			token:    "secret",
+			header:   "wrong",
+			wantCode: http.StatusUnauthorized,
+			wantBody: `{"errors":"request does not have valid authentication"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &handler{AdminCfg: domain.AdminConfig{Token: tt.token}}
+			router := gin.New()
+			router.GET("/", h.AdminAuth(), func(ctx *gin.Context) {
+				ctx.String(http.StatusOK, "%t", ctx.GetBool(adminKey))
+			})
+
+			recorder := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set(AdminTokenHeader, tt.header)
+			}
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tt.wantCode, recorder.Code)
+			assert.Equal(t, tt.wantBody, recorder.Body.String())
+		})
+	}
+}
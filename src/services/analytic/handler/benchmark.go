@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	buyerdomain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+func (h *handler) GetBenchmark(ctx *gin.Context) {
+	// sellers only see their own figures, the ones of other sellers only make up the anonymous peer distribution
+	sellerId := int64(ctx.MustGet(buyerdomain.SellerKey).(uint))
+
+	// peers of the seller's own category unless a category is requested
+	var (
+		categoryId int64
+		err        error
+	)
+	if strCategoryId := ctx.Query("category_id"); strCategoryId != "" {
+		categoryId, err = strconv.ParseInt(strCategoryId, 10, 64)
+		if err != nil || categoryId < 0 {
+			ctx.JSON(http.StatusBadRequest, GetBenchmarkResponse{
+				Error: "invalid category_id, expect a positive number",
+			})
+			return
+		}
+	}
+
+	res, err := h.BenchmarkUsecase.GetBenchmark(ctx, sellerId, categoryId)
+	if errors.Is(err, domain.ErrSellerCategoryUnknown) {
+		ctx.JSON(http.StatusNotFound, GetBenchmarkResponse{
+			Error: "category of the seller unknown, specify a category_id",
+		})
+		return
+	}
+	if errors.Is(err, domain.ErrNotEnoughPeers) {
+		ctx.JSON(http.StatusNotFound, GetBenchmarkResponse{
+			Error: "not enough peers in the category to benchmark against",
+		})
+		return
+	}
+	if err != nil {
+		ctx.Error(err)
+		ctx.JSON(http.StatusInternalServerError, GetBenchmarkResponse{
+			Error: "something happened on our end, please try at a later time",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, GetBenchmarkResponse{
+		Data: res,
+	})
+}
@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/usecase"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/usecase/mocks"
+)
+
+func TestHandler_GetBenchmark(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	benchmark := &domain.Benchmark{
+		SellerID:   2,
+		CategoryID: 9,
+		From:       "2022-01-01",
+		To:         "2022-01-30",
+		Metrics: []domain.MetricBenchmark{
+			{Metric: domain.BenchmarkMetricOrderCompletionRate, Peers: 5, P25: 60, P50: 80, P75: 90, P90: 96},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		target   string
+		sellerId uint
+		usecase  func() usecase.BenchmarkUsecase
+		wantCode int
+		want     GetBenchmarkResponse
+	}{
+		{
+			name:     "success",
+			target:   "/analytic/benchmarks",
+			sellerId: 2,
+			wantCode: http.StatusOK,
+			usecase: func() usecase.BenchmarkUsecase {
+				m := mocks.NewMockBenchmarkUsecase(ctrl)
+				m.EXPECT().GetBenchmark(gomock.Any(), int64(2), int64(0)).Return(benchmark, nil)
+				return m
+			},
+			want: GetBenchmarkResponse{
+				Data: benchmark,
+			},
+		},
+		{
+			name:     "another seller requested",
+			target:   "/analytic/benchmarks?seller_id=3",
+			sellerId: 2,
+			wantCode: http.StatusOK,
+			usecase: func() usecase.BenchmarkUsecase {
+				m := mocks.NewMockBenchmarkUsecase(ctrl)
+				m.EXPECT().GetBenchmark(gomock.Any(), int64(2), int64(0)).Return(benchmark, nil)
+				return m
+			},
+			want: GetBenchmarkResponse{
+				Data: benchmark,
+			},
+		},
+		{
+			name:     "not logged in",
+			target:   "/analytic/benchmarks",
+			wantCode: http.StatusBadRequest,
+			usecase: func() usecase.BenchmarkUsecase {
+				return mocks.NewMockBenchmarkUsecase(ctrl)
+			},
+		},
+		{
+			name:     "invalid category id",
+			target:   "/analytic/benchmarks?category_id=abc",
+			sellerId: 2,
+			wantCode: http.StatusBadRequest,
+			usecase: func() usecase.BenchmarkUsecase {
+				return mocks.NewMockBenchmarkUsecase(ctrl)
+			},
+			want: GetBenchmarkResponse{
+				Error: "invalid category_id, expect a positive number",
+			},
+		},
+		{
+			name:     "unknown category",
+			target:   "/analytic/benchmarks",
+			sellerId: 2,
+			wantCode: http.StatusNotFound,
+			usecase: func() usecase.BenchmarkUsecase {
+				m := mocks.NewMockBenchmarkUsecase(ctrl)
+				m.EXPECT().GetBenchmark(gomock.Any(), int64(2), int64(0)).Return(nil, domain.ErrSellerCategoryUnknown)
+				return m
+			},
+			want: GetBenchmarkResponse{
+				Error: "category of the seller unknown, specify a category_id",
+			},
+		},
+		{
+			name:     "not enough peers",
+			target:   "/analytic/benchmarks?category_id=9",
+			sellerId: 2,
+			wantCode: http.StatusNotFound,
+			usecase: func() usecase.BenchmarkUsecase {
+				m := mocks.NewMockBenchmarkUsecase(ctrl)
+				m.EXPECT().GetBenchmark(gomock.Any(), int64(2), int64(9)).Return(nil, domain.ErrNotEnoughPeers)
+				return m
+			},
+			want: GetBenchmarkResponse{
+				Error: "not enough peers in the category to benchmark against",
+			},
+		},
+		{
+			name:     "error",
+			target:   "/analytic/benchmarks",
+			sellerId: 2,
+			wantCode: http.StatusInternalServerError,
+			usecase: func() usecase.BenchmarkUsecase {
+				m := mocks.NewMockBenchmarkUsecase(ctrl)
+				m.EXPECT().GetBenchmark(gomock.Any(), int64(2), int64(0)).Return(nil, errors.New("mock error"))
+				return m
+			},
+			want: GetBenchmarkResponse{
+				Error: "something happened on our end, please try at a later time",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+
+			sut := NewAnalyticHandler(Params{
+				BenchmarkUsecase: tt.usecase(),
+			})
+
+			req, _ := http.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.sellerId != 0 {
+				req.AddCookie(sellerSession(t, tt.sellerId))
+			}
+			router := ProvideGinEngine(sut)
+			router.ServeHTTP(recorder, req)
+
+			var response GetBenchmarkResponse
+			json.Unmarshal(recorder.Body.Bytes(), &response)
+
+			assert.Equal(t, tt.want, response)
+			assert.Equal(t, tt.wantCode, recorder.Code)
+		})
+	}
+}
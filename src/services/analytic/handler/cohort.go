@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/messagequeue"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/usecase"
+	buyerdomain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	statdomain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/statistic/domain"
+)
+
+func (h *handler) GetCohorts(ctx *gin.Context) {
+	var err error
+
+	// cohorts of the logged in seller's buyers only
+	sellerId := int64(ctx.MustGet(buyerdomain.SellerKey).(uint))
+
+	weeks := domain.DefaultCohortWeeks
+	if strWeeks := ctx.Query("weeks"); strWeeks != "" {
+		weeks, err = strconv.Atoi(strWeeks)
+		if err != nil || weeks < 1 || weeks > domain.MaxCohortWeeks {
+			ctx.JSON(http.StatusBadRequest, GetCohortsResponse{
+				Error: "invalid weeks, expect a number from 1 to " + strconv.Itoa(domain.MaxCohortWeeks),
+			})
+			return
+		}
+	}
+
+	// the latest weeks up to the current one unless a first week is requested
+	from := time.Now().AddDate(0, 0, -7*(weeks-1))
+	if strFrom := ctx.Query("from"); strFrom != "" {
+		from, err = time.Parse(domain.AnalyticDateFormat, strFrom)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, GetCohortsResponse{
+				Error: "invalid from format, expect yyyy-mm-dd",
+			})
+			return
+		}
+	}
+
+	res, err := h.CohortUsecase.GetCohorts(ctx, sellerId, from, weeks)
+	if err != nil {
+		ctx.Error(err)
+		ctx.JSON(http.StatusInternalServerError, GetCohortsResponse{
+			Error: "something happened on our end, please try at a later time",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, GetCohortsResponse{
+		Data: res,
+	})
+}
+
+func SubscribeOrder(repoCoreRabbitMQ messagequeue.Subscriber[statdomain.PayloadEventOrder], usecase usecase.CohortUsecase, benchmarkUsecase usecase.BenchmarkUsecase) {
+	go func() {
+		err := repoCoreRabbitMQ.Subscribe(messagequeue.SubscribeConfig{
+			AutoAck: true,
+		}, func(msg statdomain.PayloadEventOrder) {
+			if msg.OrderDate != "" {
+				orderEvent := toOrderEvent(msg)
+				usecase.HandleOrderEvent(orderEvent)
+				benchmarkUsecase.HandleOrderEvent(orderEvent)
+			} else {
+				log.Println("invalid message: order date can't be empty")
+			}
+		})
+
+		if err != nil {
+			log.Println(err)
+		}
+	}()
+}
+
+// toOrderEvent, converts the order event published by the buyer service to the analytic domain
+func toOrderEvent(msg statdomain.PayloadEventOrder) domain.OrderEvent {
+	return domain.OrderEvent{
+		OrderID:        msg.OrderID,
+		SellerID:       msg.SellerID,
+		BuyerID:        msg.BuyerID,
+		OrderStatus:    msg.OrderStatus,
+		TotalRevenue:   msg.TotalRevenue,
+		RefundedAmount: msg.RefundedAmount,
+		OrderDate:      msg.OrderDate,
+		CategoryID:     msg.CategoryID,
+	}
+}
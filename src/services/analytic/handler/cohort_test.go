@@ -0,0 +1,177 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/usecase"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/usecase/mocks"
+	statdomain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/statistic/domain"
+)
+
+func TestHandler_GetCohorts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name     string
+		query    map[string]string
+		sellerId uint
+		usecase  func() usecase.CohortUsecase
+		wantCode int
+		want     GetCohortsResponse
+	}{
+		{
+			name:     "success",
+			query:    map[string]string{"from": "2022-01-03", "weeks": "4"},
+			sellerId: 2,
+			wantCode: http.StatusOK,
+			usecase: func() usecase.CohortUsecase {
+				m := mocks.NewMockCohortUsecase(ctrl)
+				m.EXPECT().GetCohorts(gomock.Any(), int64(2), time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC), 4).Return(&domain.CohortMatrix{
+					SellerID: 2,
+					Cohorts:  []domain.Cohort{{Week: "2022-01-03", Buyers: 1}},
+				}, nil)
+				return m
+			},
+			want: GetCohortsResponse{
+				Data: &domain.CohortMatrix{
+					SellerID: 2,
+					Cohorts:  []domain.Cohort{{Week: "2022-01-03", Buyers: 1}},
+				},
+			},
+		},
+		{
+			name:     "success default weeks",
+			sellerId: 2,
+			wantCode: http.StatusOK,
+			usecase: func() usecase.CohortUsecase {
+				m := mocks.NewMockCohortUsecase(ctrl)
+				m.EXPECT().GetCohorts(gomock.Any(), int64(2), gomock.Any(), domain.DefaultCohortWeeks).Return(&domain.CohortMatrix{}, nil)
+				return m
+			},
+			want: GetCohortsResponse{
+				Data: &domain.CohortMatrix{},
+			},
+		},
+		{
+			name:     "another seller requested",
+			query:    map[string]string{"seller_id": "3"},
+			sellerId: 2,
+			wantCode: http.StatusOK,
+			usecase: func() usecase.CohortUsecase {
+				m := mocks.NewMockCohortUsecase(ctrl)
+				m.EXPECT().GetCohorts(gomock.Any(), int64(2), gomock.Any(), domain.DefaultCohortWeeks).Return(&domain.CohortMatrix{}, nil)
+				return m
+			},
+			want: GetCohortsResponse{
+				Data: &domain.CohortMatrix{},
+			},
+		},
+		{
+			name:     "not logged in",
+			wantCode: http.StatusBadRequest,
+			usecase: func() usecase.CohortUsecase {
+				return mocks.NewMockCohortUsecase(ctrl)
+			},
+		},
+		{
+			name:     "invalid weeks",
+			sellerId: 2,
+			query:    map[string]string{"weeks": "53"},
+			wantCode: http.StatusBadRequest,
+			usecase: func() usecase.CohortUsecase {
+				return mocks.NewMockCohortUsecase(ctrl)
+			},
+			want: GetCohortsResponse{
+				Error: "invalid weeks, expect a number from 1 to 52",
+			},
+		},
+		{
+			name:     "invalid from format",
+			sellerId: 2,
+			query:    map[string]string{"from": "2022,01-03"},
+			wantCode: http.StatusBadRequest,
+			usecase: func() usecase.CohortUsecase {
+				return mocks.NewMockCohortUsecase(ctrl)
+			},
+			want: GetCohortsResponse{
+				Error: "invalid from format, expect yyyy-mm-dd",
+			},
+		},
+		{
+			name:     "error",
+			sellerId: 2,
+			wantCode: http.StatusInternalServerError,
+			usecase: func() usecase.CohortUsecase {
+				m := mocks.NewMockCohortUsecase(ctrl)
+				m.EXPECT().GetCohorts(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("mock error"))
+				return m
+			},
+			want: GetCohortsResponse{
+				Error: "something happened on our end, please try at a later time",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+
+			sut := NewAnalyticHandler(Params{
+				CohortUsecase: tt.usecase(),
+			})
+
+			req, _ := http.NewRequest(http.MethodGet, "/analytic/cohorts", nil)
+			values := req.URL.Query()
+			for k, v := range tt.query {
+				values.Add(k, v)
+			}
+			req.URL.RawQuery = values.Encode()
+			if tt.sellerId != 0 {
+				req.AddCookie(sellerSession(t, tt.sellerId))
+			}
+
+			router := ProvideGinEngine(sut)
+			router.ServeHTTP(recorder, req)
+
+			var response GetCohortsResponse
+			json.Unmarshal(recorder.Body.Bytes(), &response)
+
+			assert.Equal(t, tt.want, response)
+			assert.Equal(t, tt.wantCode, recorder.Code)
+		})
+	}
+}
+
+func Test_toOrderEvent(t *testing.T) {
+	msg := statdomain.PayloadEventOrder{
+		OrderID:          7,
+		SellerID:         2,
+		BuyerID:          3,
+		OrderDate:        "2022-01-05",
+		OrderStatus:      3,
+		TotalRevenue:     money.New(100, "IDR"),
+		TotalProductSold: 2,
+		RefundedAmount:   money.New(40, "IDR"),
+	}
+
+	want := domain.OrderEvent{
+		OrderID:        7,
+		SellerID:       2,
+		BuyerID:        3,
+		OrderStatus:    3,
+		TotalRevenue:   money.New(100, "IDR"),
+		RefundedAmount: money.New(40, "IDR"),
+		OrderDate:      "2022-01-05",
+	}
+	assert.Equal(t, want, toOrderEvent(msg))
+}
@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	buyerdomain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+func (h *handler) GetForecast(ctx *gin.Context) {
+	var err error
+
+	// forecast of the logged in seller's own sales only
+	sellerId := int64(ctx.MustGet(buyerdomain.SellerKey).(uint))
+
+	days := domain.DefaultForecastDays
+	if strDays := ctx.Query("days"); strDays != "" {
+		days, err = strconv.Atoi(strDays)
+		if err != nil || days < 1 || days > domain.MaxForecastDays {
+			ctx.JSON(http.StatusBadRequest, GetForecastResponse{
+				Error: "invalid days, expect a number from 1 to " + strconv.Itoa(domain.MaxForecastDays),
+			})
+			return
+		}
+	}
+
+	res, err := h.ForecastUsecase.GetForecast(ctx, sellerId, days)
+	if err != nil {
+		ctx.Error(err)
+		ctx.JSON(http.StatusInternalServerError, GetForecastResponse{
+			Error: "something happened on our end, please try at a later time",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, GetForecastResponse{
+		Data: res,
+	})
+}
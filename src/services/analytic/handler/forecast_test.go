@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/usecase"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/usecase/mocks"
+)
+
+func TestHandler_GetForecast(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	forecast := &domain.Forecast{
+		SellerID: 2,
+		Model:    domain.ForecastModelHoltWinters,
+		Days: []domain.ForecastDay{
+			{Date: "2022-01-01", Revenue: money.New(100, "IDR"), RevenueLower: money.New(50, "IDR"), RevenueUpper: money.New(150, "IDR"), Orders: 2, OrdersLower: 1, OrdersUpper: 3},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		target   string
+		sellerId uint
+		usecase  func() usecase.ForecastUsecase
+		wantCode int
+		want     GetForecastResponse
+	}{
+		{
+			name:     "success",
+			target:   "/analytic/forecast?days=1",
+			sellerId: 2,
+			wantCode: http.StatusOK,
+			usecase: func() usecase.ForecastUsecase {
+				m := mocks.NewMockForecastUsecase(ctrl)
+				m.EXPECT().GetForecast(gomock.Any(), int64(2), 1).Return(forecast, nil)
+				return m
+			},
+			want: GetForecastResponse{
+				Data: forecast,
+			},
+		},
+		{
+			name:     "success default days",
+			target:   "/analytic/forecast",
+			sellerId: 2,
+			wantCode: http.StatusOK,
+			usecase: func() usecase.ForecastUsecase {
+				m := mocks.NewMockForecastUsecase(ctrl)
+				m.EXPECT().GetForecast(gomock.Any(), int64(2), domain.DefaultForecastDays).Return(&domain.Forecast{}, nil)
+				return m
+			},
+			want: GetForecastResponse{
+				Data: &domain.Forecast{},
+			},
+		},
+		{
+			name:     "another seller requested",
+			target:   "/analytic/forecast?seller_id=3",
+			sellerId: 2,
+			wantCode: http.StatusOK,
+			usecase: func() usecase.ForecastUsecase {
+				m := mocks.NewMockForecastUsecase(ctrl)
+				m.EXPECT().GetForecast(gomock.Any(), int64(2), domain.DefaultForecastDays).Return(&domain.Forecast{}, nil)
+				return m
+			},
+			want: GetForecastResponse{
+				Data: &domain.Forecast{},
+			},
+		},
+		{
+			name:     "not logged in",
+			target:   "/analytic/forecast",
+			wantCode: http.StatusBadRequest,
+			usecase: func() usecase.ForecastUsecase {
+				return mocks.NewMockForecastUsecase(ctrl)
+			},
+		},
+		{
+			name:     "invalid days",
+			target:   "/analytic/forecast?days=31",
+			sellerId: 2,
+			wantCode: http.StatusBadRequest,
+			usecase: func() usecase.ForecastUsecase {
+				return mocks.NewMockForecastUsecase(ctrl)
+			},
+			want: GetForecastResponse{
+				Error: "invalid days, expect a number from 1 to 30",
+			},
+		},
+		{
+			name:     "error",
+			target:   "/analytic/forecast",
+			sellerId: 2,
+			wantCode: http.StatusInternalServerError,
+			usecase: func() usecase.ForecastUsecase {
+				m := mocks.NewMockForecastUsecase(ctrl)
+				m.EXPECT().GetForecast(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("mock error"))
+				return m
+			},
+			want: GetForecastResponse{
+				Error: "something happened on our end, please try at a later time",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+
+			sut := NewAnalyticHandler(Params{
+				ForecastUsecase: tt.usecase(),
+			})
+
+			req, _ := http.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.sellerId != 0 {
+				req.AddCookie(sellerSession(t, tt.sellerId))
+			}
+			router := ProvideGinEngine(sut)
+			router.ServeHTTP(recorder, req)
+
+			var response GetForecastResponse
+			json.Unmarshal(recorder.Body.Bytes(), &response)
+
+			assert.Equal(t, tt.want, response)
+			assert.Equal(t, tt.wantCode, recorder.Code)
+		})
+	}
+}
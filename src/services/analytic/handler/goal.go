@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	httpgin "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/http/gin"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/validation"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	buyerdomain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+// GetGoals, progress of the goals of a month, the current month unless a period is requested
+func (h *handler) GetGoals(ctx *gin.Context) {
+	var err error
+
+	// goals of the logged in seller only
+	sellerId := int64(ctx.MustGet(buyerdomain.SellerKey).(uint))
+
+	period := time.Now()
+	if strPeriod := ctx.Query("period"); strPeriod != "" {
+		period, err = time.Parse(domain.GoalPeriodFormat, strPeriod)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, GetGoalsResponse{
+				Error: "invalid period format, expect yyyy-mm",
+			})
+			return
+		}
+	}
+
+	res, err := h.GoalUsecase.GetGoals(ctx, sellerId, period)
+	if err != nil {
+		ctx.Error(err)
+		ctx.JSON(http.StatusInternalServerError, GetGoalsResponse{
+			Error: "something happened on our end, please try at a later time",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, GetGoalsResponse{
+		Data: res,
+	})
+}
+
+// CreateGoal, sets a goal for a month of the logged in seller
+func (h *handler) CreateGoal(ctx *gin.Context) {
+	request := new(CreateGoalRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		httpgin.AbortWithBindError[domain.Goal](ctx, err)
+		return
+	}
+
+	if _, err := time.Parse(domain.GoalPeriodFormat, request.Period); err != nil {
+		httpgin.AbortWithBindError[domain.Goal](ctx, validation.NewError("period", "must be formatted as yyyy-mm"))
+		return
+	}
+
+	res, err := h.GoalUsecase.CreateGoal(ctx, domain.Goal{
+		SellerID: int64(ctx.MustGet(buyerdomain.SellerKey).(uint)),
+		Period:   request.Period,
+		Metric:   request.Metric,
+		Target:   *request.Target,
+	})
+	if err != nil {
+		abortWithGoalError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, GoalResponse{
+		Data: res,
+	})
+}
+
+// UpdateGoal, changes the target of a goal of the logged in seller
+func (h *handler) UpdateGoal(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil || id == 0 {
+		ctx.JSON(http.StatusBadRequest, GoalResponse{
+			Error: "invalid id, expect a positive number",
+		})
+		return
+	}
+
+	request := new(UpdateGoalRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		httpgin.AbortWithBindError[domain.Goal](ctx, err)
+		return
+	}
+
+	sellerId := int64(ctx.MustGet(buyerdomain.SellerKey).(uint))
+	res, err := h.GoalUsecase.UpdateGoal(ctx, sellerId, uint(id), *request.Target)
+	if err != nil {
+		abortWithGoalError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, GoalResponse{
+		Data: res,
+	})
+}
+
+// DeleteGoal, removes a goal of the logged in seller
+func (h *handler) DeleteGoal(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil || id == 0 {
+		ctx.JSON(http.StatusBadRequest, GoalResponse{
+			Error: "invalid id, expect a positive number",
+		})
+		return
+	}
+
+	sellerId := int64(ctx.MustGet(buyerdomain.SellerKey).(uint))
+	if err := h.GoalUsecase.DeleteGoal(ctx, sellerId, uint(id)); err != nil {
+		abortWithGoalError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// abortWithGoalError, responds to a goal request the usecase failed
+func abortWithGoalError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domain.ErrGoalNotFound):
+		ctx.JSON(http.StatusNotFound, GoalResponse{
+			Error: "goal not found",
+		})
+	case errors.Is(err, domain.ErrGoalExists):
+		ctx.JSON(http.StatusConflict, GoalResponse{
+			Error: "goal of the metric already set for the period",
+		})
+	default:
+		if _, ok := validation.AsError(err); ok {
+			httpgin.AbortWithBindError[domain.Goal](ctx, err)
+			return
+		}
+		ctx.Error(err)
+		ctx.JSON(http.StatusInternalServerError, GoalResponse{
+			Error: "something happened on our end, please try at a later time",
+		})
+	}
+}
@@ -0,0 +1,454 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/validation"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/usecase"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/usecase/mocks"
+)
+
+func TestHandler_GetGoals(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	report := &domain.GoalReport{
+		SellerID:    2,
+		Period:      "2022-04",
+		ElapsedDays: 10,
+		PeriodDays:  30,
+		Goals: []domain.GoalProgress{
+			{Goal: domain.Goal{SellerID: 2, Period: "2022-04", Metric: domain.GoalMetricOrders, Target: 20}, Current: 10, Projected: 30, Attainment: 50, OnTrack: true},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		target   string
+		sellerId uint
+		usecase  func() usecase.GoalUsecase
+		wantCode int
+		want     GetGoalsResponse
+	}{
+		{
+			name:     "success",
+			target:   "/analytic/goals?period=2022-04",
+			sellerId: 2,
+			wantCode: http.StatusOK,
+			usecase: func() usecase.GoalUsecase {
+				m := mocks.NewMockGoalUsecase(ctrl)
+				m.EXPECT().GetGoals(gomock.Any(), int64(2), time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC)).Return(report, nil)
+				return m
+			},
+			want: GetGoalsResponse{
+				Data: report,
+			},
+		},
+		{
+			name:     "another seller requested",
+			target:   "/analytic/goals?seller_id=3&period=2022-04",
+			sellerId: 2,
+			wantCode: http.StatusOK,
+			usecase: func() usecase.GoalUsecase {
+				m := mocks.NewMockGoalUsecase(ctrl)
+				m.EXPECT().GetGoals(gomock.Any(), int64(2), time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC)).Return(report, nil)
+				return m
+			},
+			want: GetGoalsResponse{
+				Data: report,
+			},
+		},
+		{
+			name:     "not logged in",
+			target:   "/analytic/goals",
+			wantCode: http.StatusBadRequest,
+			usecase: func() usecase.GoalUsecase {
+				return mocks.NewMockGoalUsecase(ctrl)
+			},
+		},
+		{
+			name:     "invalid period",
+			target:   "/analytic/goals?period=2022-04-01",
+			sellerId: 2,
+			wantCode: http.StatusBadRequest,
+			usecase: func() usecase.GoalUsecase {
+				return mocks.NewMockGoalUsecase(ctrl)
+			},
+			want: GetGoalsResponse{
+				Error: "invalid period format, expect yyyy-mm",
+			},
+		},
+		{
+			name:     "error",
+			target:   "/analytic/goals",
+			sellerId: 2,
+			wantCode: http.StatusInternalServerError,
+			usecase: func() usecase.GoalUsecase {
+				m := mocks.NewMockGoalUsecase(ctrl)
+				m.EXPECT().GetGoals(gomock.Any(), int64(2), gomock.Any()).Return(nil, errors.New("mock error"))
+				return m
+			},
+			want: GetGoalsResponse{
+				Error: "something happened on our end, please try at a later time",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+
+			sut := NewAnalyticHandler(Params{
+				GoalUsecase: tt.usecase(),
+			})
+
+			req, _ := http.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.sellerId != 0 {
+				req.AddCookie(sellerSession(t, tt.sellerId))
+			}
+			router := ProvideGinEngine(sut)
+			router.ServeHTTP(recorder, req)
+
+			var response GetGoalsResponse
+			json.Unmarshal(recorder.Body.Bytes(), &response)
+
+			assert.Equal(t, tt.want, response)
+			assert.Equal(t, tt.wantCode, recorder.Code)
+		})
+	}
+}
+
+func TestHandler_CreateGoal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	goal := domain.Goal{SellerID: 2, Period: "2022-04", Metric: domain.GoalMetricRevenue, Target: 1000}
+
+	tests := []struct {
+		name     string
+		body     string
+		sellerId uint
+		usecase  func() usecase.GoalUsecase
+		wantCode int
+		want     GoalResponse
+	}{
+		{
+			name:     "success",
+			body:     `{"period":"2022-04","metric":"revenue","target":1000}`,
+			sellerId: 2,
+			wantCode: http.StatusCreated,
+			usecase: func() usecase.GoalUsecase {
+				m := mocks.NewMockGoalUsecase(ctrl)
+				m.EXPECT().CreateGoal(gomock.Any(), goal).Return(&goal, nil)
+				return m
+			},
+			want: GoalResponse{
+				Data: &goal,
+			},
+		},
+		{
+			name:     "another seller requested",
+			body:     `{"seller_id":3,"period":"2022-04","metric":"revenue","target":1000}`,
+			sellerId: 2,
+			wantCode: http.StatusCreated,
+			usecase: func() usecase.GoalUsecase {
+				m := mocks.NewMockGoalUsecase(ctrl)
+				m.EXPECT().CreateGoal(gomock.Any(), goal).Return(&goal, nil)
+				return m
+			},
+			want: GoalResponse{
+				Data: &goal,
+			},
+		},
+		{
+			name:     "not logged in",
+			body:     `{"period":"2022-04","metric":"revenue","target":1000}`,
+			wantCode: http.StatusBadRequest,
+			usecase: func() usecase.GoalUsecase {
+				return mocks.NewMockGoalUsecase(ctrl)
+			},
+		},
+		{
+			name:     "invalid metric",
+			body:     `{"period":"2022-04","metric":"profit","target":1000}`,
+			sellerId: 2,
+			wantCode: http.StatusUnprocessableEntity,
+			usecase: func() usecase.GoalUsecase {
+				return mocks.NewMockGoalUsecase(ctrl)
+			},
+			want: GoalResponse{
+				Error:  "invalid request",
+				Errors: []validation.FieldError{{Field: "metric", Message: "must be one of [revenue orders cancellation_rate]"}},
+			},
+		},
+		{
+			name:     "invalid period",
+			body:     `{"period":"April","metric":"revenue","target":1000}`,
+			sellerId: 2,
+			wantCode: http.StatusUnprocessableEntity,
+			usecase: func() usecase.GoalUsecase {
+				return mocks.NewMockGoalUsecase(ctrl)
+			},
+			want: GoalResponse{
+				Error:  "invalid request",
+				Errors: []validation.FieldError{{Field: "period", Message: "must be formatted as yyyy-mm"}},
+			},
+		},
+		{
+			name:     "invalid target",
+			body:     `{"period":"2022-04","metric":"cancellation_rate","target":101}`,
+			sellerId: 2,
+			wantCode: http.StatusUnprocessableEntity,
+			usecase: func() usecase.GoalUsecase {
+				m := mocks.NewMockGoalUsecase(ctrl)
+				m.EXPECT().CreateGoal(gomock.Any(), gomock.Any()).Return(nil, validation.NewError("target", "must be at most 100 for a rate"))
+				return m
+			},
+			want: GoalResponse{
+				Error:  "invalid request",
+				Errors: []validation.FieldError{{Field: "target", Message: "must be at most 100 for a rate"}},
+			},
+		},
+		{
+			name:     "invalid body",
+			body:     `{"target":"1000"}`,
+			sellerId: 2,
+			wantCode: http.StatusBadRequest,
+			usecase: func() usecase.GoalUsecase {
+				return mocks.NewMockGoalUsecase(ctrl)
+			},
+			want: GoalResponse{
+				Error: "invalid body type",
+			},
+		},
+		{
+			name:     "already set",
+			body:     `{"period":"2022-04","metric":"revenue","target":1000}`,
+			sellerId: 2,
+			wantCode: http.StatusConflict,
+			usecase: func() usecase.GoalUsecase {
+				m := mocks.NewMockGoalUsecase(ctrl)
+				m.EXPECT().CreateGoal(gomock.Any(), goal).Return(nil, domain.ErrGoalExists)
+				return m
+			},
+			want: GoalResponse{
+				Error: "goal of the metric already set for the period",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+
+			sut := NewAnalyticHandler(Params{
+				GoalUsecase: tt.usecase(),
+			})
+
+			req, _ := http.NewRequest(http.MethodPost, "/analytic/goals", strings.NewReader(tt.body))
+			if tt.sellerId != 0 {
+				req.AddCookie(sellerSession(t, tt.sellerId))
+			}
+			req.Header.Set("Content-Type", "application/json")
+			router := ProvideGinEngine(sut)
+			router.ServeHTTP(recorder, req)
+
+			var response GoalResponse
+			json.Unmarshal(recorder.Body.Bytes(), &response)
+
+			assert.Equal(t, tt.want, response)
+			assert.Equal(t, tt.wantCode, recorder.Code)
+		})
+	}
+}
+
+func TestHandler_UpdateGoal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	goal := &domain.Goal{SellerID: 2, Period: "2022-04", Metric: domain.GoalMetricOrders, Target: 50}
+
+	tests := []struct {
+		name     string
+		target   string
+		body     string
+		sellerId uint
+		usecase  func() usecase.GoalUsecase
+		wantCode int
+		want     GoalResponse
+	}{
+		{
+			name:     "success",
+			target:   "/analytic/goals/1",
+			body:     `{"target":50}`,
+			sellerId: 2,
+			wantCode: http.StatusOK,
+			usecase: func() usecase.GoalUsecase {
+				m := mocks.NewMockGoalUsecase(ctrl)
+				m.EXPECT().UpdateGoal(gomock.Any(), int64(2), uint(1), float64(50)).Return(goal, nil)
+				return m
+			},
+			want: GoalResponse{
+				Data: goal,
+			},
+		},
+		{
+			name:     "not logged in",
+			target:   "/analytic/goals/1",
+			body:     `{"target":50}`,
+			wantCode: http.StatusBadRequest,
+			usecase: func() usecase.GoalUsecase {
+				return mocks.NewMockGoalUsecase(ctrl)
+			},
+		},
+		{
+			name:     "invalid id",
+			target:   "/analytic/goals/abc",
+			body:     `{"target":50}`,
+			sellerId: 2,
+			wantCode: http.StatusBadRequest,
+			usecase: func() usecase.GoalUsecase {
+				return mocks.NewMockGoalUsecase(ctrl)
+			},
+			want: GoalResponse{
+				Error: "invalid id, expect a positive number",
+			},
+		},
+		{
+			name:     "missing target",
+			target:   "/analytic/goals/1",
+			body:     `{}`,
+			sellerId: 2,
+			wantCode: http.StatusUnprocessableEntity,
+			usecase: func() usecase.GoalUsecase {
+				return mocks.NewMockGoalUsecase(ctrl)
+			},
+			want: GoalResponse{
+				Error:  "invalid request",
+				Errors: []validation.FieldError{{Field: "target", Message: "is required"}},
+			},
+		},
+		{
+			name:     "goal of another seller",
+			target:   "/analytic/goals/1",
+			body:     `{"target":50}`,
+			sellerId: 2,
+			wantCode: http.StatusNotFound,
+			usecase: func() usecase.GoalUsecase {
+				m := mocks.NewMockGoalUsecase(ctrl)
+				m.EXPECT().UpdateGoal(gomock.Any(), int64(2), uint(1), float64(50)).Return(nil, domain.ErrGoalNotFound)
+				return m
+			},
+			want: GoalResponse{
+				Error: "goal not found",
+			},
+		},
+		{
+			name:     "error",
+			target:   "/analytic/goals/1",
+			body:     `{"target":50}`,
+			sellerId: 2,
+			wantCode: http.StatusInternalServerError,
+			usecase: func() usecase.GoalUsecase {
+				m := mocks.NewMockGoalUsecase(ctrl)
+				m.EXPECT().UpdateGoal(gomock.Any(), int64(2), uint(1), float64(50)).Return(nil, errors.New("mock error"))
+				return m
+			},
+			want: GoalResponse{
+				Error: "something happened on our end, please try at a later time",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+
+			sut := NewAnalyticHandler(Params{
+				GoalUsecase: tt.usecase(),
+			})
+
+			req, _ := http.NewRequest(http.MethodPut, tt.target, strings.NewReader(tt.body))
+			if tt.sellerId != 0 {
+				req.AddCookie(sellerSession(t, tt.sellerId))
+			}
+			req.Header.Set("Content-Type", "application/json")
+			router := ProvideGinEngine(sut)
+			router.ServeHTTP(recorder, req)
+
+			var response GoalResponse
+			json.Unmarshal(recorder.Body.Bytes(), &response)
+
+			assert.Equal(t, tt.want, response)
+			assert.Equal(t, tt.wantCode, recorder.Code)
+		})
+	}
+}
+
+func TestHandler_DeleteGoal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name     string
+		sellerId uint
+		usecase  func() usecase.GoalUsecase
+		wantCode int
+	}{
+		{
+			name:     "success",
+			sellerId: 2,
+			wantCode: http.StatusNoContent,
+			usecase: func() usecase.GoalUsecase {
+				m := mocks.NewMockGoalUsecase(ctrl)
+				m.EXPECT().DeleteGoal(gomock.Any(), int64(2), uint(1)).Return(nil)
+				return m
+			},
+		},
+		{
+			name:     "not logged in",
+			wantCode: http.StatusBadRequest,
+			usecase: func() usecase.GoalUsecase {
+				return mocks.NewMockGoalUsecase(ctrl)
+			},
+		},
+		{
+			name:     "goal of another seller",
+			sellerId: 2,
+			wantCode: http.StatusNotFound,
+			usecase: func() usecase.GoalUsecase {
+				m := mocks.NewMockGoalUsecase(ctrl)
+				m.EXPECT().DeleteGoal(gomock.Any(), int64(2), uint(1)).Return(domain.ErrGoalNotFound)
+				return m
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+
+			sut := NewAnalyticHandler(Params{
+				GoalUsecase: tt.usecase(),
+			})
+
+			req, _ := http.NewRequest(http.MethodDelete, "/analytic/goals/1", nil)
+			if tt.sellerId != 0 {
+				req.AddCookie(sellerSession(t, tt.sellerId))
+			}
+			router := ProvideGinEngine(sut)
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tt.wantCode, recorder.Code)
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package handler
 
 import (
+	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/validation"
 	"go.uber.org/fx"
 )
 
@@ -9,13 +11,49 @@ var Module = fx.Options(
 	fx.Provide(NewAnalyticHandler),
 	fx.Provide(ProvideGinEngine),
 	fx.Invoke(SubscribeStatistic),
+	fx.Invoke(SubscribeOrder),
+	fx.Invoke(ScheduleReports),
 )
 
 func ProvideGinEngine(handler Handler) *gin.Engine {
+	validation.UseJSONFieldNames()
+
 	router := gin.Default()
+	router.Use(sessions.Sessions(sessionName, sessionStore()))
 
 	//get analytic by date
 	router.GET("/analytic", handler.GetAnalyticByDate)
 
+	//get weekly buyer cohorts of the logged in seller
+	router.GET("/analytic/cohorts", handler.SellerAuth(), handler.GetCohorts)
+
+	//get number of buyers per RFM segment and the buyers of a segment of the logged in seller
+	router.GET("/analytic/segments", handler.SellerAuth(), handler.GetSegments)
+	router.GET("/analytic/segments/:segment", handler.SellerAuth(), handler.GetSegmentBuyers)
+
+	//get the same of the whole marketplace, admin only
+	router.GET("/analytic/marketplace/segments", handler.AdminAuth(), handler.GetSegments)
+	router.GET("/analytic/marketplace/segments/:segment", handler.AdminAuth(), handler.GetSegmentBuyers)
+
+	//get forecast revenue and orders of the next days of the logged in seller
+	router.GET("/analytic/forecast", handler.SellerAuth(), handler.GetForecast)
+
+	//get anomaly alerts of the logged in seller and acknowledge them
+	router.GET("/analytic/alerts", handler.SellerAuth(), handler.GetAlerts)
+	router.POST("/analytic/alerts/:id/acknowledge", handler.SellerAuth(), handler.AcknowledgeAlert)
+
+	//get progress of the logged in seller's monthly goals and manage them
+	router.GET("/analytic/goals", handler.SellerAuth(), handler.GetGoals)
+	router.POST("/analytic/goals", handler.SellerAuth(), handler.CreateGoal)
+	router.PUT("/analytic/goals/:id", handler.SellerAuth(), handler.UpdateGoal)
+	router.DELETE("/analytic/goals/:id", handler.SellerAuth(), handler.DeleteGoal)
+
+	//get where the logged in seller sits among the sellers of its category
+	router.GET("/analytic/benchmarks", handler.SellerAuth(), handler.GetBenchmark)
+
+	//get the logged in seller's generated summary reports and download them
+	router.GET("/analytic/reports", handler.SellerAuth(), handler.GetReports)
+	router.GET("/analytic/reports/:id/download", handler.SellerAuth(), handler.DownloadReport)
+
 	return router
 }
@@ -9,3 +9,24 @@ type GetAnalyticRequest struct {
 	Date string `json:"date"`
 }
 type GetAnalyticByDateResponse = httpdomain.ResponseModel[domain.Analytic]
+type GetCohortsResponse = httpdomain.ResponseModel[domain.CohortMatrix]
+type GetSegmentsResponse = httpdomain.ResponseModel[domain.SegmentSummary]
+type GetSegmentBuyersResponse = httpdomain.ResponseModel[domain.SegmentMembers]
+type GetForecastResponse = httpdomain.ResponseModel[domain.Forecast]
+type GetAlertsResponse = httpdomain.ResponseModel[[]domain.Alert]
+type AcknowledgeAlertResponse = httpdomain.ResponseModel[domain.Alert]
+
+type CreateGoalRequest struct {
+	Period string   `json:"period" binding:"required"`
+	Metric string   `json:"metric" binding:"required,oneof=revenue orders cancellation_rate"`
+	Target *float64 `json:"target" binding:"required"`
+}
+
+type UpdateGoalRequest struct {
+	Target *float64 `json:"target" binding:"required"`
+}
+
+type GetGoalsResponse = httpdomain.ResponseModel[domain.GoalReport]
+type GoalResponse = httpdomain.ResponseModel[domain.Goal]
+type GetBenchmarkResponse = httpdomain.ResponseModel[domain.Benchmark]
+type GetReportsResponse = httpdomain.ResponseModel[[]domain.Report]
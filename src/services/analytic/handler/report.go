@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	buyerdomain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+func (h *handler) GetReports(ctx *gin.Context) {
+	// reports of the logged in seller only
+	sellerId := int64(ctx.MustGet(buyerdomain.SellerKey).(uint))
+
+	period := ctx.Query("period")
+	if period != "" && !domain.IsReportPeriod(period) {
+		ctx.JSON(http.StatusBadRequest, GetReportsResponse{
+			Error: "invalid period, expect daily, weekly or monthly",
+		})
+		return
+	}
+
+	res, err := h.ReportUsecase.GetReports(ctx, sellerId, period)
+	if err != nil {
+		ctx.Error(err)
+		ctx.JSON(http.StatusInternalServerError, GetReportsResponse{
+			Error: "something happened on our end, please try at a later time",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, GetReportsResponse{
+		Data: &res,
+	})
+}
+
+// DownloadReport, the file of a generated report of the logged in seller
+func (h *handler) DownloadReport(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil || id == 0 {
+		ctx.JSON(http.StatusBadRequest, GetReportsResponse{
+			Error: "invalid id, expect a positive number",
+		})
+		return
+	}
+
+	sellerId := int64(ctx.MustGet(buyerdomain.SellerKey).(uint))
+	res, err := h.ReportUsecase.GetReport(ctx, sellerId, uint(id))
+	if errors.Is(err, domain.ErrReportNotFound) {
+		ctx.JSON(http.StatusNotFound, GetReportsResponse{
+			Error: "report not found",
+		})
+		return
+	}
+	if err != nil {
+		ctx.Error(err)
+		ctx.JSON(http.StatusInternalServerError, GetReportsResponse{
+			Error: "something happened on our end, please try at a later time",
+		})
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", res.FileName))
+	ctx.Data(http.StatusOK, domain.ReportContentType(res.Format), res.Content)
+}
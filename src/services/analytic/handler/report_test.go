@@ -0,0 +1,205 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/usecase"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/usecase/mocks"
+)
+
+func TestHandler_GetReports(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reports := []domain.Report{
+		{SellerID: 2, Period: domain.ReportPeriodDaily, Format: domain.ReportFormatCSV, FileName: "seller-2-daily-2022-01-01.csv", Size: 5, FromString: "2022-01-01", ToString: "2022-01-01"},
+	}
+
+	tests := []struct {
+		name     string
+		target   string
+		sellerId uint
+		usecase  func() usecase.ReportUsecase
+		wantCode int
+		want     GetReportsResponse
+	}{
+		{
+			name:     "success",
+			target:   "/analytic/reports?period=daily",
+			sellerId: 2,
+			wantCode: http.StatusOK,
+			usecase: func() usecase.ReportUsecase {
+				m := mocks.NewMockReportUsecase(ctrl)
+				m.EXPECT().GetReports(gomock.Any(), int64(2), domain.ReportPeriodDaily).Return(reports, nil)
+				return m
+			},
+			want: GetReportsResponse{
+				Data: &reports,
+			},
+		},
+		{
+			name:     "another seller requested",
+			target:   "/analytic/reports?seller_id=3&period=daily",
+			sellerId: 2,
+			wantCode: http.StatusOK,
+			usecase: func() usecase.ReportUsecase {
+				m := mocks.NewMockReportUsecase(ctrl)
+				m.EXPECT().GetReports(gomock.Any(), int64(2), domain.ReportPeriodDaily).Return(reports, nil)
+				return m
+			},
+			want: GetReportsResponse{
+				Data: &reports,
+			},
+		},
+		{
+			name:     "not logged in",
+			target:   "/analytic/reports",
+			wantCode: http.StatusBadRequest,
+			usecase: func() usecase.ReportUsecase {
+				return mocks.NewMockReportUsecase(ctrl)
+			},
+		},
+		{
+			name:     "invalid period",
+			target:   "/analytic/reports?period=yearly",
+			sellerId: 2,
+			wantCode: http.StatusBadRequest,
+			usecase: func() usecase.ReportUsecase {
+				return mocks.NewMockReportUsecase(ctrl)
+			},
+			want: GetReportsResponse{
+				Error: "invalid period, expect daily, weekly or monthly",
+			},
+		},
+		{
+			name:     "error",
+			target:   "/analytic/reports",
+			sellerId: 2,
+			wantCode: http.StatusInternalServerError,
+			usecase: func() usecase.ReportUsecase {
+				m := mocks.NewMockReportUsecase(ctrl)
+				m.EXPECT().GetReports(gomock.Any(), int64(2), "").Return(nil, errors.New("mock error"))
+				return m
+			},
+			want: GetReportsResponse{
+				Error: "something happened on our end, please try at a later time",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+
+			sut := NewAnalyticHandler(Params{
+				ReportUsecase: tt.usecase(),
+			})
+
+			req, _ := http.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.sellerId != 0 {
+				req.AddCookie(sellerSession(t, tt.sellerId))
+			}
+			router := ProvideGinEngine(sut)
+			router.ServeHTTP(recorder, req)
+
+			var response GetReportsResponse
+			json.Unmarshal(recorder.Body.Bytes(), &response)
+
+			assert.Equal(t, tt.want, response)
+			assert.Equal(t, tt.wantCode, recorder.Code)
+		})
+	}
+}
+
+func TestHandler_DownloadReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	report := &domain.Report{SellerID: 2, Format: domain.ReportFormatCSV, FileName: "seller-2-daily-2022-01-01.csv", Content: []byte("date\n")}
+
+	tests := []struct {
+		name            string
+		target          string
+		sellerId        uint
+		usecase         func() usecase.ReportUsecase
+		wantCode        int
+		wantBody        string
+		wantContentType string
+	}{
+		{
+			name:     "success",
+			target:   "/analytic/reports/1/download",
+			sellerId: 2,
+			wantCode: http.StatusOK,
+			usecase: func() usecase.ReportUsecase {
+				m := mocks.NewMockReportUsecase(ctrl)
+				m.EXPECT().GetReport(gomock.Any(), int64(2), uint(1)).Return(report, nil)
+				return m
+			},
+			wantBody:        "date\n",
+			wantContentType: "text/csv; charset=utf-8",
+		},
+		{
+			name:     "invalid id",
+			target:   "/analytic/reports/abc/download",
+			sellerId: 2,
+			wantCode: http.StatusBadRequest,
+			usecase: func() usecase.ReportUsecase {
+				return mocks.NewMockReportUsecase(ctrl)
+			},
+			wantBody:        `{"error":"invalid id, expect a positive number"}`,
+			wantContentType: "application/json; charset=utf-8",
+		},
+		{
+			name:     "not logged in",
+			target:   "/analytic/reports/1/download",
+			wantCode: http.StatusBadRequest,
+			usecase: func() usecase.ReportUsecase {
+				return mocks.NewMockReportUsecase(ctrl)
+			},
+			wantBody:        `{"errors":"no authentication found"}`,
+			wantContentType: "application/json; charset=utf-8",
+		},
+		{
+			name:     "report of another seller",
+			target:   "/analytic/reports/1/download",
+			sellerId: 2,
+			wantCode: http.StatusNotFound,
+			usecase: func() usecase.ReportUsecase {
+				m := mocks.NewMockReportUsecase(ctrl)
+				m.EXPECT().GetReport(gomock.Any(), int64(2), uint(1)).Return(nil, domain.ErrReportNotFound)
+				return m
+			},
+			wantBody:        `{"error":"report not found"}`,
+			wantContentType: "application/json; charset=utf-8",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+
+			sut := NewAnalyticHandler(Params{
+				ReportUsecase: tt.usecase(),
+			})
+
+			req, _ := http.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.sellerId != 0 {
+				req.AddCookie(sellerSession(t, tt.sellerId))
+			}
+			router := ProvideGinEngine(sut)
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tt.wantCode, recorder.Code)
+			assert.Equal(t, tt.wantBody, recorder.Body.String())
+			assert.Equal(t, tt.wantContentType, recorder.Header().Get("Content-Type"))
+		})
+	}
+}
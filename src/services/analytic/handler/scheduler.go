@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/cron"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/usecase"
+	"go.uber.org/fx"
+)
+
+// ScheduleReports, generates the reports of every configured schedule in the background for as long as the
+// service runs, an invalid schedule fails the start of the service
+func ScheduleReports(lc fx.Lifecycle, reportUsecase usecase.ReportUsecase, reportCfg domain.ReportConfig) error {
+	if len(reportCfg.Schedules) == 0 {
+		log.Println("scheduled reports are disabled")
+		return nil
+	}
+
+	schedules := make([]*cron.Schedule, len(reportCfg.Schedules))
+	for i, s := range reportCfg.Schedules {
+		if !domain.IsReportPeriod(s.Period) {
+			return fmt.Errorf("invalid report period %q", s.Period)
+		}
+		schedule, err := cron.Parse(s.Cron)
+		if err != nil {
+			return err
+		}
+		schedules[i] = schedule
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			for i, schedule := range schedules {
+				go runReports(ctx, reportUsecase, reportCfg.Schedules[i].Period, schedule)
+			}
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			cancel()
+			return nil
+		},
+	})
+	return nil
+}
+
+// runReports, generates the reports of period every time the schedule is due until ctx is done
+func runReports(ctx context.Context, reportUsecase usecase.ReportUsecase, period string, schedule *cron.Schedule) {
+	for {
+		next := schedule.Next(time.Now())
+		if next.IsZero() {
+			log.Printf("[ScheduleReports] %s schedule never runs", period)
+			return
+		}
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case now := <-timer.C:
+			if err := reportUsecase.GenerateReports(ctx, period, now); err != nil {
+				log.Println("[ScheduleReports] error", period, err)
+			}
+		}
+	}
+}
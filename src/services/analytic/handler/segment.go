@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	buyerdomain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+func (h *handler) GetSegments(ctx *gin.Context) {
+	sellerId := segmentSellerID(ctx)
+
+	res, err := h.SegmentUsecase.GetSegments(ctx, sellerId)
+	if err != nil {
+		ctx.Error(err)
+		ctx.JSON(http.StatusInternalServerError, GetSegmentsResponse{
+			Error: "something happened on our end, please try at a later time",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, GetSegmentsResponse{
+		Data: res,
+	})
+}
+
+func (h *handler) GetSegmentBuyers(ctx *gin.Context) {
+	sellerId := segmentSellerID(ctx)
+
+	segment := ctx.Param("segment")
+	if !domain.IsSegment(segment) {
+		ctx.JSON(http.StatusNotFound, GetSegmentBuyersResponse{
+			Error: "segment not found",
+		})
+		return
+	}
+
+	res, err := h.SegmentUsecase.GetSegmentBuyers(ctx, sellerId, segment)
+	if err != nil {
+		ctx.Error(err)
+		ctx.JSON(http.StatusInternalServerError, GetSegmentBuyersResponse{
+			Error: "something happened on our end, please try at a later time",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, GetSegmentBuyersResponse{
+		Data: res,
+	})
+}
+
+// segmentSellerID, the seller whose buyers are segmented, the logged in seller or the whole marketplace for an admin
+func segmentSellerID(ctx *gin.Context) int64 {
+	if ctx.GetBool(adminKey) {
+		return domain.MarketplaceSellerID
+	}
+	return int64(ctx.MustGet(buyerdomain.SellerKey).(uint))
+}
@@ -0,0 +1,261 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/usecase"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/usecase/mocks"
+)
+
+func TestHandler_GetSegments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name     string
+		target   string
+		sellerId uint
+		token    string
+		usecase  func() usecase.SegmentUsecase
+		wantCode int
+		want     GetSegmentsResponse
+	}{
+		{
+			name:     "success",
+			target:   "/analytic/segments",
+			sellerId: 2,
+			wantCode: http.StatusOK,
+			usecase: func() usecase.SegmentUsecase {
+				m := mocks.NewMockSegmentUsecase(ctrl)
+				m.EXPECT().GetSegments(gomock.Any(), int64(2)).Return(&domain.SegmentSummary{
+					SellerID: 2,
+					Buyers:   1,
+					Segments: []domain.SegmentCount{{Segment: domain.SegmentLost, Buyers: 1, Share: 100}},
+				}, nil)
+				return m
+			},
+			want: GetSegmentsResponse{
+				Data: &domain.SegmentSummary{
+					SellerID: 2,
+					Buyers:   1,
+					Segments: []domain.SegmentCount{{Segment: domain.SegmentLost, Buyers: 1, Share: 100}},
+				},
+			},
+		},
+		{
+			name:     "another seller requested",
+			target:   "/analytic/segments?seller_id=3",
+			sellerId: 2,
+			wantCode: http.StatusOK,
+			usecase: func() usecase.SegmentUsecase {
+				m := mocks.NewMockSegmentUsecase(ctrl)
+				m.EXPECT().GetSegments(gomock.Any(), int64(2)).Return(&domain.SegmentSummary{SellerID: 2}, nil)
+				return m
+			},
+			want: GetSegmentsResponse{
+				Data: &domain.SegmentSummary{SellerID: 2},
+			},
+		},
+		{
+			name:     "not logged in",
+			target:   "/analytic/segments",
+			wantCode: http.StatusBadRequest,
+			usecase: func() usecase.SegmentUsecase {
+				return mocks.NewMockSegmentUsecase(ctrl)
+			},
+		},
+		{
+			name:     "marketplace",
+			target:   "/analytic/marketplace/segments",
+			token:    "secret",
+			wantCode: http.StatusOK,
+			usecase: func() usecase.SegmentUsecase {
+				m := mocks.NewMockSegmentUsecase(ctrl)
+				m.EXPECT().GetSegments(gomock.Any(), domain.MarketplaceSellerID).Return(&domain.SegmentSummary{}, nil)
+				return m
+			},
+			want: GetSegmentsResponse{
+				Data: &domain.SegmentSummary{},
+			},
+		},
+		{
+			name:     "marketplace of a seller",
+			target:   "/analytic/marketplace/segments",
+			sellerId: 2,
+			wantCode: http.StatusUnauthorized,
+			usecase: func() usecase.SegmentUsecase {
+				return mocks.NewMockSegmentUsecase(ctrl)
+			},
+		},
+		{
+			name:     "error",
+			target:   "/analytic/segments",
+			sellerId: 2,
+			wantCode: http.StatusInternalServerError,
+			usecase: func() usecase.SegmentUsecase {
+				m := mocks.NewMockSegmentUsecase(ctrl)
+				m.EXPECT().GetSegments(gomock.Any(), int64(2)).Return(nil, errors.New("mock error"))
+				return m
+			},
+			want: GetSegmentsResponse{
+				Error: "something happened on our end, please try at a later time",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+
+			sut := NewAnalyticHandler(Params{
+				SegmentUsecase: tt.usecase(),
+				AdminCfg:       domain.AdminConfig{Token: "secret"},
+			})
+
+			req, _ := http.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.sellerId != 0 {
+				req.AddCookie(sellerSession(t, tt.sellerId))
+			}
+			if tt.token != "" {
+				req.Header.Set(AdminTokenHeader, tt.token)
+			}
+			router := ProvideGinEngine(sut)
+			router.ServeHTTP(recorder, req)
+
+			var response GetSegmentsResponse
+			json.Unmarshal(recorder.Body.Bytes(), &response)
+
+			assert.Equal(t, tt.want, response)
+			assert.Equal(t, tt.wantCode, recorder.Code)
+		})
+	}
+}
+
+func TestHandler_GetSegmentBuyers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name     string
+		target   string
+		sellerId uint
+		token    string
+		usecase  func() usecase.SegmentUsecase
+		wantCode int
+		want     GetSegmentBuyersResponse
+	}{
+		{
+			name:     "success",
+			target:   "/analytic/segments/at_risk",
+			sellerId: 2,
+			wantCode: http.StatusOK,
+			usecase: func() usecase.SegmentUsecase {
+				m := mocks.NewMockSegmentUsecase(ctrl)
+				m.EXPECT().GetSegmentBuyers(gomock.Any(), int64(2), domain.SegmentAtRisk).Return(&domain.SegmentMembers{
+					SellerID: 2,
+					Segment:  domain.SegmentAtRisk,
+					Buyers:   []domain.BuyerRFM{{BuyerID: 3, Segment: domain.SegmentAtRisk}},
+				}, nil)
+				return m
+			},
+			want: GetSegmentBuyersResponse{
+				Data: &domain.SegmentMembers{
+					SellerID: 2,
+					Segment:  domain.SegmentAtRisk,
+					Buyers:   []domain.BuyerRFM{{BuyerID: 3, Segment: domain.SegmentAtRisk}},
+				},
+			},
+		},
+		{
+			name:     "unknown segment",
+			target:   "/analytic/segments/whales",
+			sellerId: 2,
+			wantCode: http.StatusNotFound,
+			usecase: func() usecase.SegmentUsecase {
+				return mocks.NewMockSegmentUsecase(ctrl)
+			},
+			want: GetSegmentBuyersResponse{
+				Error: "segment not found",
+			},
+		},
+		{
+			name:     "not logged in",
+			target:   "/analytic/segments/lost",
+			wantCode: http.StatusBadRequest,
+			usecase: func() usecase.SegmentUsecase {
+				return mocks.NewMockSegmentUsecase(ctrl)
+			},
+		},
+		{
+			name:     "marketplace",
+			target:   "/analytic/marketplace/segments/lost",
+			token:    "secret",
+			wantCode: http.StatusOK,
+			usecase: func() usecase.SegmentUsecase {
+				m := mocks.NewMockSegmentUsecase(ctrl)
+				m.EXPECT().GetSegmentBuyers(gomock.Any(), domain.MarketplaceSellerID, domain.SegmentLost).Return(&domain.SegmentMembers{Segment: domain.SegmentLost}, nil)
+				return m
+			},
+			want: GetSegmentBuyersResponse{
+				Data: &domain.SegmentMembers{Segment: domain.SegmentLost},
+			},
+		},
+		{
+			name:     "marketplace with a wrong token",
+			target:   "/analytic/marketplace/segments/lost",
+			token:    "wrong",
+			wantCode: http.StatusUnauthorized,
+			usecase: func() usecase.SegmentUsecase {
+				return mocks.NewMockSegmentUsecase(ctrl)
+			},
+		},
+		{
+			name:     "error",
+			target:   "/analytic/segments/lost",
+			sellerId: 2,
+			wantCode: http.StatusInternalServerError,
+			usecase: func() usecase.SegmentUsecase {
+				m := mocks.NewMockSegmentUsecase(ctrl)
+				m.EXPECT().GetSegmentBuyers(gomock.Any(), int64(2), domain.SegmentLost).Return(nil, errors.New("mock error"))
+				return m
+			},
+			want: GetSegmentBuyersResponse{
+				Error: "something happened on our end, please try at a later time",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+
+			sut := NewAnalyticHandler(Params{
+				SegmentUsecase: tt.usecase(),
+				AdminCfg:       domain.AdminConfig{Token: "secret"},
+			})
+
+			req, _ := http.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.sellerId != 0 {
+				req.AddCookie(sellerSession(t, tt.sellerId))
+			}
+			if tt.token != "" {
+				req.Header.Set(AdminTokenHeader, tt.token)
+			}
+			router := ProvideGinEngine(sut)
+			router.ServeHTTP(recorder, req)
+
+			var response GetSegmentBuyersResponse
+			json.Unmarshal(recorder.Body.Bytes(), &response)
+
+			assert.Equal(t, tt.want, response)
+			assert.Equal(t, tt.wantCode, recorder.Code)
+		})
+	}
+}
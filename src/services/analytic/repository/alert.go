@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/messagequeue"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// alertsLimit, most alerts listed at once
+const alertsLimit = 100
+
+type AlertRepository interface {
+	CreateAlert(ctx context.Context, alert domain.Alert) (*domain.Alert, error)
+	GetAlerts(ctx context.Context, sellerId int64, acknowledged *bool) ([]domain.Alert, error)
+	AcknowledgeAlert(ctx context.Context, sellerId int64, id uint) (*domain.Alert, error)
+	PublishAlertEvent(ctx context.Context, event domain.PayloadEventAlert) error
+}
+
+type alertRepository struct {
+	db        *gorm.DB
+	publisher messagequeue.Publisher[domain.PayloadEventAlert]
+}
+
+func NewAlertRepository(db *gorm.DB, publisher messagequeue.Publisher[domain.PayloadEventAlert]) AlertRepository {
+	return &alertRepository{
+		db:        db,
+		publisher: publisher,
+	}
+}
+
+// CreateAlert, create alert, returns nil when the seller already has an alert of the metric that day
+func (ar *alertRepository) CreateAlert(ctx context.Context, alert domain.Alert) (*domain.Alert, error) {
+	result := ar.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&alert)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	alert.DateString = time.Time(alert.Date).Format(domain.AnalyticDateFormat)
+	return &alert, nil
+}
+
+// GetAlerts, the latest alerts of the seller, only the acknowledged or unacknowledged ones when acknowledged is set
+func (ar *alertRepository) GetAlerts(ctx context.Context, sellerId int64, acknowledged *bool) ([]domain.Alert, error) {
+	var result []domain.Alert
+
+	query := ar.db.WithContext(ctx).Where("seller_id = ?", sellerId)
+	if acknowledged != nil && *acknowledged {
+		query = query.Where("acknowledged_at IS NOT NULL")
+	} else if acknowledged != nil {
+		query = query.Where("acknowledged_at IS NULL")
+	}
+	if err := query.Order("date DESC, id DESC").Limit(alertsLimit).Find(&result).Error; err != nil {
+		return nil, err
+	}
+
+	for i := range result {
+		result[i].DateString = time.Time(result[i].Date).Format(domain.AnalyticDateFormat)
+	}
+	return result, nil
+}
+
+// AcknowledgeAlert, marks the alert of the seller as acknowledged now, an alert acknowledged before keeps its first
+// acknowledgement. The alert of another seller is not found
+func (ar *alertRepository) AcknowledgeAlert(ctx context.Context, sellerId int64, id uint) (*domain.Alert, error) {
+	var result domain.Alert
+
+	query := ar.db.WithContext(ctx)
+	if err := query.Where("id = ? AND seller_id = ?", id, sellerId).First(&result).Error; err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	} else if err == gorm.ErrRecordNotFound {
+		return nil, domain.ErrAlertNotFound
+	}
+
+	if result.AcknowledgedAt == nil {
+		now := time.Now()
+		if err := query.Model(&result).Update("acknowledged_at", now).Error; err != nil {
+			return nil, err
+		}
+		result.AcknowledgedAt = &now
+	}
+
+	result.DateString = time.Time(result.Date).Format(domain.AnalyticDateFormat)
+	return &result, nil
+}
+
+// PublishAlertEvent, publishes the raised alert
+func (ar *alertRepository) PublishAlertEvent(ctx context.Context, event domain.PayloadEventAlert) error {
+	return ar.publisher.Publish(ctx, messagequeue.PublishConfig{}, event)
+}
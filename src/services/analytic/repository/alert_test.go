@@ -0,0 +1,235 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"gorm.io/datatypes"
+)
+
+func Test_alertRepository_CreateAlert(t *testing.T) {
+	date := datatypes.Date(time.Date(2022, 1, 1, 0, 0, 0, 0, time.Local))
+	alert := domain.Alert{
+		SellerID: 2,
+		Metric:   domain.AlertMetricCancellationRate,
+		Reason:   domain.AlertReasonThreshold,
+		Value:    60,
+		Baseline: 10,
+		ZScore:   5,
+		Date:     date,
+	}
+	query := regexp.QuoteMeta(
+		`INSERT INTO "alerts" ("created_at","updated_at","deleted_at","seller_id","metric","reason","value","baseline","z_score","acknowledged_at","date") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11) ON CONFLICT DO NOTHING RETURNING "id"`)
+
+	tests := []struct {
+		name    string
+		want    *domain.Alert
+		wantErr bool
+		mock    func()
+	}{
+		{
+			name: "success",
+			want: func() *domain.Alert {
+				want := alert
+				want.ID = 1
+				want.DateString = "2022-01-01"
+				return &want
+			}(),
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), int64(2), domain.AlertMetricCancellationRate, domain.AlertReasonThreshold, float64(60), float64(10), float64(5), nil, date).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "already alerted",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "error",
+			wantErr: true,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WillReturnError(errors.New("mock error"))
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			ar := NewAlertRepository(gormdb, nil)
+			res, err := ar.CreateAlert(context.TODO(), alert)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			if tt.want != nil {
+				require.NotNil(t, res)
+				res.CreatedAt, res.UpdatedAt = time.Time{}, time.Time{}
+			}
+			assert.Equal(t, tt.want, res)
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_alertRepository_GetAlerts(t *testing.T) {
+	date := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	acknowledged, unacknowledged := true, false
+
+	tests := []struct {
+		name         string
+		acknowledged *bool
+		want         []domain.Alert
+		wantErr      bool
+		mock         func()
+	}{
+		{
+			name: "success",
+			want: []domain.Alert{
+				{SellerID: 2, Metric: domain.AlertMetricNetRevenue, Reason: domain.AlertReasonZScore, Date: datatypes.Date(date), DateString: "2022-01-01"},
+			},
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					`SELECT * FROM "alerts" WHERE seller_id = $1 AND "alerts"."deleted_at" IS NULL ORDER BY date DESC, id DESC LIMIT 100`)).
+					WithArgs(int64(2)).
+					WillReturnRows(sqlmock.NewRows([]string{"seller_id", "metric", "reason", "date"}).
+						AddRow(2, domain.AlertMetricNetRevenue, domain.AlertReasonZScore, date))
+			},
+		},
+		{
+			name:         "acknowledged",
+			acknowledged: &acknowledged,
+			want:         []domain.Alert{},
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					`SELECT * FROM "alerts" WHERE seller_id = $1 AND acknowledged_at IS NOT NULL AND "alerts"."deleted_at" IS NULL ORDER BY date DESC, id DESC LIMIT 100`)).
+					WithArgs(int64(2)).
+					WillReturnRows(sqlmock.NewRows([]string{"seller_id"}))
+			},
+		},
+		{
+			name:         "unacknowledged",
+			acknowledged: &unacknowledged,
+			want:         []domain.Alert{},
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					`SELECT * FROM "alerts" WHERE seller_id = $1 AND acknowledged_at IS NULL AND "alerts"."deleted_at" IS NULL ORDER BY date DESC, id DESC LIMIT 100`)).
+					WithArgs(int64(2)).
+					WillReturnRows(sqlmock.NewRows([]string{"seller_id"}))
+			},
+		},
+		{
+			name:    "error",
+			wantErr: true,
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "alerts"`)).
+					WillReturnError(errors.New("mock error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			ar := NewAlertRepository(gormdb, nil)
+			res, err := ar.GetAlerts(context.TODO(), 2, tt.acknowledged)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, res)
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_alertRepository_AcknowledgeAlert(t *testing.T) {
+	date := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	selectQuery := regexp.QuoteMeta(
+		`SELECT * FROM "alerts" WHERE (id = $1 AND seller_id = $2) AND "alerts"."deleted_at" IS NULL ORDER BY "alerts"."id" LIMIT 1`)
+
+	tests := []struct {
+		name    string
+		wantErr error
+		mock    func()
+	}{
+		{
+			name: "success",
+			mock: func() {
+				mock.ExpectQuery(selectQuery).
+					WithArgs(uint(1), int64(2)).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "seller_id", "date"}).AddRow(1, 2, date))
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(
+					`UPDATE "alerts" SET "acknowledged_at"=$1,"updated_at"=$2 WHERE "alerts"."deleted_at" IS NULL AND "id" = $3`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "already acknowledged",
+			mock: func() {
+				mock.ExpectQuery(selectQuery).
+					WithArgs(uint(1), int64(2)).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "seller_id", "acknowledged_at", "date"}).AddRow(1, 2, date, date))
+			},
+		},
+		{
+			name:    "not found or of another seller",
+			wantErr: domain.ErrAlertNotFound,
+			mock: func() {
+				mock.ExpectQuery(selectQuery).
+					WithArgs(uint(1), int64(2)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			},
+		},
+		{
+			name:    "error",
+			wantErr: errors.New("mock error"),
+			mock: func() {
+				mock.ExpectQuery(selectQuery).
+					WithArgs(uint(1), int64(2)).
+					WillReturnError(errors.New("mock error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			ar := NewAlertRepository(gormdb, nil)
+			res, err := ar.AcknowledgeAlert(context.TODO(), 2, 1)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr.Error(), err.Error())
+				assert.Nil(t, res)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, res.AcknowledgedAt)
+				assert.Equal(t, "2022-01-01", res.DateString)
+			}
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
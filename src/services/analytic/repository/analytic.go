@@ -10,9 +10,10 @@ import (
 )
 
 type AnalyticRepository interface {
-	GetAnalyticByDate(ctx context.Context, date time.Time) (*domain.Analytic, error)
+	GetAnalyticByDate(ctx context.Context, sellerId int64, date time.Time) (*domain.Analytic, error)
 	CreateAnalytic(ctx context.Context, analytic domain.Analytic) (*domain.Analytic, error)
 	UpdateAnalytic(ctx context.Context, analytic domain.Analytic) (*domain.Analytic, error)
+	GetAnalytics(ctx context.Context, sellerId int64, from, to time.Time) ([]domain.Analytic, error)
 }
 
 type analyticRepository struct {
@@ -26,11 +27,11 @@ func NewAnalyticRepository(db *gorm.DB) AnalyticRepository {
 }
 
 // GetAnalyticByDate, get analytic by date
-func (ar *analyticRepository) GetAnalyticByDate(ctx context.Context, date time.Time) (*domain.Analytic, error) {
+func (ar *analyticRepository) GetAnalyticByDate(ctx context.Context, sellerId int64, date time.Time) (*domain.Analytic, error) {
 	result := domain.Analytic{}
 
 	query := ar.db.WithContext(ctx)
-	if err := query.Where("Date = ?", datatypes.Date(date)).First(&result).Error; err != nil && err != gorm.ErrRecordNotFound {
+	if err := query.Where("seller_id = ?", sellerId).Where("Date = ?", datatypes.Date(date)).First(&result).Error; err != nil && err != gorm.ErrRecordNotFound {
 		return nil, err
 	} else if err == gorm.ErrRecordNotFound {
 		return nil, nil
@@ -51,22 +52,79 @@ func (ar *analyticRepository) CreateAnalytic(ctx context.Context, analytic domai
 
 // UpdateAnalytic update analytic
 func (ar *analyticRepository) UpdateAnalytic(ctx context.Context, analytic domain.Analytic) (*domain.Analytic, error) {
-	res, err := ar.GetAnalyticByDate(ctx, time.Time(analytic.Date))
+	res, err := ar.GetAnalyticByDate(ctx, analytic.SellerID, time.Time(analytic.Date))
 	if err != nil {
 		return nil, err
 	}
 
-	if analytic.AverageOrderValue != 0 {
+	if !analytic.AverageOrderValue.IsZero() {
 		res.AverageOrderValue = analytic.AverageOrderValue
 	}
 	if analytic.SalesConvertionRate != 0 {
 		res.SalesConvertionRate = analytic.SalesConvertionRate
 	}
+	if analytic.ViewToCartRate != 0 {
+		res.ViewToCartRate = analytic.ViewToCartRate
+	}
+	if analytic.CartToOrderRate != 0 {
+		res.CartToOrderRate = analytic.CartToOrderRate
+	}
+	if analytic.CheckoutRate != 0 {
+		res.CheckoutRate = analytic.CheckoutRate
+	}
+	if analytic.OrderCompletionRate != 0 {
+		res.OrderCompletionRate = analytic.OrderCompletionRate
+	}
 	if analytic.CancellationOrderRate != 0 {
 		res.CancellationOrderRate = analytic.CancellationOrderRate
 	}
+	if analytic.RefundRate != 0 {
+		res.RefundRate = analytic.RefundRate
+	}
+	// either shipping figure may be zero on a day with shipments, but an order shipped without lead time is on time
+	if analytic.AverageLeadTimeHours != 0 || analytic.OnTimeShippingRate != 0 {
+		res.AverageLeadTimeHours = analytic.AverageLeadTimeHours
+		res.OnTimeShippingRate = analytic.OnTimeShippingRate
+	}
+	// a fully refunded day has a zero net revenue, only its currency tells it was calculated
+	if analytic.NetRevenue.Currency != "" {
+		res.NetRevenue = analytic.NetRevenue
+	}
+	if analytic.GrossRevenue.Currency != "" {
+		res.GrossRevenue = analytic.GrossRevenue
+	}
+	if analytic.DiscountRate != 0 {
+		res.DiscountRate = analytic.DiscountRate
+	}
+	if analytic.UniqueBuyers != 0 {
+		res.UniqueBuyers = analytic.UniqueBuyers
+		res.NewBuyers = analytic.NewBuyers
+		res.ReturningBuyers = analytic.ReturningBuyers
+		res.RepeatPurchaseRate = analytic.RepeatPurchaseRate
+	}
+	// every review has at least one star, a zero average means the day has no reviews
+	if analytic.AverageRating != 0 {
+		res.AverageRating = analytic.AverageRating
+		res.RatingShares = analytic.RatingShares
+		res.ReviewRate = analytic.ReviewRate
+	}
 	ar.db.Save(&res)
 
 	res.DateString = time.Time(res.Date).Format(domain.AnalyticDateFormat)
 	return res, nil
 }
+
+// GetAnalytics, analytic of the seller's days from up to but excluding to, oldest first
+func (ar *analyticRepository) GetAnalytics(ctx context.Context, sellerId int64, from, to time.Time) ([]domain.Analytic, error) {
+	var result []domain.Analytic
+
+	query := ar.db.WithContext(ctx)
+	if err := query.Where("seller_id = ?", sellerId).Where("date >= ? AND date < ?", datatypes.Date(from), datatypes.Date(to)).Order("date").Find(&result).Error; err != nil {
+		return nil, err
+	}
+
+	for i := range result {
+		result[i].DateString = time.Time(result[i].Date).Format(domain.AnalyticDateFormat)
+	}
+	return result, nil
+}
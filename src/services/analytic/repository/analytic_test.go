@@ -13,6 +13,7 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
 	"gorm.io/datatypes"
 	"gorm.io/driver/postgres"
@@ -58,16 +59,16 @@ func Test_analyticRepository_GetAnalyticByDate(t *testing.T) {
 			name: "success",
 			date: date,
 			want: &domain.Analytic{
-				AverageOrderValue: 100,
+				AverageOrderValue: money.New(100, "IDR"),
 				Date:              datatypes.Date(date),
 				DateString:        "2022-01-01",
 			},
 			mock: func() {
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT * FROM "analytics" WHERE Date = $1 AND "analytics"."deleted_at" IS NULL ORDER BY "analytics"."id" LIMIT 1`)).
-					WithArgs(date).
-					WillReturnRows(sqlmock.NewRows([]string{"AverageOrderValue", "Date"}).
-						AddRow(100, date))
+					`SELECT * FROM "analytics" WHERE seller_id = $1 AND Date = $2 AND "analytics"."deleted_at" IS NULL ORDER BY "analytics"."id" LIMIT 1`)).
+					WithArgs(int64(0), date).
+					WillReturnRows(sqlmock.NewRows([]string{"average_order_value_amount", "average_order_value_currency", "Date"}).
+						AddRow(100, "IDR", date))
 			},
 		},
 		{
@@ -77,8 +78,8 @@ func Test_analyticRepository_GetAnalyticByDate(t *testing.T) {
 			wantErr: true,
 			mock: func() {
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT * FROM "analytics" WHERE Date = $1 AND "analytics"."deleted_at" IS NULL ORDER BY "analytics"."id" LIMIT 1`)).
-					WithArgs(date).WillReturnError(errors.New("mock error"))
+					`SELECT * FROM "analytics" WHERE seller_id = $1 AND Date = $2 AND "analytics"."deleted_at" IS NULL ORDER BY "analytics"."id" LIMIT 1`)).
+					WithArgs(int64(0), date).WillReturnError(errors.New("mock error"))
 			},
 		},
 	}
@@ -86,7 +87,7 @@ func Test_analyticRepository_GetAnalyticByDate(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mock()
 			ar := NewAnalyticRepository(gormdb)
-			res, err := ar.GetAnalyticByDate(context.TODO(), tt.date)
+			res, err := ar.GetAnalyticByDate(context.TODO(), 0, tt.date)
 			if tt.wantErr {
 				require.Error(t, err)
 			} else {
@@ -111,13 +112,13 @@ func Test_analyticRepository_CreateAnalytic(t *testing.T) {
 		{
 			name: "success",
 			analytic: domain.Analytic{
-				AverageOrderValue:     100,
+				AverageOrderValue:     money.New(100, "IDR"),
 				SalesConvertionRate:   90,
 				CancellationOrderRate: 10,
 				Date:                  date,
 			},
 			want: &domain.Analytic{
-				AverageOrderValue:     100,
+				AverageOrderValue:     money.New(100, "IDR"),
 				SalesConvertionRate:   90,
 				CancellationOrderRate: 10,
 				Date:                  date,
@@ -125,8 +126,8 @@ func Test_analyticRepository_CreateAnalytic(t *testing.T) {
 			mock: func() {
 				mock.ExpectBegin()
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`INSERT INTO "analytics" ("created_at","updated_at","deleted_at","average_order_value","sales_convertion_rate","cancellation_order_rate","date") VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`)).
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), float64(100), float64(90), float64(10), date).
+					`INSERT INTO "analytics" ("created_at","updated_at","deleted_at","seller_id","average_order_value_amount","average_order_value_currency","sales_convertion_rate","cancellation_order_rate","refund_rate","net_revenue_amount","net_revenue_currency","average_lead_time_hours","on_time_shipping_rate","gross_revenue_amount","gross_revenue_currency","discount_rate","average_rating","rating_share_one","rating_share_two","rating_share_three","rating_share_four","rating_share_five","review_rate","view_to_cart_rate","cart_to_order_rate","checkout_rate","order_completion_rate","unique_buyers","new_buyers","returning_buyers","repeat_purchase_rate","date") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22,$23,$24,$25,$26,$27,$28,$29,$30,$31,$32) RETURNING "id"`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), int64(0), int64(100), "IDR", float64(90), float64(10), float64(0), int64(0), "", float64(0), float64(0), int64(0), "", float64(0), float64(0), float64(0), float64(0), float64(0), float64(0), float64(0), float64(0), float64(0), float64(0), float64(0), float64(0), int64(0), int64(0), int64(0), float64(0), date).
 					WillReturnRows(sqlmock.NewRows([]string{"AvergeOrderValue", "SalesConvertionRate", "CancelationOrderRate", "Date"}).
 						AddRow(100, 90, 10, date))
 				mock.ExpectCommit()
@@ -135,7 +136,7 @@ func Test_analyticRepository_CreateAnalytic(t *testing.T) {
 		{
 			name: "error",
 			analytic: domain.Analytic{
-				AverageOrderValue:     100,
+				AverageOrderValue:     money.New(100, "IDR"),
 				SalesConvertionRate:   90,
 				CancellationOrderRate: 10,
 				Date:                  date,
@@ -145,8 +146,8 @@ func Test_analyticRepository_CreateAnalytic(t *testing.T) {
 			mock: func() {
 				mock.ExpectBegin()
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`INSERT INTO "analytics" ("created_at","updated_at","deleted_at","average_order_value","sales_convertion_rate","cancellation_order_rate","date") VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`)).
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), float64(100), float64(90), float64(10), date).
+					`INSERT INTO "analytics" ("created_at","updated_at","deleted_at","seller_id","average_order_value_amount","average_order_value_currency","sales_convertion_rate","cancellation_order_rate","refund_rate","net_revenue_amount","net_revenue_currency","average_lead_time_hours","on_time_shipping_rate","gross_revenue_amount","gross_revenue_currency","discount_rate","average_rating","rating_share_one","rating_share_two","rating_share_three","rating_share_four","rating_share_five","review_rate","view_to_cart_rate","cart_to_order_rate","checkout_rate","order_completion_rate","unique_buyers","new_buyers","returning_buyers","repeat_purchase_rate","date") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22,$23,$24,$25,$26,$27,$28,$29,$30,$31,$32) RETURNING "id"`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), int64(0), int64(100), "IDR", float64(90), float64(10), float64(0), int64(0), "", float64(0), float64(0), int64(0), "", float64(0), float64(0), float64(0), float64(0), float64(0), float64(0), float64(0), float64(0), float64(0), float64(0), float64(0), float64(0), int64(0), int64(0), int64(0), float64(0), date).
 					WillReturnError(errors.New("mock error"))
 			},
 		},
@@ -186,7 +187,7 @@ func Test_analyticRepository_UpdateAnalytic(t *testing.T) {
 		{
 			name: "error get",
 			analytic: domain.Analytic{
-				AverageOrderValue:     100,
+				AverageOrderValue:     money.New(100, "IDR"),
 				SalesConvertionRate:   90,
 				CancellationOrderRate: 10,
 				Date:                  date,
@@ -194,14 +195,14 @@ func Test_analyticRepository_UpdateAnalytic(t *testing.T) {
 			wantErr: true,
 			mock: func() {
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT * FROM "analytics" WHERE Date = $1 AND "analytics"."deleted_at" IS NULL ORDER BY "analytics"."id" LIMIT 1`)).
-					WithArgs(date).WillReturnError(errors.New("mock error"))
+					`SELECT * FROM "analytics" WHERE seller_id = $1 AND Date = $2 AND "analytics"."deleted_at" IS NULL ORDER BY "analytics"."id" LIMIT 1`)).
+					WithArgs(int64(0), date).WillReturnError(errors.New("mock error"))
 			},
 		},
 		{
 			name: "success",
 			analytic: domain.Analytic{
-				AverageOrderValue:     100,
+				AverageOrderValue:     money.New(100, "IDR"),
 				SalesConvertionRate:   90,
 				CancellationOrderRate: 10,
 				Date:                  date,
@@ -209,13 +210,13 @@ func Test_analyticRepository_UpdateAnalytic(t *testing.T) {
 			wantErr: false,
 			mock: func() {
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT * FROM "analytics" WHERE Date = $1 AND "analytics"."deleted_at" IS NULL ORDER BY "analytics"."id" LIMIT 1`)).
-					WithArgs(date).WillReturnRows(sqlmock.NewRows([]string{"AverageOrderValue", "Date"}).
-					AddRow(50, date))
+					`SELECT * FROM "analytics" WHERE seller_id = $1 AND Date = $2 AND "analytics"."deleted_at" IS NULL ORDER BY "analytics"."id" LIMIT 1`)).
+					WithArgs(int64(0), date).WillReturnRows(sqlmock.NewRows([]string{"average_order_value_amount", "average_order_value_currency", "Date"}).
+					AddRow(50, "IDR", date))
 				mock.ExpectBegin()
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`INSERT INTO "analytics" ("created_at","updated_at","deleted_at","average_order_value","sales_convertion_rate","cancellation_order_rate","date") VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`)).
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), float64(100), float64(90), float64(10), date).
+					`INSERT INTO "analytics" ("created_at","updated_at","deleted_at","seller_id","average_order_value_amount","average_order_value_currency","sales_convertion_rate","cancellation_order_rate","refund_rate","net_revenue_amount","net_revenue_currency","average_lead_time_hours","on_time_shipping_rate","gross_revenue_amount","gross_revenue_currency","discount_rate","average_rating","rating_share_one","rating_share_two","rating_share_three","rating_share_four","rating_share_five","review_rate","view_to_cart_rate","cart_to_order_rate","checkout_rate","order_completion_rate","unique_buyers","new_buyers","returning_buyers","repeat_purchase_rate","date") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22,$23,$24,$25,$26,$27,$28,$29,$30,$31,$32) RETURNING "id"`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), int64(0), int64(100), "IDR", float64(90), float64(10), float64(0), int64(0), "", float64(0), float64(0), int64(0), "", float64(0), float64(0), float64(0), float64(0), float64(0), float64(0), float64(0), float64(0), float64(0), float64(0), float64(0), float64(0), int64(0), int64(0), int64(0), float64(0), date).
 					WillReturnRows(sqlmock.NewRows([]string{"AvergeOrderValue", "SalesConvertionRate", "CancelationOrderRate", "Date"}).
 						AddRow(100, 90, 10, date))
 				mock.ExpectCommit()
@@ -236,3 +237,51 @@ func Test_analyticRepository_UpdateAnalytic(t *testing.T) {
 		})
 	}
 }
+
+func Test_analyticRepository_GetAnalytics(t *testing.T) {
+	from := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 14)
+	tests := []struct {
+		name    string
+		want    []domain.Analytic
+		wantErr bool
+		mock    func()
+	}{
+		{
+			name: "success",
+			want: []domain.Analytic{
+				{SellerID: 2, CancellationOrderRate: 10, Date: datatypes.Date(from), DateString: "2022-01-01"},
+			},
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					`SELECT * FROM "analytics" WHERE seller_id = $1 AND (date >= $2 AND date < $3) AND "analytics"."deleted_at" IS NULL ORDER BY date`)).
+					WithArgs(int64(2), datatypes.Date(from), datatypes.Date(to)).
+					WillReturnRows(sqlmock.NewRows([]string{"seller_id", "cancellation_order_rate", "date"}).
+						AddRow(2, 10, from))
+			},
+		},
+		{
+			name:    "error",
+			wantErr: true,
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "analytics"`)).
+					WillReturnError(errors.New("mock error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			ar := NewAnalyticRepository(gormdb)
+			res, err := ar.GetAnalytics(context.TODO(), 2, from, to)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, res)
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BenchmarkRepository interface {
+	AddSellerOrder(ctx context.Context, sellerId, categoryId int64) error
+	GetSellerCategory(ctx context.Context, sellerId int64) (int64, error)
+	GetCategorySellers(ctx context.Context, categoryId int64) ([]int64, error)
+	GetSellerSales(ctx context.Context, sellerIds []int64, from, to time.Time) ([]domain.SellerSales, error)
+}
+
+type benchmarkRepository struct {
+	db *gorm.DB
+}
+
+func NewBenchmarkRepository(db *gorm.DB) BenchmarkRepository {
+	return &benchmarkRepository{
+		db: db,
+	}
+}
+
+// AddSellerOrder, counts a new order of the seller in the category
+func (br *benchmarkRepository) AddSellerOrder(ctx context.Context, sellerId, categoryId int64) error {
+	return br.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "seller_id"}, {Name: "category_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"orders": gorm.Expr("seller_categories.orders + excluded.orders")}),
+	}).Create(&domain.SellerCategory{SellerID: sellerId, CategoryID: categoryId, Orders: 1}).Error
+}
+
+// GetSellerCategory, the category most of the seller's orders are in, the lowest one on a tie and zero without any
+func (br *benchmarkRepository) GetSellerCategory(ctx context.Context, sellerId int64) (int64, error) {
+	var result []int64
+	if err := br.db.WithContext(ctx).Model(&domain.SellerCategory{}).
+		Where("seller_id = ?", sellerId).
+		Order("orders DESC, category_id").
+		Limit(1).
+		Pluck("category_id", &result).Error; err != nil {
+		return 0, err
+	}
+	if len(result) == 0 {
+		return 0, nil
+	}
+	return result[0], nil
+}
+
+// GetCategorySellers, the sellers whose category is the category
+func (br *benchmarkRepository) GetCategorySellers(ctx context.Context, categoryId int64) ([]int64, error) {
+	db := br.db.WithContext(ctx)
+	categories := db.Model(&domain.SellerCategory{}).
+		Select("DISTINCT ON (seller_id) seller_id, category_id").
+		Order("seller_id, orders DESC, category_id")
+
+	var result []int64
+	if err := db.Table("(?) AS c", categories).
+		Where("c.category_id = ?", categoryId).
+		Order("c.seller_id").
+		Pluck("c.seller_id", &result).Error; err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetSellerSales, the sales of the sellers per currency summed over the days from up to but excluding to
+func (br *benchmarkRepository) GetSellerSales(ctx context.Context, sellerIds []int64, from, to time.Time) ([]domain.SellerSales, error) {
+	var result []domain.SellerSales
+	if err := br.db.WithContext(ctx).Model(&domain.DailySales{}).
+		Select("seller_id, revenue_currency, SUM(revenue_amount) AS revenue_amount, SUM(orders) AS orders, "+
+			"SUM(completed_orders) AS completed_orders, SUM(canceled_orders) AS canceled_orders").
+		Where("seller_id IN ? AND date >= ? AND date < ?", sellerIds, datatypes.Date(from), datatypes.Date(to)).
+		Group("seller_id, revenue_currency").
+		Order("seller_id, revenue_currency").
+		Scan(&result).Error; err != nil {
+		return nil, err
+	}
+	return result, nil
+}
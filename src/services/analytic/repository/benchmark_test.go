@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"gorm.io/datatypes"
+)
+
+func Test_benchmarkRepository_AddSellerOrder(t *testing.T) {
+	query := regexp.QuoteMeta(
+		`INSERT INTO "seller_categories" ("created_at","updated_at","deleted_at","seller_id","category_id","orders") VALUES ($1,$2,$3,$4,$5,$6) ON CONFLICT ("seller_id","category_id") DO UPDATE SET "orders"=seller_categories.orders + excluded.orders RETURNING "id"`)
+
+	tests := []struct {
+		name    string
+		wantErr bool
+		mock    func()
+	}{
+		{
+			name: "success",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), int64(2), int64(7), int64(1)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "error",
+			wantErr: true,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WillReturnError(errors.New("mock error"))
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			br := NewBenchmarkRepository(gormdb)
+			err := br.AddSellerOrder(context.TODO(), 2, 7)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_benchmarkRepository_GetSellerCategory(t *testing.T) {
+	query := regexp.QuoteMeta(
+		`SELECT "category_id" FROM "seller_categories" WHERE seller_id = $1 AND "seller_categories"."deleted_at" IS NULL ORDER BY orders DESC, category_id LIMIT 1`)
+
+	tests := []struct {
+		name    string
+		want    int64
+		wantErr bool
+		mock    func()
+	}{
+		{
+			name: "success",
+			want: 7,
+			mock: func() {
+				mock.ExpectQuery(query).
+					WithArgs(int64(2)).
+					WillReturnRows(sqlmock.NewRows([]string{"category_id"}).AddRow(7))
+			},
+		},
+		{
+			name: "no category",
+			mock: func() {
+				mock.ExpectQuery(query).
+					WithArgs(int64(2)).
+					WillReturnRows(sqlmock.NewRows([]string{"category_id"}))
+			},
+		},
+		{
+			name:    "error",
+			wantErr: true,
+			mock: func() {
+				mock.ExpectQuery(query).
+					WillReturnError(errors.New("mock error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			br := NewBenchmarkRepository(gormdb)
+			res, err := br.GetSellerCategory(context.TODO(), 2)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, res)
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_benchmarkRepository_GetCategorySellers(t *testing.T) {
+	query := regexp.QuoteMeta(
+		`SELECT "c"."seller_id" FROM (SELECT DISTINCT ON (seller_id) seller_id, category_id FROM "seller_categories" WHERE "seller_categories"."deleted_at" IS NULL ORDER BY seller_id, orders DESC, category_id) AS c WHERE c.category_id = $1 ORDER BY c.seller_id`)
+
+	tests := []struct {
+		name    string
+		want    []int64
+		wantErr bool
+		mock    func()
+	}{
+		{
+			name: "success",
+			want: []int64{2, 3},
+			mock: func() {
+				mock.ExpectQuery(query).
+					WithArgs(int64(7)).
+					WillReturnRows(sqlmock.NewRows([]string{"seller_id"}).AddRow(2).AddRow(3))
+			},
+		},
+		{
+			name:    "error",
+			wantErr: true,
+			mock: func() {
+				mock.ExpectQuery(query).
+					WillReturnError(errors.New("mock error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			br := NewBenchmarkRepository(gormdb)
+			res, err := br.GetCategorySellers(context.TODO(), 7)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, res)
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_benchmarkRepository_GetSellerSales(t *testing.T) {
+	from := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 30)
+	query := regexp.QuoteMeta(
+		`SELECT seller_id, revenue_currency, SUM(revenue_amount) AS revenue_amount, SUM(orders) AS orders, SUM(completed_orders) AS completed_orders, SUM(canceled_orders) AS canceled_orders FROM "daily_sales" WHERE (seller_id IN ($1,$2) AND date >= $3 AND date < $4) AND "daily_sales"."deleted_at" IS NULL GROUP BY seller_id, revenue_currency ORDER BY seller_id, revenue_currency`)
+
+	tests := []struct {
+		name    string
+		want    []domain.SellerSales
+		wantErr bool
+		mock    func()
+	}{
+		{
+			name: "success",
+			want: []domain.SellerSales{
+				{SellerID: 2, Revenue: money.New(150000, "IDR"), Orders: 10, CompletedOrders: 8, CanceledOrders: 1},
+			},
+			mock: func() {
+				mock.ExpectQuery(query).
+					WithArgs(int64(2), int64(3), datatypes.Date(from), datatypes.Date(to)).
+					WillReturnRows(sqlmock.NewRows([]string{"seller_id", "revenue_currency", "revenue_amount", "orders", "completed_orders", "canceled_orders"}).
+						AddRow(2, "IDR", 150000, 10, 8, 1))
+			},
+		},
+		{
+			name:    "error",
+			wantErr: true,
+			mock: func() {
+				mock.ExpectQuery(query).
+					WillReturnError(errors.New("mock error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			br := NewBenchmarkRepository(gormdb)
+			res, err := br.GetSellerSales(context.TODO(), []int64{2, 3}, from, to)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, res)
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CohortRepository interface {
+	AddBuyer(ctx context.Context, buyer domain.CohortBuyer) error
+	AddOrder(ctx context.Context, order domain.CohortOrder) error
+	VoidOrder(ctx context.Context, order domain.CohortOrder) error
+	GetCohortSizes(ctx context.Context, sellerId int64, from, to time.Time) ([]domain.CohortSize, error)
+	GetCohortActivity(ctx context.Context, sellerId int64, from, to time.Time) ([]domain.CohortActivity, error)
+	GetCohortRevenue(ctx context.Context, sellerId int64, from, to time.Time) ([]domain.CohortRevenue, error)
+}
+
+type cohortRepository struct {
+	db *gorm.DB
+}
+
+func NewCohortRepository(db *gorm.DB) CohortRepository {
+	return &cohortRepository{
+		db: db,
+	}
+}
+
+// AddBuyer, puts the buyer in the cohort of their order week, a buyer already in a later cohort is moved to the
+// earlier one since order events may arrive out of order
+func (cr *cohortRepository) AddBuyer(ctx context.Context, buyer domain.CohortBuyer) error {
+	return cr.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "seller_id"}, {Name: "buyer_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"week": gorm.Expr("LEAST(cohort_buyers.week, excluded.week)")}),
+	}).Create(&buyer).Error
+}
+
+// AddOrder, records the order and adds its revenue to the revenue already recorded for it
+func (cr *cohortRepository) AddOrder(ctx context.Context, order domain.CohortOrder) error {
+	return cr.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "seller_id"}, {Name: "order_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"revenue_amount": gorm.Expr("cohort_orders.revenue_amount + excluded.revenue_amount")}),
+	}).Create(&order).Error
+}
+
+// VoidOrder, marks the order void and moves the buyer to the cohort of their first order left, a buyer without
+// any order left leaves the cohorts
+func (cr *cohortRepository) VoidOrder(ctx context.Context, order domain.CohortOrder) error {
+	order.Void = true
+	return cr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "seller_id"}, {Name: "order_id"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{"void": true}),
+		}).Create(&order).Error; err != nil {
+			return err
+		}
+
+		firstWeek := tx.Model(&domain.CohortOrder{}).
+			Select("MIN(week)").
+			Where("seller_id = ? AND buyer_id = ? AND NOT void", order.SellerID, order.BuyerID)
+		if err := tx.Model(&domain.CohortBuyer{}).
+			Where("seller_id = ? AND buyer_id = ?", order.SellerID, order.BuyerID).
+			Update("week", firstWeek).Error; err != nil {
+			return err
+		}
+
+		return tx.Unscoped().
+			Where("seller_id = ? AND buyer_id = ? AND week IS NULL", order.SellerID, order.BuyerID).
+			Delete(&domain.CohortBuyer{}).Error
+	})
+}
+
+// GetCohortSizes, number of buyers per cohort of the weeks from up to but excluding to
+func (cr *cohortRepository) GetCohortSizes(ctx context.Context, sellerId int64, from, to time.Time) ([]domain.CohortSize, error) {
+	var result []domain.CohortSize
+	if err := cr.db.WithContext(ctx).Model(&domain.CohortBuyer{}).
+		Select("week, COUNT(*) AS buyers").
+		Where("seller_id = ? AND week >= ? AND week < ?", sellerId, from, to).
+		Group("week").
+		Order("week").
+		Scan(&result).Error; err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetCohortActivity, number of buyers who ordered per cohort of the weeks from up to but excluding to and per week
+// before to
+func (cr *cohortRepository) GetCohortActivity(ctx context.Context, sellerId int64, from, to time.Time) ([]domain.CohortActivity, error) {
+	var result []domain.CohortActivity
+	if err := cr.cohortOrders(ctx, sellerId, from, to).
+		Select("b.week AS cohort_week, o.week AS week, COUNT(DISTINCT o.buyer_id) AS buyers").
+		Group("b.week, o.week").
+		Order("b.week, o.week").
+		Scan(&result).Error; err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetCohortRevenue, revenue per cohort of the weeks from up to but excluding to, per week before to and per currency
+func (cr *cohortRepository) GetCohortRevenue(ctx context.Context, sellerId int64, from, to time.Time) ([]domain.CohortRevenue, error) {
+	var result []domain.CohortRevenue
+	if err := cr.cohortOrders(ctx, sellerId, from, to).
+		Select("b.week AS cohort_week, o.week AS week, o.revenue_currency, SUM(o.revenue_amount) AS revenue_amount").
+		Where("o.revenue_amount <> 0").
+		Group("b.week, o.week, o.revenue_currency").
+		Order("b.week, o.week, o.revenue_currency").
+		Scan(&result).Error; err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// cohortOrders, the orders placed before to by the buyers of the cohorts of the weeks from up to but excluding to,
+// void orders left out
+func (cr *cohortRepository) cohortOrders(ctx context.Context, sellerId int64, from, to time.Time) *gorm.DB {
+	return cr.db.WithContext(ctx).Table("cohort_orders o").
+		Joins("JOIN cohort_buyers b ON b.seller_id = o.seller_id AND b.buyer_id = o.buyer_id").
+		Where("o.seller_id = ? AND b.week >= ? AND b.week < ? AND o.week < ? AND NOT o.void", sellerId, from, to, to)
+}
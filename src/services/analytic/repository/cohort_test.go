@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"gorm.io/datatypes"
+)
+
+func Test_cohortRepository_AddBuyer(t *testing.T) {
+	week := datatypes.Date(time.Date(2022, 1, 3, 0, 0, 0, 0, time.Local))
+	tests := []struct {
+		name    string
+		wantErr bool
+		mock    func()
+	}{
+		{
+			name: "success",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(
+					`INSERT INTO "cohort_buyers" ("created_at","updated_at","deleted_at","seller_id","buyer_id","week") VALUES ($1,$2,$3,$4,$5,$6) ON CONFLICT ("seller_id","buyer_id") DO UPDATE SET "week"=LEAST(cohort_buyers.week, excluded.week) RETURNING "id"`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), int64(2), int64(3), week).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "error",
+			wantErr: true,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "cohort_buyers"`)).
+					WillReturnError(errors.New("mock error"))
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			cr := NewCohortRepository(gormdb)
+			err := cr.AddBuyer(context.TODO(), domain.CohortBuyer{SellerID: 2, BuyerID: 3, Week: week})
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_cohortRepository_AddOrder(t *testing.T) {
+	week := datatypes.Date(time.Date(2022, 1, 3, 0, 0, 0, 0, time.Local))
+	date := datatypes.Date(time.Date(2022, 1, 5, 0, 0, 0, 0, time.Local))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`INSERT INTO "cohort_orders" ("created_at","updated_at","deleted_at","seller_id","order_id","buyer_id","week","date","revenue_amount","revenue_currency","void") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11) ON CONFLICT ("seller_id","order_id") DO UPDATE SET "revenue_amount"=cohort_orders.revenue_amount + excluded.revenue_amount RETURNING "id"`)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), int64(2), int64(7), int64(3), week, date, int64(100), "IDR", false).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	cr := NewCohortRepository(gormdb)
+	err := cr.AddOrder(context.TODO(), domain.CohortOrder{SellerID: 2, OrderID: 7, BuyerID: 3, Week: week, Date: date, Revenue: money.New(100, "IDR")})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func Test_cohortRepository_VoidOrder(t *testing.T) {
+	week := datatypes.Date(time.Date(2022, 1, 3, 0, 0, 0, 0, time.Local))
+	date := datatypes.Date(time.Date(2022, 1, 5, 0, 0, 0, 0, time.Local))
+	tests := []struct {
+		name    string
+		wantErr bool
+		mock    func()
+	}{
+		{
+			name: "success",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(
+					`INSERT INTO "cohort_orders" ("created_at","updated_at","deleted_at","seller_id","order_id","buyer_id","week","date","revenue_amount","revenue_currency","void") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11) ON CONFLICT ("seller_id","order_id") DO UPDATE SET "void"=$12 RETURNING "id"`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), int64(2), int64(7), int64(3), week, date, int64(0), "IDR", true, true).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectExec(regexp.QuoteMeta(
+					`UPDATE "cohort_buyers" SET "week"=(SELECT MIN(week) FROM "cohort_orders" WHERE (seller_id = $1 AND buyer_id = $2 AND NOT void) AND "cohort_orders"."deleted_at" IS NULL),"updated_at"=$3 WHERE (seller_id = $4 AND buyer_id = $5) AND "cohort_buyers"."deleted_at" IS NULL`)).
+					WithArgs(int64(2), int64(3), sqlmock.AnyArg(), int64(2), int64(3)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(
+					`DELETE FROM "cohort_buyers" WHERE seller_id = $1 AND buyer_id = $2 AND week IS NULL`)).
+					WithArgs(int64(2), int64(3)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "error",
+			wantErr: true,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "cohort_orders"`)).
+					WillReturnError(errors.New("mock error"))
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			cr := NewCohortRepository(gormdb)
+			err := cr.VoidOrder(context.TODO(), domain.CohortOrder{SellerID: 2, OrderID: 7, BuyerID: 3, Week: week, Date: date, Revenue: money.New(0, "IDR")})
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_cohortRepository_GetCohortSizes(t *testing.T) {
+	from := time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 14)
+	tests := []struct {
+		name    string
+		want    []domain.CohortSize
+		wantErr bool
+		mock    func()
+	}{
+		{
+			name: "success",
+			want: []domain.CohortSize{{Week: from, Buyers: 4}},
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					`SELECT week, COUNT(*) AS buyers FROM "cohort_buyers" WHERE (seller_id = $1 AND week >= $2 AND week < $3) AND "cohort_buyers"."deleted_at" IS NULL GROUP BY "week" ORDER BY week`)).
+					WithArgs(int64(2), from, to).
+					WillReturnRows(sqlmock.NewRows([]string{"week", "buyers"}).AddRow(from, 4))
+			},
+		},
+		{
+			name:    "error",
+			wantErr: true,
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT week, COUNT(*) AS buyers FROM "cohort_buyers"`)).
+					WillReturnError(errors.New("mock error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			cr := NewCohortRepository(gormdb)
+			res, err := cr.GetCohortSizes(context.TODO(), 2, from, to)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, res)
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_cohortRepository_GetCohortActivity(t *testing.T) {
+	from := time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 14)
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT b.week AS cohort_week, o.week AS week, COUNT(DISTINCT o.buyer_id) AS buyers FROM cohort_orders o JOIN cohort_buyers b ON b.seller_id = o.seller_id AND b.buyer_id = o.buyer_id WHERE o.seller_id = $1 AND b.week >= $2 AND b.week < $3 AND o.week < $4 AND NOT o.void GROUP BY b.week, o.week ORDER BY b.week, o.week`)).
+		WithArgs(int64(2), from, to, to).
+		WillReturnRows(sqlmock.NewRows([]string{"cohort_week", "week", "buyers"}).AddRow(from, from, 4).AddRow(from, to, 1))
+
+	cr := NewCohortRepository(gormdb)
+	res, err := cr.GetCohortActivity(context.TODO(), 2, from, to)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.CohortActivity{
+		{CohortWeek: from, Week: from, Buyers: 4},
+		{CohortWeek: from, Week: to, Buyers: 1},
+	}, res)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func Test_cohortRepository_GetCohortRevenue(t *testing.T) {
+	from := time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 14)
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT b.week AS cohort_week, o.week AS week, o.revenue_currency, SUM(o.revenue_amount) AS revenue_amount FROM cohort_orders o JOIN cohort_buyers b ON b.seller_id = o.seller_id AND b.buyer_id = o.buyer_id WHERE (o.seller_id = $1 AND b.week >= $2 AND b.week < $3 AND o.week < $4 AND NOT o.void) AND o.revenue_amount <> 0 GROUP BY b.week, o.week, o.revenue_currency ORDER BY b.week, o.week, o.revenue_currency`)).
+		WithArgs(int64(2), from, to, to).
+		WillReturnRows(sqlmock.NewRows([]string{"cohort_week", "week", "revenue_currency", "revenue_amount"}).AddRow(from, from, "IDR", 400))
+
+	cr := NewCohortRepository(gormdb)
+	res, err := cr.GetCohortRevenue(context.TODO(), 2, from, to)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.CohortRevenue{
+		{CohortWeek: from, Week: from, Revenue: money.New(400, "IDR")},
+	}, res)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
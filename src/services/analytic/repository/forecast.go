@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ForecastRepository interface {
+	SaveDailySales(ctx context.Context, sales domain.DailySales) error
+	GetDailySales(ctx context.Context, sellerId int64, from, to time.Time) ([]domain.DailySales, error)
+}
+
+type forecastRepository struct {
+	db *gorm.DB
+}
+
+func NewForecastRepository(db *gorm.DB) ForecastRepository {
+	return &forecastRepository{
+		db: db,
+	}
+}
+
+// SaveDailySales, saves the sales of the seller's day, replacing the ones reported before
+func (fr *forecastRepository) SaveDailySales(ctx context.Context, sales domain.DailySales) error {
+	return fr.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "seller_id"}, {Name: "date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"updated_at", "revenue_amount", "revenue_currency", "orders", "completed_orders", "canceled_orders"}),
+	}).Create(&sales).Error
+}
+
+// GetDailySales, the sales of the seller's days from up to but excluding to, oldest first
+func (fr *forecastRepository) GetDailySales(ctx context.Context, sellerId int64, from, to time.Time) ([]domain.DailySales, error) {
+	var result []domain.DailySales
+	if err := fr.db.WithContext(ctx).
+		Where("seller_id = ? AND date >= ? AND date < ?", sellerId, datatypes.Date(from), datatypes.Date(to)).
+		Order("date").
+		Find(&result).Error; err != nil {
+		return nil, err
+	}
+	return result, nil
+}
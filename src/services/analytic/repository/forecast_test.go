@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"gorm.io/datatypes"
+)
+
+func Test_forecastRepository_SaveDailySales(t *testing.T) {
+	date := datatypes.Date(time.Date(2022, 1, 1, 0, 0, 0, 0, time.Local))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`INSERT INTO "daily_sales" ("created_at","updated_at","deleted_at","seller_id","date","revenue_amount","revenue_currency","orders","completed_orders","canceled_orders") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) ON CONFLICT ("seller_id","date") DO UPDATE SET "updated_at"="excluded"."updated_at","revenue_amount"="excluded"."revenue_amount","revenue_currency"="excluded"."revenue_currency","orders"="excluded"."orders","completed_orders"="excluded"."completed_orders","canceled_orders"="excluded"."canceled_orders" RETURNING "id"`)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), int64(2), date, int64(100), "IDR", int64(3), int64(2), int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	fr := NewForecastRepository(gormdb)
+	err := fr.SaveDailySales(context.TODO(), domain.DailySales{SellerID: 2, Date: date, Revenue: money.New(100, "IDR"), Orders: 3, CompletedOrders: 2, CanceledOrders: 1})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func Test_forecastRepository_GetDailySales(t *testing.T) {
+	from := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+	tests := []struct {
+		name    string
+		want    []domain.DailySales
+		wantErr bool
+		mock    func()
+	}{
+		{
+			name: "success",
+			want: []domain.DailySales{
+				{SellerID: 2, Date: datatypes.Date(from), Revenue: money.New(100, "IDR"), Orders: 3},
+			},
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					`SELECT * FROM "daily_sales" WHERE (seller_id = $1 AND date >= $2 AND date < $3) AND "daily_sales"."deleted_at" IS NULL ORDER BY date`)).
+					WithArgs(int64(2), datatypes.Date(from), datatypes.Date(to)).
+					WillReturnRows(sqlmock.NewRows([]string{"seller_id", "date", "revenue_amount", "revenue_currency", "orders"}).
+						AddRow(2, from, 100, "IDR", 3))
+			},
+		},
+		{
+			name:    "error",
+			wantErr: true,
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "daily_sales"`)).
+					WillReturnError(errors.New("mock error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			fr := NewForecastRepository(gormdb)
+			res, err := fr.GetDailySales(context.TODO(), 2, from, to)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, res)
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
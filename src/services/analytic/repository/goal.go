@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type GoalRepository interface {
+	CreateGoal(ctx context.Context, goal domain.Goal) (*domain.Goal, error)
+	GetGoals(ctx context.Context, sellerId int64, period string) ([]domain.Goal, error)
+	GetGoal(ctx context.Context, sellerId int64, id uint) (*domain.Goal, error)
+	UpdateGoal(ctx context.Context, goal domain.Goal) (*domain.Goal, error)
+	DeleteGoal(ctx context.Context, sellerId int64, id uint) error
+}
+
+type goalRepository struct {
+	db *gorm.DB
+}
+
+func NewGoalRepository(db *gorm.DB) GoalRepository {
+	return &goalRepository{
+		db: db,
+	}
+}
+
+// CreateGoal, create goal, returns ErrGoalExists when the seller already has a goal of the metric for the period
+func (gr *goalRepository) CreateGoal(ctx context.Context, goal domain.Goal) (*domain.Goal, error) {
+	result := gr.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&goal)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, domain.ErrGoalExists
+	}
+	return &goal, nil
+}
+
+// GetGoals, the goals of the seller for the period, in the order they were set
+func (gr *goalRepository) GetGoals(ctx context.Context, sellerId int64, period string) ([]domain.Goal, error) {
+	var result []domain.Goal
+	if err := gr.db.WithContext(ctx).
+		Where("seller_id = ? AND period = ?", sellerId, period).
+		Order("id").
+		Find(&result).Error; err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetGoal, get goal of the seller by id, the goal of another seller is not found
+func (gr *goalRepository) GetGoal(ctx context.Context, sellerId int64, id uint) (*domain.Goal, error) {
+	var result domain.Goal
+	if err := gr.db.WithContext(ctx).Where("id = ? AND seller_id = ?", id, sellerId).First(&result).Error; err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	} else if err == gorm.ErrRecordNotFound {
+		return nil, domain.ErrGoalNotFound
+	}
+	return &result, nil
+}
+
+// UpdateGoal, saves the target of the goal, the goal of another seller is not found
+func (gr *goalRepository) UpdateGoal(ctx context.Context, goal domain.Goal) (*domain.Goal, error) {
+	result := gr.db.WithContext(ctx).Model(&goal).Where("seller_id = ?", goal.SellerID).Update("target", goal.Target)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, domain.ErrGoalNotFound
+	}
+	return &goal, nil
+}
+
+// DeleteGoal, deletes the goal of the seller for good so the seller can set a goal of the metric for the period
+// again, the goal of another seller is not found
+func (gr *goalRepository) DeleteGoal(ctx context.Context, sellerId int64, id uint) error {
+	result := gr.db.WithContext(ctx).Unscoped().Where("seller_id = ?", sellerId).Delete(&domain.Goal{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrGoalNotFound
+	}
+	return nil
+}
@@ -0,0 +1,298 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+)
+
+func Test_goalRepository_CreateGoal(t *testing.T) {
+	goal := domain.Goal{SellerID: 2, Period: "2022-01", Metric: domain.GoalMetricRevenue, Target: 1000}
+	query := regexp.QuoteMeta(
+		`INSERT INTO "goals" ("created_at","updated_at","deleted_at","seller_id","period","metric","target") VALUES ($1,$2,$3,$4,$5,$6,$7) ON CONFLICT DO NOTHING RETURNING "id"`)
+
+	tests := []struct {
+		name    string
+		wantID  uint
+		wantErr error
+		mock    func()
+	}{
+		{
+			name:   "success",
+			wantID: 1,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), int64(2), "2022-01", domain.GoalMetricRevenue, float64(1000)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "already set",
+			wantErr: domain.ErrGoalExists,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "error",
+			wantErr: errors.New("mock error"),
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WillReturnError(errors.New("mock error"))
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			gr := NewGoalRepository(gormdb)
+			res, err := gr.CreateGoal(context.TODO(), goal)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr.Error(), err.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantID, res.ID)
+			}
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_goalRepository_GetGoals(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    []domain.Goal
+		wantErr bool
+		mock    func()
+	}{
+		{
+			name: "success",
+			want: []domain.Goal{
+				{SellerID: 2, Period: "2022-01", Metric: domain.GoalMetricOrders, Target: 100},
+			},
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					`SELECT * FROM "goals" WHERE (seller_id = $1 AND period = $2) AND "goals"."deleted_at" IS NULL ORDER BY id`)).
+					WithArgs(int64(2), "2022-01").
+					WillReturnRows(sqlmock.NewRows([]string{"seller_id", "period", "metric", "target"}).
+						AddRow(2, "2022-01", domain.GoalMetricOrders, 100))
+			},
+		},
+		{
+			name:    "error",
+			wantErr: true,
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "goals"`)).
+					WillReturnError(errors.New("mock error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			gr := NewGoalRepository(gormdb)
+			res, err := gr.GetGoals(context.TODO(), 2, "2022-01")
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, res)
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_goalRepository_GetGoal(t *testing.T) {
+	query := regexp.QuoteMeta(
+		`SELECT * FROM "goals" WHERE (id = $1 AND seller_id = $2) AND "goals"."deleted_at" IS NULL ORDER BY "goals"."id" LIMIT 1`)
+
+	tests := []struct {
+		name    string
+		want    *domain.Goal
+		wantErr error
+		mock    func()
+	}{
+		{
+			name: "success",
+			want: &domain.Goal{SellerID: 2, Metric: domain.GoalMetricRevenue, Target: 1000},
+			mock: func() {
+				mock.ExpectQuery(query).
+					WithArgs(uint(1), int64(2)).
+					WillReturnRows(sqlmock.NewRows([]string{"seller_id", "metric", "target"}).AddRow(2, domain.GoalMetricRevenue, 1000))
+			},
+		},
+		{
+			name:    "goal of another seller",
+			wantErr: domain.ErrGoalNotFound,
+			mock: func() {
+				mock.ExpectQuery(query).
+					WithArgs(uint(1), int64(2)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			},
+		},
+		{
+			name:    "error",
+			wantErr: errors.New("mock error"),
+			mock: func() {
+				mock.ExpectQuery(query).
+					WithArgs(uint(1), int64(2)).
+					WillReturnError(errors.New("mock error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			gr := NewGoalRepository(gormdb)
+			res, err := gr.GetGoal(context.TODO(), 2, 1)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr.Error(), err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, res)
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_goalRepository_UpdateGoal(t *testing.T) {
+	query := regexp.QuoteMeta(
+		`UPDATE "goals" SET "target"=$1,"updated_at"=$2 WHERE seller_id = $3 AND "goals"."deleted_at" IS NULL AND "id" = $4`)
+
+	goal := domain.Goal{SellerID: 2, Metric: domain.GoalMetricRevenue, Target: 2000}
+	goal.ID = 1
+
+	tests := []struct {
+		name    string
+		wantErr error
+		mock    func()
+	}{
+		{
+			name: "success",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(float64(2000), sqlmock.AnyArg(), int64(2), 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "goal of another seller",
+			wantErr: domain.ErrGoalNotFound,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(float64(2000), sqlmock.AnyArg(), int64(2), 1).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "error",
+			wantErr: errors.New("mock error"),
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(float64(2000), sqlmock.AnyArg(), int64(2), 1).
+					WillReturnError(errors.New("mock error"))
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			gr := NewGoalRepository(gormdb)
+			res, err := gr.UpdateGoal(context.TODO(), goal)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr.Error(), err.Error())
+				assert.Nil(t, res)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, float64(2000), res.Target)
+			}
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_goalRepository_DeleteGoal(t *testing.T) {
+	query := regexp.QuoteMeta(`DELETE FROM "goals" WHERE seller_id = $1 AND "goals"."id" = $2`)
+
+	tests := []struct {
+		name    string
+		wantErr error
+		mock    func()
+	}{
+		{
+			name: "success",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(int64(2), 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "goal of another seller",
+			wantErr: domain.ErrGoalNotFound,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(int64(2), 1).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "error",
+			wantErr: errors.New("mock error"),
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(int64(2), 1).
+					WillReturnError(errors.New("mock error"))
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			gr := NewGoalRepository(gormdb)
+			err := gr.DeleteGoal(context.TODO(), 2, 1)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr.Error(), err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
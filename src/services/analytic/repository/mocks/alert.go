@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: alert.go
+
+// Package mock_repository is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+)
+
+// MockAlertRepository is a mock of AlertRepository interface.
+type MockAlertRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAlertRepositoryMockRecorder
+}
+
+// MockAlertRepositoryMockRecorder is the mock recorder for MockAlertRepository.
+type MockAlertRepositoryMockRecorder struct {
+	mock *MockAlertRepository
+}
+
+// NewMockAlertRepository creates a new mock instance.
+func NewMockAlertRepository(ctrl *gomock.Controller) *MockAlertRepository {
+	mock := &MockAlertRepository{ctrl: ctrl}
+	mock.recorder = &MockAlertRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAlertRepository) EXPECT() *MockAlertRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AcknowledgeAlert mocks base method.
+func (m *MockAlertRepository) AcknowledgeAlert(ctx context.Context, sellerId int64, id uint) (*domain.Alert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcknowledgeAlert", ctx, sellerId, id)
+	ret0, _ := ret[0].(*domain.Alert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcknowledgeAlert indicates an expected call of AcknowledgeAlert.
+func (mr *MockAlertRepositoryMockRecorder) AcknowledgeAlert(ctx, sellerId, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcknowledgeAlert", reflect.TypeOf((*MockAlertRepository)(nil).AcknowledgeAlert), ctx, sellerId, id)
+}
+
+// CreateAlert mocks base method.
+func (m *MockAlertRepository) CreateAlert(ctx context.Context, alert domain.Alert) (*domain.Alert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAlert", ctx, alert)
+	ret0, _ := ret[0].(*domain.Alert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAlert indicates an expected call of CreateAlert.
+func (mr *MockAlertRepositoryMockRecorder) CreateAlert(ctx, alert interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlert", reflect.TypeOf((*MockAlertRepository)(nil).CreateAlert), ctx, alert)
+}
+
+// GetAlerts mocks base method.
+func (m *MockAlertRepository) GetAlerts(ctx context.Context, sellerId int64, acknowledged *bool) ([]domain.Alert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlerts", ctx, sellerId, acknowledged)
+	ret0, _ := ret[0].([]domain.Alert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlerts indicates an expected call of GetAlerts.
+func (mr *MockAlertRepositoryMockRecorder) GetAlerts(ctx, sellerId, acknowledged interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlerts", reflect.TypeOf((*MockAlertRepository)(nil).GetAlerts), ctx, sellerId, acknowledged)
+}
+
+// PublishAlertEvent mocks base method.
+func (m *MockAlertRepository) PublishAlertEvent(ctx context.Context, event domain.PayloadEventAlert) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishAlertEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishAlertEvent indicates an expected call of PublishAlertEvent.
+func (mr *MockAlertRepositoryMockRecorder) PublishAlertEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishAlertEvent", reflect.TypeOf((*MockAlertRepository)(nil).PublishAlertEvent), ctx, event)
+}
@@ -52,18 +52,33 @@ func (mr *MockAnalyticRepositoryMockRecorder) CreateAnalytic(ctx, analytic inter
 }
 
 // GetAnalyticByDate mocks base method.
-func (m *MockAnalyticRepository) GetAnalyticByDate(ctx context.Context, date time.Time) (*domain.Analytic, error) {
+func (m *MockAnalyticRepository) GetAnalyticByDate(ctx context.Context, sellerId int64, date time.Time) (*domain.Analytic, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAnalyticByDate", ctx, date)
+	ret := m.ctrl.Call(m, "GetAnalyticByDate", ctx, sellerId, date)
 	ret0, _ := ret[0].(*domain.Analytic)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAnalyticByDate indicates an expected call of GetAnalyticByDate.
-func (mr *MockAnalyticRepositoryMockRecorder) GetAnalyticByDate(ctx, date interface{}) *gomock.Call {
+func (mr *MockAnalyticRepositoryMockRecorder) GetAnalyticByDate(ctx, sellerId, date interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnalyticByDate", reflect.TypeOf((*MockAnalyticRepository)(nil).GetAnalyticByDate), ctx, date)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnalyticByDate", reflect.TypeOf((*MockAnalyticRepository)(nil).GetAnalyticByDate), ctx, sellerId, date)
+}
+
+// GetAnalytics mocks base method.
+func (m *MockAnalyticRepository) GetAnalytics(ctx context.Context, sellerId int64, from, to time.Time) ([]domain.Analytic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnalytics", ctx, sellerId, from, to)
+	ret0, _ := ret[0].([]domain.Analytic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnalytics indicates an expected call of GetAnalytics.
+func (mr *MockAnalyticRepositoryMockRecorder) GetAnalytics(ctx, sellerId, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnalytics", reflect.TypeOf((*MockAnalyticRepository)(nil).GetAnalytics), ctx, sellerId, from, to)
 }
 
 // UpdateAnalytic mocks base method.
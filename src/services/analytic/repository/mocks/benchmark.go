@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: benchmark.go
+
+// Package mock_repository is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+)
+
+// MockBenchmarkRepository is a mock of BenchmarkRepository interface.
+type MockBenchmarkRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBenchmarkRepositoryMockRecorder
+}
+
+// MockBenchmarkRepositoryMockRecorder is the mock recorder for MockBenchmarkRepository.
+type MockBenchmarkRepositoryMockRecorder struct {
+	mock *MockBenchmarkRepository
+}
+
+// NewMockBenchmarkRepository creates a new mock instance.
+func NewMockBenchmarkRepository(ctrl *gomock.Controller) *MockBenchmarkRepository {
+	mock := &MockBenchmarkRepository{ctrl: ctrl}
+	mock.recorder = &MockBenchmarkRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBenchmarkRepository) EXPECT() *MockBenchmarkRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddSellerOrder mocks base method.
+func (m *MockBenchmarkRepository) AddSellerOrder(ctx context.Context, sellerId, categoryId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSellerOrder", ctx, sellerId, categoryId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSellerOrder indicates an expected call of AddSellerOrder.
+func (mr *MockBenchmarkRepositoryMockRecorder) AddSellerOrder(ctx, sellerId, categoryId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSellerOrder", reflect.TypeOf((*MockBenchmarkRepository)(nil).AddSellerOrder), ctx, sellerId, categoryId)
+}
+
+// GetCategorySellers mocks base method.
+func (m *MockBenchmarkRepository) GetCategorySellers(ctx context.Context, categoryId int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategorySellers", ctx, categoryId)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategorySellers indicates an expected call of GetCategorySellers.
+func (mr *MockBenchmarkRepositoryMockRecorder) GetCategorySellers(ctx, categoryId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategorySellers", reflect.TypeOf((*MockBenchmarkRepository)(nil).GetCategorySellers), ctx, categoryId)
+}
+
+// GetSellerCategory mocks base method.
+func (m *MockBenchmarkRepository) GetSellerCategory(ctx context.Context, sellerId int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSellerCategory", ctx, sellerId)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSellerCategory indicates an expected call of GetSellerCategory.
+func (mr *MockBenchmarkRepositoryMockRecorder) GetSellerCategory(ctx, sellerId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSellerCategory", reflect.TypeOf((*MockBenchmarkRepository)(nil).GetSellerCategory), ctx, sellerId)
+}
+
+// GetSellerSales mocks base method.
+func (m *MockBenchmarkRepository) GetSellerSales(ctx context.Context, sellerIds []int64, from, to time.Time) ([]domain.SellerSales, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSellerSales", ctx, sellerIds, from, to)
+	ret0, _ := ret[0].([]domain.SellerSales)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSellerSales indicates an expected call of GetSellerSales.
+func (mr *MockBenchmarkRepositoryMockRecorder) GetSellerSales(ctx, sellerIds, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSellerSales", reflect.TypeOf((*MockBenchmarkRepository)(nil).GetSellerSales), ctx, sellerIds, from, to)
+}
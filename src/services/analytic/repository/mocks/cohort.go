@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: cohort.go
+
+// Package mock_repository is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+)
+
+// MockCohortRepository is a mock of CohortRepository interface.
+type MockCohortRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCohortRepositoryMockRecorder
+}
+
+// MockCohortRepositoryMockRecorder is the mock recorder for MockCohortRepository.
+type MockCohortRepositoryMockRecorder struct {
+	mock *MockCohortRepository
+}
+
+// NewMockCohortRepository creates a new mock instance.
+func NewMockCohortRepository(ctrl *gomock.Controller) *MockCohortRepository {
+	mock := &MockCohortRepository{ctrl: ctrl}
+	mock.recorder = &MockCohortRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCohortRepository) EXPECT() *MockCohortRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddBuyer mocks base method.
+func (m *MockCohortRepository) AddBuyer(ctx context.Context, buyer domain.CohortBuyer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBuyer", ctx, buyer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddBuyer indicates an expected call of AddBuyer.
+func (mr *MockCohortRepositoryMockRecorder) AddBuyer(ctx, buyer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBuyer", reflect.TypeOf((*MockCohortRepository)(nil).AddBuyer), ctx, buyer)
+}
+
+// AddOrder mocks base method.
+func (m *MockCohortRepository) AddOrder(ctx context.Context, order domain.CohortOrder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOrder", ctx, order)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddOrder indicates an expected call of AddOrder.
+func (mr *MockCohortRepositoryMockRecorder) AddOrder(ctx, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrder", reflect.TypeOf((*MockCohortRepository)(nil).AddOrder), ctx, order)
+}
+
+// GetCohortActivity mocks base method.
+func (m *MockCohortRepository) GetCohortActivity(ctx context.Context, sellerId int64, from, to time.Time) ([]domain.CohortActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCohortActivity", ctx, sellerId, from, to)
+	ret0, _ := ret[0].([]domain.CohortActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCohortActivity indicates an expected call of GetCohortActivity.
+func (mr *MockCohortRepositoryMockRecorder) GetCohortActivity(ctx, sellerId, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCohortActivity", reflect.TypeOf((*MockCohortRepository)(nil).GetCohortActivity), ctx, sellerId, from, to)
+}
+
+// GetCohortRevenue mocks base method.
+func (m *MockCohortRepository) GetCohortRevenue(ctx context.Context, sellerId int64, from, to time.Time) ([]domain.CohortRevenue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCohortRevenue", ctx, sellerId, from, to)
+	ret0, _ := ret[0].([]domain.CohortRevenue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCohortRevenue indicates an expected call of GetCohortRevenue.
+func (mr *MockCohortRepositoryMockRecorder) GetCohortRevenue(ctx, sellerId, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCohortRevenue", reflect.TypeOf((*MockCohortRepository)(nil).GetCohortRevenue), ctx, sellerId, from, to)
+}
+
+// GetCohortSizes mocks base method.
+func (m *MockCohortRepository) GetCohortSizes(ctx context.Context, sellerId int64, from, to time.Time) ([]domain.CohortSize, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCohortSizes", ctx, sellerId, from, to)
+	ret0, _ := ret[0].([]domain.CohortSize)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCohortSizes indicates an expected call of GetCohortSizes.
+func (mr *MockCohortRepositoryMockRecorder) GetCohortSizes(ctx, sellerId, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCohortSizes", reflect.TypeOf((*MockCohortRepository)(nil).GetCohortSizes), ctx, sellerId, from, to)
+}
+
+// VoidOrder mocks base method.
+func (m *MockCohortRepository) VoidOrder(ctx context.Context, order domain.CohortOrder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoidOrder", ctx, order)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VoidOrder indicates an expected call of VoidOrder.
+func (mr *MockCohortRepositoryMockRecorder) VoidOrder(ctx, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoidOrder", reflect.TypeOf((*MockCohortRepository)(nil).VoidOrder), ctx, order)
+}
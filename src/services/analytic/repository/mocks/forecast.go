@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: forecast.go
+
+// Package mock_repository is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+)
+
+// MockForecastRepository is a mock of ForecastRepository interface.
+type MockForecastRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockForecastRepositoryMockRecorder
+}
+
+// MockForecastRepositoryMockRecorder is the mock recorder for MockForecastRepository.
+type MockForecastRepositoryMockRecorder struct {
+	mock *MockForecastRepository
+}
+
+// NewMockForecastRepository creates a new mock instance.
+func NewMockForecastRepository(ctrl *gomock.Controller) *MockForecastRepository {
+	mock := &MockForecastRepository{ctrl: ctrl}
+	mock.recorder = &MockForecastRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForecastRepository) EXPECT() *MockForecastRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetDailySales mocks base method.
+func (m *MockForecastRepository) GetDailySales(ctx context.Context, sellerId int64, from, to time.Time) ([]domain.DailySales, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDailySales", ctx, sellerId, from, to)
+	ret0, _ := ret[0].([]domain.DailySales)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDailySales indicates an expected call of GetDailySales.
+func (mr *MockForecastRepositoryMockRecorder) GetDailySales(ctx, sellerId, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDailySales", reflect.TypeOf((*MockForecastRepository)(nil).GetDailySales), ctx, sellerId, from, to)
+}
+
+// SaveDailySales mocks base method.
+func (m *MockForecastRepository) SaveDailySales(ctx context.Context, sales domain.DailySales) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveDailySales", ctx, sales)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveDailySales indicates an expected call of SaveDailySales.
+func (mr *MockForecastRepositoryMockRecorder) SaveDailySales(ctx, sales interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDailySales", reflect.TypeOf((*MockForecastRepository)(nil).SaveDailySales), ctx, sales)
+}
@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: goal.go
+
+// Package mock_repository is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+)
+
+// MockGoalRepository is a mock of GoalRepository interface.
+type MockGoalRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGoalRepositoryMockRecorder
+}
+
+// MockGoalRepositoryMockRecorder is the mock recorder for MockGoalRepository.
+type MockGoalRepositoryMockRecorder struct {
+	mock *MockGoalRepository
+}
+
+// NewMockGoalRepository creates a new mock instance.
+func NewMockGoalRepository(ctrl *gomock.Controller) *MockGoalRepository {
+	mock := &MockGoalRepository{ctrl: ctrl}
+	mock.recorder = &MockGoalRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGoalRepository) EXPECT() *MockGoalRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateGoal mocks base method.
+func (m *MockGoalRepository) CreateGoal(ctx context.Context, goal domain.Goal) (*domain.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGoal", ctx, goal)
+	ret0, _ := ret[0].(*domain.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGoal indicates an expected call of CreateGoal.
+func (mr *MockGoalRepositoryMockRecorder) CreateGoal(ctx, goal interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGoal", reflect.TypeOf((*MockGoalRepository)(nil).CreateGoal), ctx, goal)
+}
+
+// DeleteGoal mocks base method.
+func (m *MockGoalRepository) DeleteGoal(ctx context.Context, sellerId int64, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGoal", ctx, sellerId, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGoal indicates an expected call of DeleteGoal.
+func (mr *MockGoalRepositoryMockRecorder) DeleteGoal(ctx, sellerId, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGoal", reflect.TypeOf((*MockGoalRepository)(nil).DeleteGoal), ctx, sellerId, id)
+}
+
+// GetGoal mocks base method.
+func (m *MockGoalRepository) GetGoal(ctx context.Context, sellerId int64, id uint) (*domain.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGoal", ctx, sellerId, id)
+	ret0, _ := ret[0].(*domain.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGoal indicates an expected call of GetGoal.
+func (mr *MockGoalRepositoryMockRecorder) GetGoal(ctx, sellerId, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGoal", reflect.TypeOf((*MockGoalRepository)(nil).GetGoal), ctx, sellerId, id)
+}
+
+// GetGoals mocks base method.
+func (m *MockGoalRepository) GetGoals(ctx context.Context, sellerId int64, period string) ([]domain.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGoals", ctx, sellerId, period)
+	ret0, _ := ret[0].([]domain.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGoals indicates an expected call of GetGoals.
+func (mr *MockGoalRepositoryMockRecorder) GetGoals(ctx, sellerId, period interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGoals", reflect.TypeOf((*MockGoalRepository)(nil).GetGoals), ctx, sellerId, period)
+}
+
+// UpdateGoal mocks base method.
+func (m *MockGoalRepository) UpdateGoal(ctx context.Context, goal domain.Goal) (*domain.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGoal", ctx, goal)
+	ret0, _ := ret[0].(*domain.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGoal indicates an expected call of UpdateGoal.
+func (mr *MockGoalRepositoryMockRecorder) UpdateGoal(ctx, goal interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGoal", reflect.TypeOf((*MockGoalRepository)(nil).UpdateGoal), ctx, goal)
+}
@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: report.go
+
+// Package mock_repository is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+)
+
+// MockReportRepository is a mock of ReportRepository interface.
+type MockReportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportRepositoryMockRecorder
+}
+
+// MockReportRepositoryMockRecorder is the mock recorder for MockReportRepository.
+type MockReportRepositoryMockRecorder struct {
+	mock *MockReportRepository
+}
+
+// NewMockReportRepository creates a new mock instance.
+func NewMockReportRepository(ctrl *gomock.Controller) *MockReportRepository {
+	mock := &MockReportRepository{ctrl: ctrl}
+	mock.recorder = &MockReportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportRepository) EXPECT() *MockReportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateReport mocks base method.
+func (m *MockReportRepository) CreateReport(ctx context.Context, report domain.Report) (*domain.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReport", ctx, report)
+	ret0, _ := ret[0].(*domain.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReport indicates an expected call of CreateReport.
+func (mr *MockReportRepositoryMockRecorder) CreateReport(ctx, report interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReport", reflect.TypeOf((*MockReportRepository)(nil).CreateReport), ctx, report)
+}
+
+// GetReport mocks base method.
+func (m *MockReportRepository) GetReport(ctx context.Context, sellerId int64, id uint) (*domain.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReport", ctx, sellerId, id)
+	ret0, _ := ret[0].(*domain.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReport indicates an expected call of GetReport.
+func (mr *MockReportRepositoryMockRecorder) GetReport(ctx, sellerId, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*MockReportRepository)(nil).GetReport), ctx, sellerId, id)
+}
+
+// GetReportSellers mocks base method.
+func (m *MockReportRepository) GetReportSellers(ctx context.Context, from, to time.Time) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReportSellers", ctx, from, to)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReportSellers indicates an expected call of GetReportSellers.
+func (mr *MockReportRepositoryMockRecorder) GetReportSellers(ctx, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReportSellers", reflect.TypeOf((*MockReportRepository)(nil).GetReportSellers), ctx, from, to)
+}
+
+// GetReports mocks base method.
+func (m *MockReportRepository) GetReports(ctx context.Context, sellerId int64, period string) ([]domain.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReports", ctx, sellerId, period)
+	ret0, _ := ret[0].([]domain.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReports indicates an expected call of GetReports.
+func (mr *MockReportRepositoryMockRecorder) GetReports(ctx, sellerId, period interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReports", reflect.TypeOf((*MockReportRepository)(nil).GetReports), ctx, sellerId, period)
+}
+
+// GetUndeliveredReports mocks base method.
+func (m *MockReportRepository) GetUndeliveredReports(ctx context.Context, period string) ([]domain.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUndeliveredReports", ctx, period)
+	ret0, _ := ret[0].([]domain.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUndeliveredReports indicates an expected call of GetUndeliveredReports.
+func (mr *MockReportRepositoryMockRecorder) GetUndeliveredReports(ctx, period interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUndeliveredReports", reflect.TypeOf((*MockReportRepository)(nil).GetUndeliveredReports), ctx, period)
+}
+
+// SetReportDelivered mocks base method.
+func (m *MockReportRepository) SetReportDelivered(ctx context.Context, id uint, deliveredAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReportDelivered", ctx, id, deliveredAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetReportDelivered indicates an expected call of SetReportDelivered.
+func (mr *MockReportRepositoryMockRecorder) SetReportDelivered(ctx, id, deliveredAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReportDelivered", reflect.TypeOf((*MockReportRepository)(nil).SetReportDelivered), ctx, id, deliveredAt)
+}
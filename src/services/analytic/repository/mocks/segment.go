@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: segment.go
+
+// Package mock_repository is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+)
+
+// MockSegmentRepository is a mock of SegmentRepository interface.
+type MockSegmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSegmentRepositoryMockRecorder
+}
+
+// MockSegmentRepositoryMockRecorder is the mock recorder for MockSegmentRepository.
+type MockSegmentRepositoryMockRecorder struct {
+	mock *MockSegmentRepository
+}
+
+// NewMockSegmentRepository creates a new mock instance.
+func NewMockSegmentRepository(ctrl *gomock.Controller) *MockSegmentRepository {
+	mock := &MockSegmentRepository{ctrl: ctrl}
+	mock.recorder = &MockSegmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSegmentRepository) EXPECT() *MockSegmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetBuyerOrders mocks base method.
+func (m *MockSegmentRepository) GetBuyerOrders(ctx context.Context, sellerId int64) ([]domain.BuyerOrders, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBuyerOrders", ctx, sellerId)
+	ret0, _ := ret[0].([]domain.BuyerOrders)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBuyerOrders indicates an expected call of GetBuyerOrders.
+func (mr *MockSegmentRepositoryMockRecorder) GetBuyerOrders(ctx, sellerId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBuyerOrders", reflect.TypeOf((*MockSegmentRepository)(nil).GetBuyerOrders), ctx, sellerId)
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sink.go
+
+// Package mock_repository is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+)
+
+// MockReportSink is a mock of ReportSink interface.
+type MockReportSink struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportSinkMockRecorder
+}
+
+// MockReportSinkMockRecorder is the mock recorder for MockReportSink.
+type MockReportSinkMockRecorder struct {
+	mock *MockReportSink
+}
+
+// NewMockReportSink creates a new mock instance.
+func NewMockReportSink(ctrl *gomock.Controller) *MockReportSink {
+	mock := &MockReportSink{ctrl: ctrl}
+	mock.recorder = &MockReportSinkMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportSink) EXPECT() *MockReportSinkMockRecorder {
+	return m.recorder
+}
+
+// Deliver mocks base method.
+func (m *MockReportSink) Deliver(ctx context.Context, report domain.Report) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deliver", ctx, report)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Deliver indicates an expected call of Deliver.
+func (mr *MockReportSinkMockRecorder) Deliver(ctx, report interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deliver", reflect.TypeOf((*MockReportSink)(nil).Deliver), ctx, report)
+}
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// reportsLimit, most reports listed at once
+const reportsLimit = 100
+
+type ReportRepository interface {
+	CreateReport(ctx context.Context, report domain.Report) (*domain.Report, error)
+	GetReports(ctx context.Context, sellerId int64, period string) ([]domain.Report, error)
+	GetReport(ctx context.Context, sellerId int64, id uint) (*domain.Report, error)
+	GetReportSellers(ctx context.Context, from, to time.Time) ([]int64, error)
+	GetUndeliveredReports(ctx context.Context, period string) ([]domain.Report, error)
+	SetReportDelivered(ctx context.Context, id uint, deliveredAt time.Time) error
+}
+
+type reportRepository struct {
+	db *gorm.DB
+}
+
+func NewReportRepository(db *gorm.DB) ReportRepository {
+	return &reportRepository{
+		db: db,
+	}
+}
+
+// CreateReport, create report, returns nil when the seller already has the report of the period in the format
+func (rr *reportRepository) CreateReport(ctx context.Context, report domain.Report) (*domain.Report, error) {
+	result := rr.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&report)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	setReportDates(&report)
+	return &report, nil
+}
+
+// GetReports, the latest reports of the seller without their content, only the ones of period unless it is empty
+func (rr *reportRepository) GetReports(ctx context.Context, sellerId int64, period string) ([]domain.Report, error) {
+	var result []domain.Report
+
+	query := rr.db.WithContext(ctx).Omit("content").Where("seller_id = ?", sellerId)
+	if period != "" {
+		query = query.Where("period = ?", period)
+	}
+	if err := query.Order("from_date DESC, id DESC").Limit(reportsLimit).Find(&result).Error; err != nil {
+		return nil, err
+	}
+
+	for i := range result {
+		setReportDates(&result[i])
+	}
+	return result, nil
+}
+
+// GetReport, the report of the seller with its content, the report of another seller is not found
+func (rr *reportRepository) GetReport(ctx context.Context, sellerId int64, id uint) (*domain.Report, error) {
+	var result domain.Report
+	if err := rr.db.WithContext(ctx).Where("id = ? AND seller_id = ?", id, sellerId).First(&result).Error; err == gorm.ErrRecordNotFound {
+		return nil, domain.ErrReportNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	setReportDates(&result)
+	return &result, nil
+}
+
+// GetReportSellers, the sellers with analytic on the days from up to but excluding to
+func (rr *reportRepository) GetReportSellers(ctx context.Context, from, to time.Time) ([]int64, error) {
+	var result []int64
+	if err := rr.db.WithContext(ctx).Model(&domain.Analytic{}).
+		Where("date >= ? AND date < ?", datatypes.Date(from), datatypes.Date(to)).
+		Distinct().
+		Order("seller_id").
+		Pluck("seller_id", &result).Error; err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetUndeliveredReports, the oldest reports of period with their content a sink has yet to take
+func (rr *reportRepository) GetUndeliveredReports(ctx context.Context, period string) ([]domain.Report, error) {
+	var result []domain.Report
+	if err := rr.db.WithContext(ctx).
+		Where("period = ? AND delivered_at IS NULL", period).
+		Order("id").
+		Limit(reportsLimit).
+		Find(&result).Error; err != nil {
+		return nil, err
+	}
+
+	for i := range result {
+		setReportDates(&result[i])
+	}
+	return result, nil
+}
+
+// SetReportDelivered, records every sink took the report at deliveredAt
+func (rr *reportRepository) SetReportDelivered(ctx context.Context, id uint, deliveredAt time.Time) error {
+	return rr.db.WithContext(ctx).Model(&domain.Report{}).Where("id = ?", id).Update("delivered_at", deliveredAt).Error
+}
+
+// setReportDates, formats the days the report covers
+func setReportDates(report *domain.Report) {
+	report.FromString = time.Time(report.FromDate).Format(domain.AnalyticDateFormat)
+	report.ToString = time.Time(report.ToDate).Format(domain.AnalyticDateFormat)
+}
@@ -0,0 +1,339 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"gorm.io/datatypes"
+)
+
+func Test_reportRepository_CreateReport(t *testing.T) {
+	from := datatypes.Date(time.Date(2022, 1, 1, 0, 0, 0, 0, time.Local))
+	report := domain.Report{
+		SellerID: 2,
+		Period:   domain.ReportPeriodDaily,
+		Format:   domain.ReportFormatCSV,
+		FileName: "seller-2-daily-2022-01-01.csv",
+		Size:     5,
+		Content:  []byte("date\n"),
+		FromDate: from,
+		ToDate:   from,
+	}
+	query := regexp.QuoteMeta(
+		`INSERT INTO "reports" ("created_at","updated_at","deleted_at","seller_id","period","format","file_name","size","content","from_date","to_date","delivered_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12) ON CONFLICT DO NOTHING RETURNING "id"`)
+
+	tests := []struct {
+		name    string
+		want    *domain.Report
+		wantErr bool
+		mock    func()
+	}{
+		{
+			name: "success",
+			want: func() *domain.Report {
+				want := report
+				want.ID = 1
+				want.FromString, want.ToString = "2022-01-01", "2022-01-01"
+				return &want
+			}(),
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), int64(2), domain.ReportPeriodDaily, domain.ReportFormatCSV, "seller-2-daily-2022-01-01.csv", int64(5), []byte("date\n"), from, from, nil).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "already generated",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "error",
+			wantErr: true,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WillReturnError(errors.New("mock error"))
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			rr := NewReportRepository(gormdb)
+			res, err := rr.CreateReport(context.TODO(), report)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			if tt.want != nil {
+				require.NotNil(t, res)
+				res.CreatedAt, res.UpdatedAt = time.Time{}, time.Time{}
+			}
+			assert.Equal(t, tt.want, res)
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_reportRepository_GetReports(t *testing.T) {
+	date := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		period  string
+		want    []domain.Report
+		wantErr bool
+		mock    func()
+	}{
+		{
+			name: "success",
+			want: []domain.Report{
+				{SellerID: 2, Period: domain.ReportPeriodDaily, FromDate: datatypes.Date(date), ToDate: datatypes.Date(date), FromString: "2022-01-01", ToString: "2022-01-01"},
+			},
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					`SELECT "reports"."id","reports"."created_at","reports"."updated_at","reports"."deleted_at","reports"."seller_id","reports"."period","reports"."format","reports"."file_name","reports"."size","reports"."from_date","reports"."to_date","reports"."delivered_at" FROM "reports" WHERE seller_id = $1 AND "reports"."deleted_at" IS NULL ORDER BY from_date DESC, id DESC LIMIT 100`)).
+					WithArgs(int64(2)).
+					WillReturnRows(sqlmock.NewRows([]string{"seller_id", "period", "from_date", "to_date"}).
+						AddRow(2, domain.ReportPeriodDaily, date, date))
+			},
+		},
+		{
+			name:   "period",
+			period: domain.ReportPeriodWeekly,
+			want:   []domain.Report{},
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					`WHERE seller_id = $1 AND period = $2 AND "reports"."deleted_at" IS NULL ORDER BY from_date DESC, id DESC LIMIT 100`)).
+					WithArgs(int64(2), domain.ReportPeriodWeekly).
+					WillReturnRows(sqlmock.NewRows([]string{"seller_id"}))
+			},
+		},
+		{
+			name:    "error",
+			wantErr: true,
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM "reports"`)).
+					WillReturnError(errors.New("mock error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			rr := NewReportRepository(gormdb)
+			res, err := rr.GetReports(context.TODO(), 2, tt.period)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, res)
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_reportRepository_GetReport(t *testing.T) {
+	date := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	query := regexp.QuoteMeta(
+		`SELECT * FROM "reports" WHERE (id = $1 AND seller_id = $2) AND "reports"."deleted_at" IS NULL ORDER BY "reports"."id" LIMIT 1`)
+
+	tests := []struct {
+		name    string
+		want    *domain.Report
+		wantErr error
+		mock    func()
+	}{
+		{
+			name: "success",
+			want: &domain.Report{SellerID: 2, Content: []byte("date\n"), FromDate: datatypes.Date(date), ToDate: datatypes.Date(date), FromString: "2022-01-01", ToString: "2022-01-01"},
+			mock: func() {
+				mock.ExpectQuery(query).
+					WithArgs(uint(1), int64(2)).
+					WillReturnRows(sqlmock.NewRows([]string{"seller_id", "content", "from_date", "to_date"}).AddRow(2, []byte("date\n"), date, date))
+			},
+		},
+		{
+			name:    "report of another seller",
+			wantErr: domain.ErrReportNotFound,
+			mock: func() {
+				mock.ExpectQuery(query).
+					WithArgs(uint(1), int64(2)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			rr := NewReportRepository(gormdb)
+			res, err := rr.GetReport(context.TODO(), 2, 1)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, res)
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_reportRepository_GetReportSellers(t *testing.T) {
+	from := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 1)
+	query := regexp.QuoteMeta(
+		`SELECT DISTINCT "seller_id" FROM "analytics" WHERE (date >= $1 AND date < $2) AND "analytics"."deleted_at" IS NULL ORDER BY seller_id`)
+
+	tests := []struct {
+		name    string
+		want    []int64
+		wantErr bool
+		mock    func()
+	}{
+		{
+			name: "success",
+			want: []int64{0, 2},
+			mock: func() {
+				mock.ExpectQuery(query).
+					WithArgs(datatypes.Date(from), datatypes.Date(to)).
+					WillReturnRows(sqlmock.NewRows([]string{"seller_id"}).AddRow(0).AddRow(2))
+			},
+		},
+		{
+			name:    "error",
+			wantErr: true,
+			mock: func() {
+				mock.ExpectQuery(query).
+					WillReturnError(errors.New("mock error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			rr := NewReportRepository(gormdb)
+			res, err := rr.GetReportSellers(context.TODO(), from, to)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, res)
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_reportRepository_GetUndeliveredReports(t *testing.T) {
+	date := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	query := regexp.QuoteMeta(
+		`SELECT * FROM "reports" WHERE (period = $1 AND delivered_at IS NULL) AND "reports"."deleted_at" IS NULL ORDER BY id LIMIT 100`)
+
+	tests := []struct {
+		name    string
+		want    []domain.Report
+		wantErr bool
+		mock    func()
+	}{
+		{
+			name: "success",
+			want: []domain.Report{
+				{SellerID: 2, Period: domain.ReportPeriodDaily, Content: []byte("date\n"), FromDate: datatypes.Date(date), ToDate: datatypes.Date(date), FromString: "2022-01-01", ToString: "2022-01-01"},
+			},
+			mock: func() {
+				mock.ExpectQuery(query).
+					WithArgs(domain.ReportPeriodDaily).
+					WillReturnRows(sqlmock.NewRows([]string{"seller_id", "period", "content", "from_date", "to_date"}).
+						AddRow(2, domain.ReportPeriodDaily, []byte("date\n"), date, date))
+			},
+		},
+		{
+			name:    "error",
+			wantErr: true,
+			mock: func() {
+				mock.ExpectQuery(query).
+					WillReturnError(errors.New("mock error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			rr := NewReportRepository(gormdb)
+			res, err := rr.GetUndeliveredReports(context.TODO(), domain.ReportPeriodDaily)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, res)
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_reportRepository_SetReportDelivered(t *testing.T) {
+	deliveredAt := time.Date(2022, 1, 2, 1, 0, 0, 0, time.UTC)
+	query := regexp.QuoteMeta(
+		`UPDATE "reports" SET "delivered_at"=$1,"updated_at"=$2 WHERE id = $3 AND "reports"."deleted_at" IS NULL`)
+
+	tests := []struct {
+		name    string
+		wantErr bool
+		mock    func()
+	}{
+		{
+			name: "success",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(deliveredAt, sqlmock.AnyArg(), 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "error",
+			wantErr: true,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WillReturnError(errors.New("mock error"))
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			rr := NewReportRepository(gormdb)
+			err := rr.SetReportDelivered(context.TODO(), 1, deliveredAt)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -13,12 +13,22 @@ var Module = fx.Options(
 	fx.Provide(yugabyte.NewDatabase),
 	fx.Provide(messagequeue.NewRabbitMQ),
 	fx.Provide(messagequeue.NewRabbitMQSubscriber[statdomain.PayloadEventStatistic]),
+	fx.Provide(fx.Annotate(messagequeue.NewRabbitMQSubscriber[statdomain.PayloadEventOrder], fx.ParamTags(`name:"orderSubscriber"`))),
+	fx.Provide(fx.Annotate(messagequeue.NewRabbitMQPublisher[domain.PayloadEventAlert], fx.ParamTags(`name:"alertPublisher"`))),
 	fx.Provide(NewAnalyticRepository),
+	fx.Provide(NewCohortRepository),
+	fx.Provide(NewSegmentRepository),
+	fx.Provide(NewForecastRepository),
+	fx.Provide(NewAlertRepository),
+	fx.Provide(NewGoalRepository),
+	fx.Provide(NewBenchmarkRepository),
+	fx.Provide(NewReportRepository),
+	fx.Provide(NewReportSinks),
 	fx.Invoke(AutoMigrateEntities),
 )
 
 func AutoMigrateEntities(db *gorm.DB) error {
-	if err := db.AutoMigrate(&domain.Analytic{}); err != nil {
+	if err := db.AutoMigrate(&domain.Analytic{}, &domain.CohortBuyer{}, &domain.CohortOrder{}, &domain.DailySales{}, &domain.Alert{}, &domain.Goal{}, &domain.SellerCategory{}, &domain.Report{}); err != nil {
 		return err
 	}
 	return nil
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"gorm.io/gorm"
+)
+
+type SegmentRepository interface {
+	GetBuyerOrders(ctx context.Context, sellerId int64) ([]domain.BuyerOrders, error)
+}
+
+type segmentRepository struct {
+	db *gorm.DB
+}
+
+func NewSegmentRepository(db *gorm.DB) SegmentRepository {
+	return &segmentRepository{
+		db: db,
+	}
+}
+
+// GetBuyerOrders, the orders of every buyer of the seller per currency, void orders left out. Orders recorded
+// without a date fall back to the start of their week
+func (sr *segmentRepository) GetBuyerOrders(ctx context.Context, sellerId int64) ([]domain.BuyerOrders, error) {
+	var result []domain.BuyerOrders
+	if err := sr.db.WithContext(ctx).Model(&domain.CohortOrder{}).
+		Select("buyer_id, COUNT(*) AS orders, MAX(COALESCE(date, week)) AS last_order_date, revenue_currency, SUM(revenue_amount) AS revenue_amount").
+		Where("seller_id = ? AND NOT void", sellerId).
+		Group("buyer_id, revenue_currency").
+		Order("buyer_id, revenue_currency").
+		Scan(&result).Error; err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+)
+
+func Test_segmentRepository_GetBuyerOrders(t *testing.T) {
+	date := time.Date(2022, 1, 5, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		want    []domain.BuyerOrders
+		wantErr bool
+		mock    func()
+	}{
+		{
+			name: "success",
+			want: []domain.BuyerOrders{
+				{BuyerID: 3, Orders: 2, LastOrderDate: date, Revenue: money.New(100, "IDR")},
+				{BuyerID: 3, Orders: 1, LastOrderDate: date, Revenue: money.New(5, "USD")},
+			},
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					`SELECT buyer_id, COUNT(*) AS orders, MAX(COALESCE(date, week)) AS last_order_date, revenue_currency, SUM(revenue_amount) AS revenue_amount FROM "cohort_orders" WHERE (seller_id = $1 AND NOT void) AND "cohort_orders"."deleted_at" IS NULL GROUP BY buyer_id, revenue_currency ORDER BY buyer_id, revenue_currency`)).
+					WithArgs(int64(2)).
+					WillReturnRows(sqlmock.NewRows([]string{"buyer_id", "orders", "last_order_date", "revenue_currency", "revenue_amount"}).
+						AddRow(3, 2, date, "IDR", 100).
+						AddRow(3, 1, date, "USD", 5))
+			},
+		},
+		{
+			name:    "error",
+			wantErr: true,
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT buyer_id`)).
+					WillReturnError(errors.New("mock error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			sr := NewSegmentRepository(gormdb)
+			res, err := sr.GetBuyerOrders(context.TODO(), 2)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, res)
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"mime"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+)
+
+// ReportSink, delivers a generated report somewhere outside the service
+type ReportSink interface {
+	Deliver(ctx context.Context, report domain.Report) error
+}
+
+// NewReportSinks, the sinks configured to deliver reports to
+func NewReportSinks(cfg domain.ReportConfig) ([]ReportSink, error) {
+	var result []ReportSink
+	for _, name := range cfg.Sinks {
+		switch name {
+		case domain.ReportSinkFilesystem:
+			result = append(result, NewFilesystemSink(cfg.Directory))
+		case domain.ReportSinkSMTP:
+			result = append(result, NewSMTPSink(cfg.SMTP))
+		default:
+			return nil, fmt.Errorf("unknown report sink %q", name)
+		}
+	}
+	return result, nil
+}
+
+type filesystemSink struct {
+	directory string
+}
+
+// NewFilesystemSink, sink writing reports to a directory per seller below directory
+func NewFilesystemSink(directory string) ReportSink {
+	return &filesystemSink{
+		directory: directory,
+	}
+}
+
+// Deliver, writes the report to its file, replacing the file of an earlier delivery
+func (fs *filesystemSink) Deliver(ctx context.Context, report domain.Report) error {
+	dir := filepath.Join(fs.directory, strconv.FormatInt(report.SellerID, 10))
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, report.FileName), report.Content, 0o644)
+}
+
+type smtpSink struct {
+	cfg domain.ReportSMTPConfig
+}
+
+// NewSMTPSink, stand-in for a sink mailing reports, it writes the mail with the report attached to the outbox
+// directory instead of sending it
+func NewSMTPSink(cfg domain.ReportSMTPConfig) ReportSink {
+	return &smtpSink{
+		cfg: cfg,
+	}
+}
+
+// Deliver, writes the mail of the report to the outbox
+func (ss *smtpSink) Deliver(ctx context.Context, report domain.Report) error {
+	if err := os.MkdirAll(ss.cfg.Outbox, 0o755); err != nil {
+		return err
+	}
+
+	name := filepath.Join(ss.cfg.Outbox, fmt.Sprintf("%d-%s.eml", report.SellerID, report.FileName))
+	if err := os.WriteFile(name, reportMail(ss.cfg, report), 0o644); err != nil {
+		return err
+	}
+
+	log.Printf("[smtpSink] mail of report %s to %s written to %s", report.FileName, ss.cfg.To, name)
+	return nil
+}
+
+// reportMail, a mime mail from the configured sender to the configured recipient with the report attached
+func reportMail(cfg domain.ReportSMTPConfig, report domain.Report) []byte {
+	const boundary = "report-boundary"
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", cfg.From)
+	fmt.Fprintf(&buf, "To: %s\r\n", cfg.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8",
+		fmt.Sprintf("Seller %d %s report %s to %s", report.SellerID, report.Period, report.FromString, report.ToString)))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&buf, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%q\r\n\r\n", boundary)
+
+	fmt.Fprintf(&buf, "--%s\r\n", boundary)
+	fmt.Fprintf(&buf, "Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	fmt.Fprintf(&buf, "Attached is the %s report of seller %d from %s to %s.\r\n\r\n",
+		report.Period, report.SellerID, report.FromString, report.ToString)
+
+	fmt.Fprintf(&buf, "--%s\r\n", boundary)
+	fmt.Fprintf(&buf, "Content-Type: %s\r\n", domain.ReportContentType(report.Format))
+	fmt.Fprintf(&buf, "Content-Transfer-Encoding: base64\r\n")
+	fmt.Fprintf(&buf, "Content-Disposition: attachment; filename=%q\r\n\r\n", report.FileName)
+	encoded := base64.StdEncoding.EncodeToString(report.Content)
+	for len(encoded) > 76 {
+		buf.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	buf.WriteString(encoded + "\r\n")
+	fmt.Fprintf(&buf, "--%s--\r\n", boundary)
+
+	return buf.Bytes()
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+)
+
+func TestNewReportSinks(t *testing.T) {
+	tests := []struct {
+		name    string
+		sinks   []string
+		want    int
+		wantErr bool
+	}{
+		{
+			name:  "configured sinks",
+			sinks: []string{domain.ReportSinkFilesystem, domain.ReportSinkSMTP},
+			want:  2,
+		},
+		{
+			name: "no sinks",
+		},
+		{
+			name:    "unknown sink",
+			sinks:   []string{"ftp"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := NewReportSinks(domain.ReportConfig{Sinks: tt.sinks})
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Len(t, res, tt.want)
+		})
+	}
+}
+
+func Test_filesystemSink_Deliver(t *testing.T) {
+	dir := t.TempDir()
+	report := domain.Report{SellerID: 2, FileName: "seller-2-daily-2022-01-01.csv", Content: []byte("date\n")}
+
+	require.NoError(t, NewFilesystemSink(dir).Deliver(context.TODO(), report))
+
+	content, err := os.ReadFile(filepath.Join(dir, "2", "seller-2-daily-2022-01-01.csv"))
+	require.NoError(t, err)
+	assert.Equal(t, report.Content, content)
+}
+
+func Test_smtpSink_Deliver(t *testing.T) {
+	outbox := t.TempDir()
+	report := domain.Report{
+		SellerID:   2,
+		Period:     domain.ReportPeriodDaily,
+		Format:     domain.ReportFormatCSV,
+		FileName:   "seller-2-daily-2022-01-01.csv",
+		Content:    []byte("date\n"),
+		FromString: "2022-01-01",
+		ToString:   "2022-01-01",
+	}
+
+	sink := NewSMTPSink(domain.ReportSMTPConfig{From: "reports@example.com", To: "seller@example.com", Outbox: outbox})
+	require.NoError(t, sink.Deliver(context.TODO(), report))
+
+	mail, err := os.ReadFile(filepath.Join(outbox, "2-seller-2-daily-2022-01-01.csv.eml"))
+	require.NoError(t, err)
+	for _, want := range []string{
+		"From: reports@example.com\r\n",
+		"To: seller@example.com\r\n",
+		"Subject: Seller 2 daily report 2022-01-01 to 2022-01-01\r\n",
+		"Content-Type: text/csv; charset=utf-8\r\n",
+		"Content-Disposition: attachment; filename=\"seller-2-daily-2022-01-01.csv\"\r\n",
+		"ZGF0ZQo=\r\n",
+	} {
+		assert.True(t, strings.Contains(string(mail), want), "mail lacks %q", want)
+	}
+}
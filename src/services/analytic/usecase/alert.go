@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"math"
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/repository"
+	"gorm.io/datatypes"
+)
+
+type AlertUsecase interface {
+	GetAlerts(ctx context.Context, sellerId int64, acknowledged *bool) ([]domain.Alert, error)
+	AcknowledgeAlert(ctx context.Context, sellerId int64, id uint) (*domain.Alert, error)
+	HandleStatisticEvent(statisticEvent domain.StatisticEvent)
+}
+
+type alertUsecase struct {
+	analyticRepo repository.AnalyticRepository
+	alertRepo    repository.AlertRepository
+}
+
+func NewAlertUsecase(analyticRepo repository.AnalyticRepository, alertRepo repository.AlertRepository) AlertUsecase {
+	return &alertUsecase{
+		analyticRepo: analyticRepo,
+		alertRepo:    alertRepo,
+	}
+}
+
+func (au *alertUsecase) GetAlerts(ctx context.Context, sellerId int64, acknowledged *bool) ([]domain.Alert, error) {
+	return au.alertRepo.GetAlerts(ctx, sellerId, acknowledged)
+}
+
+// AcknowledgeAlert, acknowledges the alert of the seller, ErrAlertNotFound for the alert of another seller
+func (au *alertUsecase) AcknowledgeAlert(ctx context.Context, sellerId int64, id uint) (*domain.Alert, error) {
+	return au.alertRepo.AcknowledgeAlert(ctx, sellerId, id)
+}
+
+// HandleStatisticEvent, checks the seller's metrics against their trailing window, raising and publishing an alert
+// for every anomalous one not alerted on yet
+func (au *alertUsecase) HandleStatisticEvent(statisticEvent domain.StatisticEvent) {
+	ctx := context.Background()
+
+	date, err := time.Parse(domain.AnalyticDateFormat, statisticEvent.Date)
+	if err != nil {
+		log.Println("[HandleStatisticEvent] error parsing date", err)
+		return
+	}
+
+	// the window of the previous day, whose revenue is checked, starts a day earlier
+	history, err := au.analyticRepo.GetAnalytics(ctx, statisticEvent.SellerID, date.AddDate(0, 0, -domain.AnomalyWindowDays-1), date)
+	if err != nil {
+		log.Println("[HandleStatisticEvent] error GetAnalytics", err)
+		return
+	}
+
+	for _, alert := range detectAnomalies(statisticEvent, date, history) {
+		res, err := au.alertRepo.CreateAlert(ctx, alert)
+		if err != nil {
+			log.Println("[HandleStatisticEvent] error CreateAlert", err)
+			continue
+		}
+		if res == nil {
+			// already alerted on the metric that day
+			continue
+		}
+
+		if err := au.alertRepo.PublishAlertEvent(ctx, domain.PayloadEventAlert{
+			AlertID:  res.ID,
+			SellerID: res.SellerID,
+			Metric:   res.Metric,
+			Reason:   res.Reason,
+			Value:    res.Value,
+			Baseline: res.Baseline,
+			ZScore:   res.ZScore,
+			Date:     res.DateString,
+		}); err != nil {
+			log.Println("[HandleStatisticEvent] error PublishAlertEvent", err)
+		}
+	}
+}
+
+// detectAnomalies, the alerts raised by the statistics of a seller's day given the analytic of the days before.
+// The cancellation rate of the day is checked once it has enough orders, the net revenue is checked on the previous
+// day since the revenue of the day is still growing
+func detectAnomalies(statisticEvent domain.StatisticEvent, date time.Time, history []domain.Analytic) []domain.Alert {
+	var result []domain.Alert
+
+	analytics := make(map[string]domain.Analytic, len(history))
+	for _, a := range history {
+		analytics[time.Time(a.Date).Format(domain.AnalyticDateFormat)] = a
+	}
+
+	if statisticEvent.TotalOrder >= domain.AnomalyMinOrders {
+		rate := float64(statisticEvent.CanceledOrder) / float64(statisticEvent.TotalOrder) * 100
+		var window []float64
+		for _, a := range anomalyWindow(analytics, date) {
+			window = append(window, float64(a.CancellationOrderRate))
+		}
+		if alert, ok := checkAnomaly(rate, window, 1, rate >= domain.AnomalyCancellationRateLimit); ok {
+			alert.Metric = domain.AlertMetricCancellationRate
+			result = append(result, alert)
+		}
+	}
+
+	previous := date.AddDate(0, 0, -1)
+	if days := anomalyWindow(analytics, previous); len(days) >= domain.AnomalyMinHistory {
+		checked := analytics[previous.Format(domain.AnalyticDateFormat)].NetRevenue
+		// revenue is compared in the currency of the latest day with revenue, the seller's reporting currency
+		currency := checked.Currency
+		for i := len(days) - 1; currency == "" && i >= 0; i-- {
+			currency = days[i].NetRevenue.Currency
+		}
+		// a day without analytic had no revenue. Amounts of another currency can't be compared with the ones of the
+		// reporting currency, the window of a seller that changed its reporting currency starts after the change
+		window := make([]float64, 0, domain.AnomalyWindowDays)
+		for i := 0; i < domain.AnomalyWindowDays; i++ {
+			day := analytics[previous.AddDate(0, 0, i-domain.AnomalyWindowDays).Format(domain.AnalyticDateFormat)].NetRevenue
+			if day.Currency != "" && day.Currency != currency {
+				window = window[:0]
+				continue
+			}
+			window = append(window, day.Major())
+		}
+		// a window trimmed by a currency change is too short to tell a drop, the revenue is checked again once the
+		// window is back to full length in the new currency
+		if len(window) == domain.AnomalyWindowDays {
+			revenue := checked.Major()
+			baseline := mean(window)
+			if alert, ok := checkAnomaly(revenue, window, -1, baseline > 0 && revenue < baseline*domain.AnomalyRevenueDropRatio); ok {
+				alert.Metric = domain.AlertMetricNetRevenue
+				alert.Date = datatypes.Date(previous)
+				result = append(result, alert)
+			}
+		}
+	}
+
+	for i := range result {
+		result[i].SellerID = statisticEvent.SellerID
+		if result[i].Date == (datatypes.Date{}) {
+			result[i].Date = datatypes.Date(date)
+		}
+	}
+	return result
+}
+
+// anomalyWindow, the analytic of the days of the window before date
+func anomalyWindow(analytics map[string]domain.Analytic, date time.Time) []domain.Analytic {
+	var result []domain.Analytic
+	for i := domain.AnomalyWindowDays; i > 0; i-- {
+		if a, ok := analytics[date.AddDate(0, 0, -i).Format(domain.AnalyticDateFormat)]; ok {
+			result = append(result, a)
+		}
+	}
+	return result
+}
+
+// checkAnomaly, alerts on value when it crosses its threshold or lies AnomalyZScore standard deviations from the
+// window in direction, 1 for spikes and -1 for drops. The z-score needs AnomalyMinHistory days of a varying window
+func checkAnomaly(value float64, window []float64, direction float64, threshold bool) (domain.Alert, bool) {
+	alert := domain.Alert{
+		Value:    value,
+		Baseline: mean(window),
+	}
+	if len(window) >= domain.AnomalyMinHistory {
+		if sd := standardDeviation(window); sd > 0 {
+			alert.ZScore = (value - alert.Baseline) / sd
+		}
+	}
+
+	switch {
+	case threshold:
+		alert.Reason = domain.AlertReasonThreshold
+	case alert.ZScore*direction >= domain.AnomalyZScore:
+		alert.Reason = domain.AlertReasonZScore
+	default:
+		return domain.Alert{}, false
+	}
+	return alert, true
+}
+
+// standardDeviation, sample standard deviation of values, zero with fewer than two values
+func standardDeviation(values []float64) float64 {
+	if len(values) < 2 {
+		return 0
+	}
+	m := mean(values)
+	var sum float64
+	for _, v := range values {
+		sum += (v - m) * (v - m)
+	}
+	return math.Sqrt(sum / float64(len(values)-1))
+}
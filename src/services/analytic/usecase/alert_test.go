@@ -0,0 +1,338 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/repository"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/repository/mocks"
+	"gorm.io/datatypes"
+)
+
+// alertHistory, analytic of the days before date, day returns the cancellation rate and net revenue i days before
+func alertHistory(date time.Time, days int, day func(i int) (float32, int64)) []domain.Analytic {
+	var result []domain.Analytic
+	for i := days; i > 0; i-- {
+		rate, revenue := day(i)
+		result = append(result, domain.Analytic{
+			SellerID:              2,
+			CancellationOrderRate: rate,
+			NetRevenue:            money.New(revenue, "USD"),
+			Date:                  datatypes.Date(date.AddDate(0, 0, -i)),
+		})
+	}
+	return result
+}
+
+func Test_detectAnomalies(t *testing.T) {
+	date := time.Date(2022, 1, 20, 0, 0, 0, 0, time.UTC)
+
+	type alert struct {
+		metric string
+		reason string
+		date   string
+	}
+	tests := []struct {
+		name    string
+		event   domain.StatisticEvent
+		history []domain.Analytic
+		want    []alert
+	}{
+		{
+			name:  "cancellation rate over the limit",
+			event: domain.StatisticEvent{SellerID: 2, TotalOrder: 10, CanceledOrder: 6},
+			want: []alert{
+				{domain.AlertMetricCancellationRate, domain.AlertReasonThreshold, "2022-01-20"},
+			},
+		},
+		{
+			name:  "cancellation rate spike",
+			event: domain.StatisticEvent{SellerID: 2, TotalOrder: 10, CanceledOrder: 2},
+			history: alertHistory(date, 15, func(i int) (float32, int64) {
+				return float32(4 + 2*(i%2)), 100000
+			}),
+			want: []alert{
+				{domain.AlertMetricCancellationRate, domain.AlertReasonZScore, "2022-01-20"},
+			},
+		},
+		{
+			name:  "too few orders",
+			event: domain.StatisticEvent{SellerID: 2, TotalOrder: 5, CanceledOrder: 5},
+		},
+		{
+			name:  "cancellation rate without enough history",
+			event: domain.StatisticEvent{SellerID: 2, TotalOrder: 10, CanceledOrder: 2},
+			history: alertHistory(date, 3, func(i int) (float32, int64) {
+				return float32(4 + 2*(i%2)), 100000
+			}),
+		},
+		{
+			name:  "revenue under half the average",
+			event: domain.StatisticEvent{SellerID: 2},
+			history: alertHistory(date, 15, func(i int) (float32, int64) {
+				if i == 1 {
+					return 0, 10000
+				}
+				return 0, 100000
+			}),
+			want: []alert{
+				{domain.AlertMetricNetRevenue, domain.AlertReasonThreshold, "2022-01-19"},
+			},
+		},
+		{
+			name:  "revenue drop",
+			event: domain.StatisticEvent{SellerID: 2},
+			history: alertHistory(date, 15, func(i int) (float32, int64) {
+				if i == 1 {
+					return 0, 60000
+				}
+				return 0, int64(90000 + 20000*(i%2))
+			}),
+			want: []alert{
+				{domain.AlertMetricNetRevenue, domain.AlertReasonZScore, "2022-01-19"},
+			},
+		},
+		{
+			name:  "revenue drop after a currency change",
+			event: domain.StatisticEvent{SellerID: 2},
+			history: func() []domain.Analytic {
+				// ten days in IDR since the seller switched from USD, a USD day's revenue dwarfs an IDR one's
+				history := alertHistory(date, 15, func(i int) (float32, int64) {
+					if i <= 10 {
+						return 0, 150
+					}
+					return 0, 100000
+				})
+				for i := range history[5:] {
+					history[5+i].NetRevenue = money.New(history[5+i].NetRevenue.Amount, "IDR")
+				}
+				return history
+			}(),
+		},
+		{
+			name:  "revenue drop in a full window since a currency change",
+			event: domain.StatisticEvent{SellerID: 2},
+			history: func() []domain.Analytic {
+				history := alertHistory(date, 16, func(i int) (float32, int64) {
+					if i == 1 {
+						return 0, 10
+					}
+					if i <= 15 {
+						return 0, 150
+					}
+					return 0, 100000
+				})
+				for i := range history[1:] {
+					history[1+i].NetRevenue = money.New(history[1+i].NetRevenue.Amount, "IDR")
+				}
+				return history
+			}(),
+			want: []alert{
+				{domain.AlertMetricNetRevenue, domain.AlertReasonThreshold, "2022-01-19"},
+			},
+		},
+		{
+			name:  "usual day",
+			event: domain.StatisticEvent{SellerID: 2, TotalOrder: 10, CanceledOrder: 1},
+			history: alertHistory(date, 15, func(i int) (float32, int64) {
+				return float32(5 + 10*(i%2)), int64(90000 + 20000*(i%2))
+			}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []alert
+			for _, a := range detectAnomalies(tt.event, date, tt.history) {
+				if a.SellerID != tt.event.SellerID {
+					t.Errorf("detectAnomalies() seller = %v, want %v", a.SellerID, tt.event.SellerID)
+				}
+				got = append(got, alert{a.Metric, a.Reason, time.Time(a.Date).Format(domain.AnalyticDateFormat)})
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("detectAnomalies() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_checkAnomaly(t *testing.T) {
+	window := []float64{4, 6, 4, 6, 4, 6, 4, 6}
+
+	got, ok := checkAnomaly(20, window, 1, false)
+	if !ok || got.Reason != domain.AlertReasonZScore || got.Baseline != 5 || got.ZScore < domain.AnomalyZScore {
+		t.Errorf("checkAnomaly() = %v, %v, want a z-score alert", got, ok)
+	}
+
+	// a drop is no spike
+	if got, ok := checkAnomaly(-10, window, 1, false); ok {
+		t.Errorf("checkAnomaly() = %v, want no alert", got)
+	}
+
+	// a window without variation has no z-score
+	if got, ok := checkAnomaly(20, []float64{5, 5, 5, 5, 5, 5, 5}, 1, false); ok {
+		t.Errorf("checkAnomaly() = %v, want no alert", got)
+	}
+}
+
+func Test_alertUsecase_HandleStatisticEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	date := time.Date(2022, 1, 20, 0, 0, 0, 0, time.UTC)
+	event := domain.StatisticEvent{
+		SellerID:      2,
+		TotalOrder:    10,
+		CanceledOrder: 6,
+		Date:          "2022-01-20",
+	}
+	raised := &domain.Alert{
+		SellerID:   2,
+		Metric:     domain.AlertMetricCancellationRate,
+		Reason:     domain.AlertReasonThreshold,
+		Value:      60,
+		Date:       datatypes.Date(date),
+		DateString: "2022-01-20",
+	}
+	raised.ID = 1
+
+	tests := []struct {
+		name         string
+		event        domain.StatisticEvent
+		analyticRepo func() repository.AnalyticRepository
+		alertRepo    func() repository.AlertRepository
+	}{
+		{
+			name:  "sukses",
+			event: event,
+			analyticRepo: func() repository.AnalyticRepository {
+				m := mocks.NewMockAnalyticRepository(ctrl)
+				m.EXPECT().GetAnalytics(gomock.Any(), int64(2), date.AddDate(0, 0, -domain.AnomalyWindowDays-1), date).Return(nil, nil)
+				return m
+			},
+			alertRepo: func() repository.AlertRepository {
+				m := mocks.NewMockAlertRepository(ctrl)
+				m.EXPECT().CreateAlert(gomock.Any(), domain.Alert{
+					SellerID: 2,
+					Metric:   domain.AlertMetricCancellationRate,
+					Reason:   domain.AlertReasonThreshold,
+					Value:    60,
+					Date:     datatypes.Date(date),
+				}).Return(raised, nil)
+				m.EXPECT().PublishAlertEvent(gomock.Any(), domain.PayloadEventAlert{
+					AlertID:  1,
+					SellerID: 2,
+					Metric:   domain.AlertMetricCancellationRate,
+					Reason:   domain.AlertReasonThreshold,
+					Value:    60,
+					Date:     "2022-01-20",
+				}).Return(nil)
+				return m
+			},
+		},
+		{
+			name:  "already alerted",
+			event: event,
+			analyticRepo: func() repository.AnalyticRepository {
+				m := mocks.NewMockAnalyticRepository(ctrl)
+				m.EXPECT().GetAnalytics(gomock.Any(), int64(2), gomock.Any(), gomock.Any()).Return(nil, nil)
+				return m
+			},
+			alertRepo: func() repository.AlertRepository {
+				m := mocks.NewMockAlertRepository(ctrl)
+				m.EXPECT().CreateAlert(gomock.Any(), gomock.Any()).Return(nil, nil)
+				return m
+			},
+		},
+		{
+			name:  "error create alert",
+			event: event,
+			analyticRepo: func() repository.AnalyticRepository {
+				m := mocks.NewMockAnalyticRepository(ctrl)
+				m.EXPECT().GetAnalytics(gomock.Any(), int64(2), gomock.Any(), gomock.Any()).Return(nil, nil)
+				return m
+			},
+			alertRepo: func() repository.AlertRepository {
+				m := mocks.NewMockAlertRepository(ctrl)
+				m.EXPECT().CreateAlert(gomock.Any(), gomock.Any()).Return(nil, errors.New("mock error"))
+				return m
+			},
+		},
+		{
+			name:  "error get analytics",
+			event: event,
+			analyticRepo: func() repository.AnalyticRepository {
+				m := mocks.NewMockAnalyticRepository(ctrl)
+				m.EXPECT().GetAnalytics(gomock.Any(), int64(2), gomock.Any(), gomock.Any()).Return(nil, errors.New("mock error"))
+				return m
+			},
+			alertRepo: func() repository.AlertRepository {
+				return mocks.NewMockAlertRepository(ctrl)
+			},
+		},
+		{
+			name: "invalid date",
+			event: domain.StatisticEvent{
+				SellerID: 2,
+				Date:     "2022,01-20",
+			},
+			analyticRepo: func() repository.AnalyticRepository {
+				return mocks.NewMockAnalyticRepository(ctrl)
+			},
+			alertRepo: func() repository.AlertRepository {
+				return mocks.NewMockAlertRepository(ctrl)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			au := NewAlertUsecase(tt.analyticRepo(), tt.alertRepo())
+			au.HandleStatisticEvent(tt.event)
+		})
+	}
+}
+
+func Test_alertUsecase_AcknowledgeAlert(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name     string
+		sellerId int64
+		wantErr  error
+		repo     func() repository.AlertRepository
+	}{
+		{
+			name:     "sukses",
+			sellerId: 2,
+			repo: func() repository.AlertRepository {
+				m := mocks.NewMockAlertRepository(ctrl)
+				m.EXPECT().AcknowledgeAlert(gomock.Any(), int64(2), uint(1)).Return(&domain.Alert{SellerID: 2}, nil)
+				return m
+			},
+		},
+		{
+			name:     "alert of another seller",
+			sellerId: 3,
+			wantErr:  domain.ErrAlertNotFound,
+			repo: func() repository.AlertRepository {
+				m := mocks.NewMockAlertRepository(ctrl)
+				m.EXPECT().AcknowledgeAlert(gomock.Any(), int64(3), uint(1)).Return(nil, domain.ErrAlertNotFound)
+				return m
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			au := NewAlertUsecase(mocks.NewMockAnalyticRepository(ctrl), tt.repo())
+			if _, err := au.AcknowledgeAlert(context.TODO(), tt.sellerId, 1); !errors.Is(err, tt.wantErr) {
+				t.Errorf("alertUsecase.AcknowledgeAlert() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -12,7 +12,7 @@ import (
 )
 
 type AnalyticUsecase interface {
-	GetAnalyticByDate(ctx context.Context, date time.Time) (*domain.Analytic, error)
+	GetAnalyticByDate(ctx context.Context, sellerId int64, date time.Time) (*domain.Analytic, error)
 	HandleStatisticEvent(statisticEvent domain.StatisticEvent)
 }
 
@@ -26,8 +26,8 @@ func NewAnalyticsUsecase(analyticRepo repository.AnalyticRepository) AnalyticUse
 	}
 }
 
-func (au *analyticUsecase) GetAnalyticByDate(ctx context.Context, date time.Time) (*domain.Analytic, error) {
-	res, err := au.analyticRepo.GetAnalyticByDate(ctx, date)
+func (au *analyticUsecase) GetAnalyticByDate(ctx context.Context, sellerId int64, date time.Time) (*domain.Analytic, error) {
+	res, err := au.analyticRepo.GetAnalyticByDate(ctx, sellerId, date)
 	if err != nil {
 		return nil, err
 	}
@@ -44,7 +44,7 @@ func (au *analyticUsecase) HandleStatisticEvent(statisticEvent domain.StatisticE
 		return
 	}
 
-	res, err := au.analyticRepo.GetAnalyticByDate(ctx, date)
+	res, err := au.analyticRepo.GetAnalyticByDate(ctx, statisticEvent.SellerID, date)
 	if err != nil {
 		log.Println("[HandleOrderEvent] error GetAnalyticByDate", err)
 		return
@@ -75,17 +75,77 @@ func (au *analyticUsecase) HandleStatisticEvent(statisticEvent domain.StatisticE
 }
 
 func calculateAnalytic(statisticEvent domain.StatisticEvent) (domain.Analytic, error) {
-	var res domain.Analytic
+	res := domain.Analytic{
+		SellerID: statisticEvent.SellerID,
+	}
 
-	if statisticEvent.TotalRevenue > 0 && statisticEvent.CompletedOrder > 0 {
-		res.AverageOrderValue = statisticEvent.TotalRevenue / float64(statisticEvent.CompletedOrder)
+	if statisticEvent.TotalRevenue.Amount > 0 && statisticEvent.CompletedOrder > 0 {
+		res.AverageOrderValue = statisticEvent.TotalRevenue.Div(statisticEvent.CompletedOrder)
+	}
+	if statisticEvent.CompletedOrder > 0 && statisticEvent.ProductViews > 0 {
+		res.SalesConvertionRate = float32(statisticEvent.CompletedOrder) / float32(statisticEvent.ProductViews) * 100
+	}
+	if statisticEvent.CartAdds > 0 && statisticEvent.ProductViews > 0 {
+		res.ViewToCartRate = float32(statisticEvent.CartAdds) / float32(statisticEvent.ProductViews) * 100
+	}
+	if statisticEvent.TotalOrder > 0 && statisticEvent.CartAdds > 0 {
+		res.CartToOrderRate = float32(statisticEvent.TotalOrder) / float32(statisticEvent.CartAdds) * 100
+	}
+	// checkouts count every attempt, the ones that failed are the checkouts not placing an order
+	if statisticEvent.TotalOrder > 0 && statisticEvent.CheckoutsStarted > 0 {
+		res.CheckoutRate = float32(statisticEvent.TotalOrder) / float32(statisticEvent.CheckoutsStarted) * 100
 	}
 	if statisticEvent.CompletedOrder > 0 && statisticEvent.TotalOrder > 0 {
-		res.SalesConvertionRate = float32(statisticEvent.CompletedOrder) / float32(statisticEvent.TotalOrder) * 100
+		res.OrderCompletionRate = float32(statisticEvent.CompletedOrder) / float32(statisticEvent.TotalOrder) * 100
 	}
 	if statisticEvent.CanceledOrder > 0 && statisticEvent.TotalOrder > 0 {
 		res.CancellationOrderRate = float32(statisticEvent.CanceledOrder) / float32(statisticEvent.TotalOrder) * 100
 	}
+	if statisticEvent.RefundedOrders > 0 && statisticEvent.CompletedOrder > 0 {
+		res.RefundRate = float32(statisticEvent.RefundedOrders) / float32(statisticEvent.CompletedOrder) * 100
+	}
+	if statisticEvent.ShippedOrders > 0 {
+		res.AverageLeadTimeHours = float32(statisticEvent.ShippingLeadTime) / float32(statisticEvent.ShippedOrders) / 3600
+		res.OnTimeShippingRate = float32(statisticEvent.OnTimeShipments) / float32(statisticEvent.ShippedOrders) * 100
+	}
+	if statisticEvent.UniqueBuyers > 0 {
+		res.UniqueBuyers = statisticEvent.UniqueBuyers
+		res.NewBuyers = statisticEvent.NewBuyers
+		res.ReturningBuyers = statisticEvent.ReturningBuyers
+		res.RepeatPurchaseRate = float32(statisticEvent.ReturningBuyers) / float32(statisticEvent.UniqueBuyers) * 100
+	}
+	if statisticEvent.ReviewCount > 0 {
+		reviews := float32(statisticEvent.ReviewCount)
+		res.AverageRating = float32(statisticEvent.RatingSum) / reviews
+		res.RatingShares = domain.RatingShares{
+			One:   float32(statisticEvent.Ratings.One) / reviews * 100,
+			Two:   float32(statisticEvent.Ratings.Two) / reviews * 100,
+			Three: float32(statisticEvent.Ratings.Three) / reviews * 100,
+			Four:  float32(statisticEvent.Ratings.Four) / reviews * 100,
+			Five:  float32(statisticEvent.Ratings.Five) / reviews * 100,
+		}
+		if statisticEvent.CompletedOrder > 0 {
+			// reviews of a day may belong to orders completed on earlier days, hence the rate can exceed 100
+			res.ReviewRate = reviews / float32(statisticEvent.CompletedOrder) * 100
+		}
+	}
+	if statisticEvent.TotalRevenue.Currency != "" {
+		// both amounts are in the reporting currency of the seller
+		netRevenue, err := statisticEvent.TotalRevenue.Sub(statisticEvent.RefundedAmount)
+		if err != nil {
+			return domain.Analytic{}, err
+		}
+		res.NetRevenue = netRevenue
+
+		grossRevenue, err := statisticEvent.TotalRevenue.Add(statisticEvent.DiscountAmount)
+		if err != nil {
+			return domain.Analytic{}, err
+		}
+		res.GrossRevenue = grossRevenue
+		if statisticEvent.DiscountAmount.Amount > 0 && grossRevenue.Amount > 0 {
+			res.DiscountRate = float32(statisticEvent.DiscountAmount.Amount) / float32(grossRevenue.Amount) * 100
+		}
+	}
 
 	date, err := time.Parse(domain.AnalyticDateFormat, statisticEvent.Date)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/repository"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/repository/mocks"
@@ -31,15 +32,15 @@ func Test_analyticUsecase_GetAnalyticByDate(t *testing.T) {
 			name: "sukses",
 			date: date,
 			want: &domain.Analytic{
-				AverageOrderValue:     100,
+				AverageOrderValue:     money.New(100, "IDR"),
 				SalesConvertionRate:   80,
 				CancellationOrderRate: 20,
 				Date:                  datatypes.Date(date),
 			},
 			repo: func() repository.AnalyticRepository {
 				m := mocks.NewMockAnalyticRepository(ctrl)
-				m.EXPECT().GetAnalyticByDate(gomock.Any(), date).Return(&domain.Analytic{
-					AverageOrderValue:     100,
+				m.EXPECT().GetAnalyticByDate(gomock.Any(), int64(1), date).Return(&domain.Analytic{
+					AverageOrderValue:     money.New(100, "IDR"),
 					SalesConvertionRate:   80,
 					CancellationOrderRate: 20,
 					Date:                  datatypes.Date(date),
@@ -54,7 +55,7 @@ func Test_analyticUsecase_GetAnalyticByDate(t *testing.T) {
 			wantErr: true,
 			repo: func() repository.AnalyticRepository {
 				m := mocks.NewMockAnalyticRepository(ctrl)
-				m.EXPECT().GetAnalyticByDate(gomock.Any(), date).Return(nil, errors.New("mock error"))
+				m.EXPECT().GetAnalyticByDate(gomock.Any(), int64(1), date).Return(nil, errors.New("mock error"))
 				return m
 			},
 		},
@@ -62,7 +63,7 @@ func Test_analyticUsecase_GetAnalyticByDate(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			au := NewAnalyticsUsecase(tt.repo())
-			got, err := au.GetAnalyticByDate(context.TODO(), tt.date)
+			got, err := au.GetAnalyticByDate(context.TODO(), 1, tt.date)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("analyticUsecase.GetAnalyticByDate() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -90,7 +91,7 @@ func Test_analyticUsecase_HandleStatisticEvent(t *testing.T) {
 		{
 			name: "sukses",
 			analytic: domain.StatisticEvent{
-				TotalRevenue:   100,
+				TotalRevenue:   money.New(100, "IDR"),
 				CompletedOrder: 4,
 				CanceledOrder:  1,
 				TotalOrder:     5,
@@ -98,19 +99,21 @@ func Test_analyticUsecase_HandleStatisticEvent(t *testing.T) {
 			},
 			repo: func() repository.AnalyticRepository {
 				m := mocks.NewMockAnalyticRepository(ctrl)
-				m.EXPECT().GetAnalyticByDate(gomock.Any(), dateTime).Return(&domain.Analytic{
-					AverageOrderValue:     25,
+				m.EXPECT().GetAnalyticByDate(gomock.Any(), int64(0), dateTime).Return(&domain.Analytic{
+					AverageOrderValue:     money.New(25, "IDR"),
 					SalesConvertionRate:   100,
 					CancellationOrderRate: 0,
 					Date:                  date,
 				}, nil)
 				m.EXPECT().UpdateAnalytic(gomock.Any(), domain.Analytic{
-					AverageOrderValue:     25,
-					SalesConvertionRate:   80,
+					AverageOrderValue:     money.New(25, "IDR"),
+					OrderCompletionRate:   80,
 					CancellationOrderRate: 20,
+					NetRevenue:            money.New(100, "IDR"),
+					GrossRevenue:          money.New(100, "IDR"),
 					Date:                  date,
 				}).Return(&domain.Analytic{
-					AverageOrderValue:     25,
+					AverageOrderValue:     money.New(25, "IDR"),
 					SalesConvertionRate:   80,
 					CancellationOrderRate: 20,
 					Date:                  date,
@@ -118,10 +121,144 @@ func Test_analyticUsecase_HandleStatisticEvent(t *testing.T) {
 				return m
 			},
 		},
+		{
+			name: "refunded orders",
+			analytic: domain.StatisticEvent{
+				TotalRevenue:   money.New(100, "IDR"),
+				CompletedOrder: 4,
+				TotalOrder:     4,
+				RefundedAmount: money.New(30, "IDR"),
+				RefundedOrders: 1,
+				Date:           dateString,
+			},
+			repo: func() repository.AnalyticRepository {
+				m := mocks.NewMockAnalyticRepository(ctrl)
+				m.EXPECT().GetAnalyticByDate(gomock.Any(), int64(0), dateTime).Return(nil, nil)
+				m.EXPECT().CreateAnalytic(gomock.Any(), domain.Analytic{
+					AverageOrderValue:   money.New(25, "IDR"),
+					OrderCompletionRate: 100,
+					RefundRate:          25,
+					NetRevenue:          money.New(70, "IDR"),
+					GrossRevenue:        money.New(100, "IDR"),
+					Date:                date,
+				}).Return(&domain.Analytic{}, nil)
+				return m
+			},
+		},
+		{
+			name: "discounted orders",
+			analytic: domain.StatisticEvent{
+				TotalRevenue:   money.New(90, "IDR"),
+				CompletedOrder: 1,
+				TotalOrder:     1,
+				DiscountAmount: money.New(10, "IDR"),
+				Date:           dateString,
+			},
+			repo: func() repository.AnalyticRepository {
+				m := mocks.NewMockAnalyticRepository(ctrl)
+				m.EXPECT().GetAnalyticByDate(gomock.Any(), int64(0), dateTime).Return(nil, nil)
+				m.EXPECT().CreateAnalytic(gomock.Any(), domain.Analytic{
+					AverageOrderValue:   money.New(90, "IDR"),
+					OrderCompletionRate: 100,
+					NetRevenue:          money.New(90, "IDR"),
+					GrossRevenue:        money.New(100, "IDR"),
+					DiscountRate:        10,
+					Date:                date,
+				}).Return(&domain.Analytic{}, nil)
+				return m
+			},
+		},
+		{
+			name: "reviewed orders",
+			analytic: domain.StatisticEvent{
+				CompletedOrder: 2,
+				TotalOrder:     2,
+				ReviewCount:    4,
+				RatingSum:      14,
+				Ratings:        domain.RatingDistribution{Two: 1, Three: 1, Four: 1, Five: 1},
+				Date:           dateString,
+			},
+			repo: func() repository.AnalyticRepository {
+				m := mocks.NewMockAnalyticRepository(ctrl)
+				m.EXPECT().GetAnalyticByDate(gomock.Any(), int64(0), dateTime).Return(nil, nil)
+				m.EXPECT().CreateAnalytic(gomock.Any(), domain.Analytic{
+					OrderCompletionRate: 100,
+					AverageRating:       3.5,
+					RatingShares:        domain.RatingShares{Two: 25, Three: 25, Four: 25, Five: 25},
+					ReviewRate:          200,
+					Date:                date,
+				}).Return(&domain.Analytic{}, nil)
+				return m
+			},
+		},
+		{
+			name: "funnel",
+			analytic: domain.StatisticEvent{
+				CompletedOrder:   2,
+				TotalOrder:       4,
+				ProductViews:     40,
+				CartAdds:         10,
+				CheckoutsStarted: 5,
+				Date:             dateString,
+			},
+			repo: func() repository.AnalyticRepository {
+				m := mocks.NewMockAnalyticRepository(ctrl)
+				m.EXPECT().GetAnalyticByDate(gomock.Any(), int64(0), dateTime).Return(nil, nil)
+				m.EXPECT().CreateAnalytic(gomock.Any(), domain.Analytic{
+					SalesConvertionRate: 5,
+					ViewToCartRate:      25,
+					CartToOrderRate:     40,
+					CheckoutRate:        80,
+					OrderCompletionRate: 50,
+					Date:                date,
+				}).Return(&domain.Analytic{}, nil)
+				return m
+			},
+		},
+		{
+			name: "returning buyers",
+			analytic: domain.StatisticEvent{
+				UniqueBuyers:    4,
+				NewBuyers:       3,
+				ReturningBuyers: 1,
+				Date:            dateString,
+			},
+			repo: func() repository.AnalyticRepository {
+				m := mocks.NewMockAnalyticRepository(ctrl)
+				m.EXPECT().GetAnalyticByDate(gomock.Any(), int64(0), dateTime).Return(nil, nil)
+				m.EXPECT().CreateAnalytic(gomock.Any(), domain.Analytic{
+					UniqueBuyers:       4,
+					NewBuyers:          3,
+					ReturningBuyers:    1,
+					RepeatPurchaseRate: 25,
+					Date:               date,
+				}).Return(&domain.Analytic{}, nil)
+				return m
+			},
+		},
+		{
+			name: "shipped orders",
+			analytic: domain.StatisticEvent{
+				ShippedOrders:    4,
+				OnTimeShipments:  3,
+				ShippingLeadTime: 4 * 36 * 3600,
+				Date:             dateString,
+			},
+			repo: func() repository.AnalyticRepository {
+				m := mocks.NewMockAnalyticRepository(ctrl)
+				m.EXPECT().GetAnalyticByDate(gomock.Any(), int64(0), dateTime).Return(nil, nil)
+				m.EXPECT().CreateAnalytic(gomock.Any(), domain.Analytic{
+					AverageLeadTimeHours: 36,
+					OnTimeShippingRate:   75,
+					Date:                 date,
+				}).Return(&domain.Analytic{}, nil)
+				return m
+			},
+		},
 		{
 			name: "error update",
 			analytic: domain.StatisticEvent{
-				TotalRevenue:   100,
+				TotalRevenue:   money.New(100, "IDR"),
 				CompletedOrder: 4,
 				CanceledOrder:  1,
 				TotalOrder:     5,
@@ -129,16 +266,18 @@ func Test_analyticUsecase_HandleStatisticEvent(t *testing.T) {
 			},
 			repo: func() repository.AnalyticRepository {
 				m := mocks.NewMockAnalyticRepository(ctrl)
-				m.EXPECT().GetAnalyticByDate(gomock.Any(), dateTime).Return(&domain.Analytic{
-					AverageOrderValue:     25,
+				m.EXPECT().GetAnalyticByDate(gomock.Any(), int64(0), dateTime).Return(&domain.Analytic{
+					AverageOrderValue:     money.New(25, "IDR"),
 					SalesConvertionRate:   100,
 					CancellationOrderRate: 0,
 					Date:                  date,
 				}, nil)
 				m.EXPECT().UpdateAnalytic(gomock.Any(), domain.Analytic{
-					AverageOrderValue:     25,
-					SalesConvertionRate:   80,
+					AverageOrderValue:     money.New(25, "IDR"),
+					OrderCompletionRate:   80,
 					CancellationOrderRate: 20,
+					NetRevenue:            money.New(100, "IDR"),
+					GrossRevenue:          money.New(100, "IDR"),
 					Date:                  date,
 				}).Return(nil, errors.New("mock error"))
 				return m
@@ -147,7 +286,7 @@ func Test_analyticUsecase_HandleStatisticEvent(t *testing.T) {
 		{
 			name: "no record, create new one",
 			analytic: domain.StatisticEvent{
-				TotalRevenue:   100,
+				TotalRevenue:   money.New(100, "IDR"),
 				CompletedOrder: 4,
 				CanceledOrder:  1,
 				TotalOrder:     5,
@@ -155,14 +294,16 @@ func Test_analyticUsecase_HandleStatisticEvent(t *testing.T) {
 			},
 			repo: func() repository.AnalyticRepository {
 				m := mocks.NewMockAnalyticRepository(ctrl)
-				m.EXPECT().GetAnalyticByDate(gomock.Any(), dateTime).Return(nil, nil)
+				m.EXPECT().GetAnalyticByDate(gomock.Any(), int64(0), dateTime).Return(nil, nil)
 				m.EXPECT().CreateAnalytic(gomock.Any(), domain.Analytic{
-					AverageOrderValue:     25,
-					SalesConvertionRate:   80,
+					AverageOrderValue:     money.New(25, "IDR"),
+					OrderCompletionRate:   80,
 					CancellationOrderRate: 20,
+					NetRevenue:            money.New(100, "IDR"),
+					GrossRevenue:          money.New(100, "IDR"),
 					Date:                  date,
 				}).Return(&domain.Analytic{
-					AverageOrderValue:     25,
+					AverageOrderValue:     money.New(25, "IDR"),
 					SalesConvertionRate:   80,
 					CancellationOrderRate: 20,
 					Date:                  date,
@@ -0,0 +1,222 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/repository"
+	buyerdomain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+type BenchmarkUsecase interface {
+	GetBenchmark(ctx context.Context, sellerId, categoryId int64) (*domain.Benchmark, error)
+	HandleOrderEvent(orderEvent domain.OrderEvent)
+}
+
+type benchmarkUsecase struct {
+	benchmarkRepo repository.BenchmarkRepository
+}
+
+func NewBenchmarkUsecase(benchmarkRepo repository.BenchmarkRepository) BenchmarkUsecase {
+	return &benchmarkUsecase{
+		benchmarkRepo: benchmarkRepo,
+	}
+}
+
+// GetBenchmark, compares the seller with the other sellers of the category over the benchmark window, the seller's
+// own category when categoryId is zero
+func (bu *benchmarkUsecase) GetBenchmark(ctx context.Context, sellerId, categoryId int64) (*domain.Benchmark, error) {
+	var err error
+	if categoryId == 0 {
+		categoryId, err = bu.benchmarkRepo.GetSellerCategory(ctx, sellerId)
+		if err != nil {
+			return nil, err
+		}
+		if categoryId == 0 {
+			return nil, domain.ErrSellerCategoryUnknown
+		}
+	}
+
+	sellers, err := bu.benchmarkRepo.GetCategorySellers(ctx, categoryId)
+	if err != nil {
+		return nil, err
+	}
+	peers := []int64{sellerId}
+	for _, s := range sellers {
+		if s != sellerId {
+			peers = append(peers, s)
+		}
+	}
+
+	to := toDay(time.Now()).AddDate(0, 0, 1)
+	from := to.AddDate(0, 0, -domain.BenchmarkWindowDays)
+	sales, err := bu.benchmarkRepo.GetSellerSales(ctx, peers, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildBenchmark(sellerId, categoryId, sales, from, to)
+}
+
+// HandleOrderEvent, counts a new order in the category of the seller
+func (bu *benchmarkUsecase) HandleOrderEvent(orderEvent domain.OrderEvent) {
+	if orderEvent.OrderStatus != buyerdomain.OrderStatusNewInt || orderEvent.CategoryID == 0 {
+		return
+	}
+
+	if err := bu.benchmarkRepo.AddSellerOrder(context.Background(), orderEvent.SellerID, orderEvent.CategoryID); err != nil {
+		log.Println("[HandleOrderEvent] error AddSellerOrder", err)
+	}
+}
+
+// sellerBenchmark, the sales of a seller over the benchmark window, revenue being the one of the currency with the
+// most completed orders revenueOrders
+type sellerBenchmark struct {
+	orders        int64
+	completed     int64
+	canceled      int64
+	revenue       money.Money
+	revenueOrders int64
+}
+
+// buildBenchmark, the benchmark of the seller against the other sellers of sales over the days from up to but
+// excluding to. A metric is only reported with BenchmarkMinPeers peers having it
+func buildBenchmark(sellerId, categoryId int64, sales []domain.SellerSales, from, to time.Time) (*domain.Benchmark, error) {
+	sellers := make(map[int64]*sellerBenchmark)
+	var order []int64
+	for _, s := range sales {
+		seller, ok := sellers[s.SellerID]
+		if !ok {
+			seller = &sellerBenchmark{}
+			sellers[s.SellerID] = seller
+			order = append(order, s.SellerID)
+		}
+		seller.orders += s.Orders
+		seller.completed += s.CompletedOrders
+		seller.canceled += s.CanceledOrders
+		// a seller that changed its reporting currency is compared in the currency most of its orders completed in
+		if s.CompletedOrders > seller.revenueOrders {
+			seller.revenue = s.Revenue
+			seller.revenueOrders = s.CompletedOrders
+		}
+	}
+
+	currency := money.DefaultCurrency
+	if seller, ok := sellers[sellerId]; ok && seller.revenueOrders > 0 {
+		currency = seller.revenue.Currency
+	}
+
+	metrics := []struct {
+		name         string
+		higherBetter bool
+		value        func(s *sellerBenchmark) (float64, bool)
+	}{
+		{
+			name:         domain.BenchmarkMetricAverageOrderValue,
+			higherBetter: true,
+			value: func(s *sellerBenchmark) (float64, bool) {
+				if s.revenueOrders == 0 || s.revenue.Currency != currency {
+					return 0, false
+				}
+				return s.revenue.Major() / float64(s.revenueOrders), true
+			},
+		},
+		{
+			name:         domain.BenchmarkMetricOrderCompletionRate,
+			higherBetter: true,
+			value: func(s *sellerBenchmark) (float64, bool) {
+				if s.orders == 0 {
+					return 0, false
+				}
+				return float64(s.completed) / float64(s.orders) * 100, true
+			},
+		},
+		{
+			name: domain.BenchmarkMetricCancellationRate,
+			value: func(s *sellerBenchmark) (float64, bool) {
+				if s.orders == 0 {
+					return 0, false
+				}
+				return float64(s.canceled) / float64(s.orders) * 100, true
+			},
+		},
+	}
+
+	result := &domain.Benchmark{
+		SellerID:   sellerId,
+		CategoryID: categoryId,
+		From:       from.Format(domain.AnalyticDateFormat),
+		To:         to.AddDate(0, 0, -1).Format(domain.AnalyticDateFormat),
+		Metrics:    []domain.MetricBenchmark{},
+	}
+	for _, metric := range metrics {
+		var peers []float64
+		for _, id := range order {
+			if id == sellerId {
+				continue
+			}
+			if v, ok := metric.value(sellers[id]); ok {
+				peers = append(peers, v)
+			}
+		}
+		if len(peers) < domain.BenchmarkMinPeers {
+			continue
+		}
+		sort.Float64s(peers)
+
+		benchmark := domain.MetricBenchmark{
+			Metric: metric.name,
+			Peers:  len(peers),
+			P25:    roundHundredths(percentile(peers, 0.25)),
+			P50:    roundHundredths(percentile(peers, 0.5)),
+			P75:    roundHundredths(percentile(peers, 0.75)),
+			P90:    roundHundredths(percentile(peers, 0.9)),
+		}
+		if metric.name == domain.BenchmarkMetricAverageOrderValue {
+			benchmark.Currency = currency
+		}
+
+		if seller, ok := sellers[sellerId]; ok {
+			if v, ok := metric.value(seller); ok {
+				var below, ties float64
+				for _, p := range peers {
+					if p < v {
+						below++
+					} else if p == v {
+						ties++
+					}
+				}
+				n := float64(len(peers))
+				value := roundHundredths(v)
+				rank := roundHundredths((below + ties/2) / n * 100)
+				better := roundHundredths(below / n * 100)
+				if !metric.higherBetter {
+					better = roundHundredths((n - below - ties) / n * 100)
+				}
+				benchmark.Value, benchmark.PercentileRank, benchmark.BetterThan = &value, &rank, &better
+			}
+		}
+
+		result.Metrics = append(result.Metrics, benchmark)
+	}
+
+	if len(result.Metrics) == 0 {
+		return nil, domain.ErrNotEnoughPeers
+	}
+	return result, nil
+}
+
+// percentile, the p quantile of the sorted values interpolating between the closest ranks
+func percentile(sorted []float64, p float64) float64 {
+	position := p * float64(len(sorted)-1)
+	lower := int(math.Floor(position))
+	if lower+1 >= len(sorted) {
+		return sorted[len(sorted)-1]
+	}
+	return sorted[lower] + (position-float64(lower))*(sorted[lower+1]-sorted[lower])
+}
@@ -0,0 +1,208 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/repository"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/repository/mocks"
+)
+
+func Test_buildBenchmark(t *testing.T) {
+	from := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 30)
+	float := func(value float64) *float64 { return &value }
+
+	// seller 7 mostly completes orders in USD, leaving it out of the IDR average order value
+	peers := []domain.SellerSales{
+		{SellerID: 2, Revenue: money.New(2500000, "IDR"), Orders: 10, CompletedOrders: 5, CanceledOrders: 5},
+		{SellerID: 3, Revenue: money.New(3600000, "IDR"), Orders: 10, CompletedOrders: 6, CanceledOrders: 2},
+		{SellerID: 4, Revenue: money.New(6400000, "IDR"), Orders: 10, CompletedOrders: 8, CanceledOrders: 1},
+		{SellerID: 5, Revenue: money.New(10800000, "IDR"), Orders: 10, CompletedOrders: 9},
+		{SellerID: 6, Revenue: money.New(20000000, "IDR"), Orders: 10, CompletedOrders: 10},
+		{SellerID: 7, Revenue: money.New(100000, "IDR"), Orders: 1, CompletedOrders: 1},
+		{SellerID: 7, Revenue: money.New(40000, "USD"), Orders: 4, CompletedOrders: 4},
+	}
+	seller := domain.SellerSales{SellerID: 1, Revenue: money.New(8000000, "IDR"), Orders: 10, CompletedOrders: 8, CanceledOrders: 1}
+
+	metrics := []domain.MetricBenchmark{
+		{
+			Metric: domain.BenchmarkMetricAverageOrderValue, Currency: "IDR", Peers: 5,
+			P25: 6000, P50: 8000, P75: 12000, P90: 16800,
+			Value: float(10000), PercentileRank: float(60), BetterThan: float(60),
+		},
+		{
+			Metric: domain.BenchmarkMetricOrderCompletionRate, Peers: 6,
+			P25: 65, P50: 85, P75: 97.5, P90: 100,
+			Value: float(80), PercentileRank: float(41.67), BetterThan: float(33.33),
+		},
+		{
+			Metric: domain.BenchmarkMetricCancellationRate, Peers: 6,
+			P25: 0, P50: 5, P75: 17.5, P90: 35,
+			Value: float(10), PercentileRank: float(58.33), BetterThan: float(33.33),
+		},
+	}
+
+	tests := []struct {
+		name    string
+		sales   []domain.SellerSales
+		want    *domain.Benchmark
+		wantErr error
+	}{
+		{
+			name:  "seller among peers",
+			sales: append([]domain.SellerSales{seller}, peers...),
+			want: &domain.Benchmark{
+				SellerID:   1,
+				CategoryID: 9,
+				From:       "2022-01-01",
+				To:         "2022-01-30",
+				Metrics:    metrics,
+			},
+		},
+		{
+			name:  "seller without sales",
+			sales: peers,
+			want: &domain.Benchmark{
+				SellerID:   1,
+				CategoryID: 9,
+				From:       "2022-01-01",
+				To:         "2022-01-30",
+				Metrics: func() []domain.MetricBenchmark {
+					var result []domain.MetricBenchmark
+					for _, m := range metrics {
+						m.Value, m.PercentileRank, m.BetterThan = nil, nil, nil
+						result = append(result, m)
+					}
+					return result
+				}(),
+			},
+		},
+		{
+			name:    "not enough peers",
+			sales:   append([]domain.SellerSales{seller}, peers[:4]...),
+			wantErr: domain.ErrNotEnoughPeers,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildBenchmark(1, 9, tt.sales, from, to)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("buildBenchmark() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("buildBenchmark() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_benchmarkUsecase_GetBenchmark(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name       string
+		categoryId int64
+		wantErr    error
+		repo       func() repository.BenchmarkRepository
+	}{
+		{
+			name: "seller category",
+			repo: func() repository.BenchmarkRepository {
+				m := mocks.NewMockBenchmarkRepository(ctrl)
+				m.EXPECT().GetSellerCategory(gomock.Any(), int64(1)).Return(int64(9), nil)
+				m.EXPECT().GetCategorySellers(gomock.Any(), int64(9)).Return([]int64{1, 2, 3, 4, 5, 6}, nil)
+				m.EXPECT().GetSellerSales(gomock.Any(), []int64{1, 2, 3, 4, 5, 6}, gomock.Any(), gomock.Any()).Return([]domain.SellerSales{
+					{SellerID: 2, Orders: 1}, {SellerID: 3, Orders: 1}, {SellerID: 4, Orders: 1}, {SellerID: 5, Orders: 1}, {SellerID: 6, Orders: 1},
+				}, nil)
+				return m
+			},
+		},
+		{
+			name:       "other category",
+			categoryId: 8,
+			wantErr:    domain.ErrNotEnoughPeers,
+			repo: func() repository.BenchmarkRepository {
+				m := mocks.NewMockBenchmarkRepository(ctrl)
+				m.EXPECT().GetCategorySellers(gomock.Any(), int64(8)).Return([]int64{2}, nil)
+				m.EXPECT().GetSellerSales(gomock.Any(), []int64{1, 2}, gomock.Any(), gomock.Any()).Return(nil, nil)
+				return m
+			},
+		},
+		{
+			name:    "unknown category",
+			wantErr: domain.ErrSellerCategoryUnknown,
+			repo: func() repository.BenchmarkRepository {
+				m := mocks.NewMockBenchmarkRepository(ctrl)
+				m.EXPECT().GetSellerCategory(gomock.Any(), int64(1)).Return(int64(0), nil)
+				return m
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bu := NewBenchmarkUsecase(tt.repo())
+			if _, err := bu.GetBenchmark(context.TODO(), 1, tt.categoryId); !errors.Is(err, tt.wantErr) {
+				t.Errorf("benchmarkUsecase.GetBenchmark() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_benchmarkUsecase_HandleOrderEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name  string
+		event domain.OrderEvent
+		repo  func() repository.BenchmarkRepository
+	}{
+		{
+			name:  "new order",
+			event: domain.OrderEvent{OrderID: 7, SellerID: 2, OrderStatus: 0, CategoryID: 9},
+			repo: func() repository.BenchmarkRepository {
+				m := mocks.NewMockBenchmarkRepository(ctrl)
+				m.EXPECT().AddSellerOrder(gomock.Any(), int64(2), int64(9)).Return(nil)
+				return m
+			},
+		},
+		{
+			name:  "error add seller order",
+			event: domain.OrderEvent{OrderID: 7, SellerID: 2, OrderStatus: 0, CategoryID: 9},
+			repo: func() repository.BenchmarkRepository {
+				m := mocks.NewMockBenchmarkRepository(ctrl)
+				m.EXPECT().AddSellerOrder(gomock.Any(), int64(2), int64(9)).Return(errors.New("mock error"))
+				return m
+			},
+		},
+		{
+			name:  "completed order",
+			event: domain.OrderEvent{OrderID: 7, SellerID: 2, OrderStatus: 1, CategoryID: 9},
+			repo: func() repository.BenchmarkRepository {
+				return mocks.NewMockBenchmarkRepository(ctrl)
+			},
+		},
+		{
+			name:  "order without category",
+			event: domain.OrderEvent{OrderID: 7, SellerID: 2, OrderStatus: 0},
+			repo: func() repository.BenchmarkRepository {
+				return mocks.NewMockBenchmarkRepository(ctrl)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bu := NewBenchmarkUsecase(tt.repo())
+			bu.HandleOrderEvent(tt.event)
+		})
+	}
+}
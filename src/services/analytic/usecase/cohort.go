@@ -0,0 +1,202 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/repository"
+	buyerdomain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"gorm.io/datatypes"
+)
+
+type CohortUsecase interface {
+	GetCohorts(ctx context.Context, sellerId int64, from time.Time, weeks int) (*domain.CohortMatrix, error)
+	HandleOrderEvent(orderEvent domain.OrderEvent)
+}
+
+type cohortUsecase struct {
+	cohortRepo repository.CohortRepository
+}
+
+func NewCohortUsecase(cohortRepo repository.CohortRepository) CohortUsecase {
+	return &cohortUsecase{
+		cohortRepo: cohortRepo,
+	}
+}
+
+// GetCohorts, the cohorts of the weeks weeks starting with the week of from, each followed up to the current week
+func (cu *cohortUsecase) GetCohorts(ctx context.Context, sellerId int64, from time.Time, weeks int) (*domain.CohortMatrix, error) {
+	from = domain.CohortWeek(from)
+	to := from.AddDate(0, 0, 7*weeks)
+	if end := domain.CohortWeek(time.Now()).AddDate(0, 0, 7); end.Before(to) {
+		to = end
+	}
+
+	sizes, err := cu.cohortRepo.GetCohortSizes(ctx, sellerId, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	activity, err := cu.cohortRepo.GetCohortActivity(ctx, sellerId, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	revenue, err := cu.cohortRepo.GetCohortRevenue(ctx, sellerId, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildCohortMatrix(sellerId, to, sizes, activity, revenue), nil
+}
+
+// HandleOrderEvent, puts the buyer of a new order in the cohort of its week and records the order's revenue once
+// completed or refunded for the seller and the marketplace, a cancelled order is voided. The recorded orders also
+// make up the buyers' RFM scores
+func (cu *cohortUsecase) HandleOrderEvent(orderEvent domain.OrderEvent) {
+	ctx := context.Background()
+
+	// orders published before events carried the buyer can't be put in a cohort
+	if orderEvent.BuyerID == 0 {
+		return
+	}
+
+	date, err := time.Parse(domain.AnalyticDateFormat, orderEvent.OrderDate)
+	if err != nil {
+		log.Println("[HandleOrderEvent] error parsing date", err)
+		return
+	}
+	week := datatypes.Date(domain.CohortWeek(date))
+
+	var revenue money.Money
+	switch orderEvent.OrderStatus {
+	case buyerdomain.OrderStatusNewInt:
+		revenue = money.New(0, orderEvent.TotalRevenue.Currency)
+	case buyerdomain.OrderStatusCompletedInt:
+		revenue = orderEvent.TotalRevenue
+	case buyerdomain.OrderStatusRefundedInt:
+		revenue = money.New(-orderEvent.RefundedAmount.Amount, orderEvent.RefundedAmount.Currency)
+	case buyerdomain.OrderStatusCancelledInt:
+		for _, sellerId := range cohortSellers(orderEvent.SellerID) {
+			if err := cu.cohortRepo.VoidOrder(ctx, domain.CohortOrder{
+				SellerID: sellerId,
+				OrderID:  orderEvent.OrderID,
+				BuyerID:  orderEvent.BuyerID,
+				Week:     week,
+				Date:     datatypes.Date(date),
+				Revenue:  money.New(0, orderEvent.TotalRevenue.Currency),
+			}); err != nil {
+				log.Println("[HandleOrderEvent] error VoidOrder", err)
+				return
+			}
+		}
+		return
+	default:
+		return
+	}
+
+	for _, sellerId := range cohortSellers(orderEvent.SellerID) {
+		if orderEvent.OrderStatus == buyerdomain.OrderStatusNewInt {
+			if err := cu.cohortRepo.AddBuyer(ctx, domain.CohortBuyer{
+				SellerID: sellerId,
+				BuyerID:  orderEvent.BuyerID,
+				Week:     week,
+			}); err != nil {
+				log.Println("[HandleOrderEvent] error AddBuyer", err)
+				return
+			}
+		}
+
+		if err := cu.cohortRepo.AddOrder(ctx, domain.CohortOrder{
+			SellerID: sellerId,
+			OrderID:  orderEvent.OrderID,
+			BuyerID:  orderEvent.BuyerID,
+			Week:     week,
+			Date:     datatypes.Date(date),
+			Revenue:  revenue,
+		}); err != nil {
+			log.Println("[HandleOrderEvent] error AddOrder", err)
+			return
+		}
+	}
+}
+
+// cohortSellers, the seller of an order and the marketplace whose cohorts the order counts towards
+func cohortSellers(sellerId int64) []int64 {
+	if sellerId == domain.MarketplaceSellerID {
+		return []int64{sellerId}
+	}
+	return []int64{sellerId, domain.MarketplaceSellerID}
+}
+
+// buildCohortMatrix, lays the cohorts out week by week from their first order week up to but excluding to
+func buildCohortMatrix(sellerId int64, to time.Time, sizes []domain.CohortSize, activity []domain.CohortActivity, revenue []domain.CohortRevenue) *domain.CohortMatrix {
+	active := make(map[string]int64, len(activity))
+	for _, a := range activity {
+		active[cohortKey(a.CohortWeek, a.Week)] = a.Buyers
+	}
+	earned := make(map[string][]money.Money, len(revenue))
+	for _, r := range revenue {
+		key := cohortKey(r.CohortWeek, r.Week)
+		earned[key] = addRevenue(earned[key], r.Revenue)
+	}
+
+	result := &domain.CohortMatrix{
+		SellerID: sellerId,
+		Cohorts:  []domain.Cohort{},
+	}
+	for _, size := range sizes {
+		cohort := domain.Cohort{
+			Week:    size.Week.Format(domain.AnalyticDateFormat),
+			Buyers:  size.Buyers,
+			Periods: []domain.CohortPeriod{},
+		}
+
+		var cumulative []money.Money
+		for offset, week := 0, size.Week; week.Before(to); offset, week = offset+1, week.AddDate(0, 0, 7) {
+			key := cohortKey(size.Week, week)
+			period := domain.CohortPeriod{
+				Offset:            offset,
+				ActiveBuyers:      active[key],
+				Revenue:           []money.Money{},
+				CumulativeRevenue: []money.Money{},
+				LifetimeValue:     []money.Money{},
+			}
+			if size.Buyers != 0 {
+				period.RetentionRate = float32(period.ActiveBuyers) / float32(size.Buyers) * 100
+			}
+			for _, r := range earned[key] {
+				period.Revenue = append(period.Revenue, r)
+				cumulative = addRevenue(cumulative, r)
+			}
+			for _, c := range cumulative {
+				period.CumulativeRevenue = append(period.CumulativeRevenue, c)
+				period.LifetimeValue = append(period.LifetimeValue, c.Div(size.Buyers))
+			}
+			cohort.Periods = append(cohort.Periods, period)
+		}
+		result.Cohorts = append(result.Cohorts, cohort)
+	}
+	return result
+}
+
+// cohortKey, identifies the week of a cohort
+func cohortKey(cohortWeek, week time.Time) string {
+	return cohortWeek.Format(domain.AnalyticDateFormat) + "/" + week.Format(domain.AnalyticDateFormat)
+}
+
+// addRevenue, adds amount to the revenue of its currency, revenues is left untouched
+func addRevenue(revenues []money.Money, amount money.Money) []money.Money {
+	result := make([]money.Money, len(revenues), len(revenues)+1)
+	copy(result, revenues)
+	for i := range result {
+		if result[i].Currency == amount.Currency {
+			result[i] = money.New(result[i].Amount+amount.Amount, amount.Currency)
+			return result
+		}
+	}
+	return append(result, amount)
+}
@@ -0,0 +1,306 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/repository"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/repository/mocks"
+	"gorm.io/datatypes"
+)
+
+func Test_cohortUsecase_HandleOrderEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// 2022-01-05 is a wednesday, its cohort week starts on monday 2022-01-03
+	week := datatypes.Date(time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC))
+	date := datatypes.Date(time.Date(2022, 1, 5, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name  string
+		event domain.OrderEvent
+		repo  func() repository.CohortRepository
+	}{
+		{
+			name: "new order",
+			event: domain.OrderEvent{
+				OrderID:      7,
+				SellerID:     2,
+				BuyerID:      3,
+				OrderStatus:  0,
+				TotalRevenue: money.New(100, "IDR"),
+				OrderDate:    "2022-01-05",
+			},
+			repo: func() repository.CohortRepository {
+				m := mocks.NewMockCohortRepository(ctrl)
+				for _, sellerId := range []int64{2, domain.MarketplaceSellerID} {
+					m.EXPECT().AddBuyer(gomock.Any(), domain.CohortBuyer{SellerID: sellerId, BuyerID: 3, Week: week}).Return(nil)
+					m.EXPECT().AddOrder(gomock.Any(), domain.CohortOrder{SellerID: sellerId, OrderID: 7, BuyerID: 3, Week: week, Date: date, Revenue: money.New(0, "IDR")}).Return(nil)
+				}
+				return m
+			},
+		},
+		{
+			name: "completed order",
+			event: domain.OrderEvent{
+				OrderID:      7,
+				SellerID:     2,
+				BuyerID:      3,
+				OrderStatus:  1,
+				TotalRevenue: money.New(100, "IDR"),
+				OrderDate:    "2022-01-05",
+			},
+			repo: func() repository.CohortRepository {
+				m := mocks.NewMockCohortRepository(ctrl)
+				for _, sellerId := range []int64{2, domain.MarketplaceSellerID} {
+					m.EXPECT().AddOrder(gomock.Any(), domain.CohortOrder{SellerID: sellerId, OrderID: 7, BuyerID: 3, Week: week, Date: date, Revenue: money.New(100, "IDR")}).Return(nil)
+				}
+				return m
+			},
+		},
+		{
+			name: "refunded order",
+			event: domain.OrderEvent{
+				OrderID:        7,
+				SellerID:       2,
+				BuyerID:        3,
+				OrderStatus:    3,
+				TotalRevenue:   money.New(100, "IDR"),
+				RefundedAmount: money.New(40, "IDR"),
+				OrderDate:      "2022-01-05",
+			},
+			repo: func() repository.CohortRepository {
+				m := mocks.NewMockCohortRepository(ctrl)
+				for _, sellerId := range []int64{2, domain.MarketplaceSellerID} {
+					m.EXPECT().AddOrder(gomock.Any(), domain.CohortOrder{SellerID: sellerId, OrderID: 7, BuyerID: 3, Week: week, Date: date, Revenue: money.New(-40, "IDR")}).Return(nil)
+				}
+				return m
+			},
+		},
+		{
+			name: "cancelled order",
+			event: domain.OrderEvent{
+				OrderID:      7,
+				SellerID:     2,
+				BuyerID:      3,
+				OrderStatus:  2,
+				TotalRevenue: money.New(100, "IDR"),
+				OrderDate:    "2022-01-05",
+			},
+			repo: func() repository.CohortRepository {
+				m := mocks.NewMockCohortRepository(ctrl)
+				for _, sellerId := range []int64{2, domain.MarketplaceSellerID} {
+					m.EXPECT().VoidOrder(gomock.Any(), domain.CohortOrder{SellerID: sellerId, OrderID: 7, BuyerID: 3, Week: week, Date: date, Revenue: money.New(0, "IDR")}).Return(nil)
+				}
+				return m
+			},
+		},
+		{
+			name: "error void order",
+			event: domain.OrderEvent{
+				OrderID:      7,
+				SellerID:     2,
+				BuyerID:      3,
+				OrderStatus:  2,
+				TotalRevenue: money.New(100, "IDR"),
+				OrderDate:    "2022-01-05",
+			},
+			repo: func() repository.CohortRepository {
+				m := mocks.NewMockCohortRepository(ctrl)
+				m.EXPECT().VoidOrder(gomock.Any(), gomock.Any()).Return(errors.New("mock error"))
+				return m
+			},
+		},
+		{
+			name: "no buyer",
+			event: domain.OrderEvent{
+				OrderID:   7,
+				SellerID:  2,
+				OrderDate: "2022-01-05",
+			},
+			repo: func() repository.CohortRepository {
+				return mocks.NewMockCohortRepository(ctrl)
+			},
+		},
+		{
+			name: "invalid date",
+			event: domain.OrderEvent{
+				OrderID:   7,
+				SellerID:  2,
+				BuyerID:   3,
+				OrderDate: "2022,01-05",
+			},
+			repo: func() repository.CohortRepository {
+				return mocks.NewMockCohortRepository(ctrl)
+			},
+		},
+		{
+			name: "error add buyer",
+			event: domain.OrderEvent{
+				OrderID:      7,
+				SellerID:     2,
+				BuyerID:      3,
+				TotalRevenue: money.New(100, "IDR"),
+				OrderDate:    "2022-01-05",
+			},
+			repo: func() repository.CohortRepository {
+				m := mocks.NewMockCohortRepository(ctrl)
+				m.EXPECT().AddBuyer(gomock.Any(), gomock.Any()).Return(errors.New("mock error"))
+				return m
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cu := NewCohortUsecase(tt.repo())
+			cu.HandleOrderEvent(tt.event)
+		})
+	}
+}
+
+func Test_cohortUsecase_GetCohorts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// 2022-01-05 is a wednesday, its cohort week starts on monday 2022-01-03
+	from := time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 14)
+
+	tests := []struct {
+		name    string
+		want    *domain.CohortMatrix
+		wantErr bool
+		repo    func() repository.CohortRepository
+	}{
+		{
+			name: "sukses",
+			want: &domain.CohortMatrix{
+				SellerID: 2,
+				Cohorts: []domain.Cohort{
+					{
+						Week:   "2022-01-03",
+						Buyers: 1,
+						Periods: []domain.CohortPeriod{
+							{
+								Offset:            0,
+								ActiveBuyers:      1,
+								RetentionRate:     100,
+								Revenue:           []money.Money{money.New(100, "IDR")},
+								CumulativeRevenue: []money.Money{money.New(100, "IDR")},
+								LifetimeValue:     []money.Money{money.New(100, "IDR")},
+							},
+							{
+								Offset:            1,
+								Revenue:           []money.Money{},
+								CumulativeRevenue: []money.Money{money.New(100, "IDR")},
+								LifetimeValue:     []money.Money{money.New(100, "IDR")},
+							},
+						},
+					},
+				},
+			},
+			repo: func() repository.CohortRepository {
+				m := mocks.NewMockCohortRepository(ctrl)
+				m.EXPECT().GetCohortSizes(gomock.Any(), int64(2), from, to).Return([]domain.CohortSize{{Week: from, Buyers: 1}}, nil)
+				m.EXPECT().GetCohortActivity(gomock.Any(), int64(2), from, to).Return([]domain.CohortActivity{{CohortWeek: from, Week: from, Buyers: 1}}, nil)
+				m.EXPECT().GetCohortRevenue(gomock.Any(), int64(2), from, to).Return([]domain.CohortRevenue{{CohortWeek: from, Week: from, Revenue: money.New(100, "IDR")}}, nil)
+				return m
+			},
+		},
+		{
+			name:    "error",
+			wantErr: true,
+			repo: func() repository.CohortRepository {
+				m := mocks.NewMockCohortRepository(ctrl)
+				m.EXPECT().GetCohortSizes(gomock.Any(), int64(2), from, to).Return(nil, errors.New("mock error"))
+				return m
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cu := NewCohortUsecase(tt.repo())
+			got, err := cu.GetCohorts(context.TODO(), 2, from.AddDate(0, 0, 2), 2)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("cohortUsecase.GetCohorts() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("cohortUsecase.GetCohorts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_buildCohortMatrix(t *testing.T) {
+	first := time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC)
+	second := first.AddDate(0, 0, 7)
+	third := first.AddDate(0, 0, 14)
+
+	got := buildCohortMatrix(0, third,
+		[]domain.CohortSize{{Week: first, Buyers: 4}, {Week: second, Buyers: 2}},
+		[]domain.CohortActivity{
+			{CohortWeek: first, Week: first, Buyers: 4},
+			{CohortWeek: first, Week: second, Buyers: 1},
+			{CohortWeek: second, Week: second, Buyers: 2},
+		},
+		[]domain.CohortRevenue{
+			{CohortWeek: first, Week: first, Revenue: money.New(400, "IDR")},
+			{CohortWeek: first, Week: second, Revenue: money.New(100, "IDR")},
+			{CohortWeek: first, Week: second, Revenue: money.New(8, "USD")},
+			{CohortWeek: second, Week: second, Revenue: money.New(300, "IDR")},
+		},
+	)
+
+	want := &domain.CohortMatrix{
+		SellerID: 0,
+		Cohorts: []domain.Cohort{
+			{
+				Week:   "2022-01-03",
+				Buyers: 4,
+				Periods: []domain.CohortPeriod{
+					{
+						Offset:            0,
+						ActiveBuyers:      4,
+						RetentionRate:     100,
+						Revenue:           []money.Money{money.New(400, "IDR")},
+						CumulativeRevenue: []money.Money{money.New(400, "IDR")},
+						LifetimeValue:     []money.Money{money.New(100, "IDR")},
+					},
+					{
+						Offset:            1,
+						ActiveBuyers:      1,
+						RetentionRate:     25,
+						Revenue:           []money.Money{money.New(100, "IDR"), money.New(8, "USD")},
+						CumulativeRevenue: []money.Money{money.New(500, "IDR"), money.New(8, "USD")},
+						LifetimeValue:     []money.Money{money.New(125, "IDR"), money.New(2, "USD")},
+					},
+				},
+			},
+			{
+				Week:   "2022-01-10",
+				Buyers: 2,
+				Periods: []domain.CohortPeriod{
+					{
+						Offset:            0,
+						ActiveBuyers:      2,
+						RetentionRate:     100,
+						Revenue:           []money.Money{money.New(300, "IDR")},
+						CumulativeRevenue: []money.Money{money.New(300, "IDR")},
+						LifetimeValue:     []money.Money{money.New(150, "IDR")},
+					},
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("buildCohortMatrix() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,258 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"math"
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/repository"
+	"gorm.io/datatypes"
+)
+
+// forecastSeason, days of the weekly season forecasts follow
+const forecastSeason = 7
+
+// forecastZ, standard normal quantile of the 95% confidence band
+const forecastZ = 1.96
+
+// forecastSmoothing, smoothing factors tried when fitting Holt-Winters
+var forecastSmoothing = []float64{0.1, 0.3, 0.5, 0.7, 0.9}
+
+type ForecastUsecase interface {
+	GetForecast(ctx context.Context, sellerId int64, days int) (*domain.Forecast, error)
+	HandleStatisticEvent(statisticEvent domain.StatisticEvent)
+}
+
+type forecastUsecase struct {
+	forecastRepo repository.ForecastRepository
+}
+
+func NewForecastUsecase(forecastRepo repository.ForecastRepository) ForecastUsecase {
+	return &forecastUsecase{
+		forecastRepo: forecastRepo,
+	}
+}
+
+// GetForecast, forecasts the seller's revenue and orders of days days starting today, fitted on the days before
+func (fu *forecastUsecase) GetForecast(ctx context.Context, sellerId int64, days int) (*domain.Forecast, error) {
+	today := toDay(time.Now())
+	from := today.AddDate(0, 0, -domain.ForecastHistoryDays)
+
+	history, err := fu.forecastRepo.GetDailySales(ctx, sellerId, from, today)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildForecast(sellerId, history, from, today, days), nil
+}
+
+// HandleStatisticEvent, records the day's revenue and orders of the seller as history for its forecasts, goals and
+// benchmarks
+func (fu *forecastUsecase) HandleStatisticEvent(statisticEvent domain.StatisticEvent) {
+	ctx := context.Background()
+
+	date, err := time.Parse(domain.AnalyticDateFormat, statisticEvent.Date)
+	if err != nil {
+		log.Println("[HandleStatisticEvent] error parsing date", err)
+		return
+	}
+
+	if err := fu.forecastRepo.SaveDailySales(ctx, domain.DailySales{
+		SellerID:        statisticEvent.SellerID,
+		Date:            datatypes.Date(date),
+		Revenue:         statisticEvent.TotalRevenue,
+		Orders:          statisticEvent.TotalOrder,
+		CompletedOrders: statisticEvent.CompletedOrder,
+		CanceledOrders:  statisticEvent.CanceledOrder,
+	}); err != nil {
+		log.Println("[HandleStatisticEvent] error SaveDailySales", err)
+	}
+}
+
+// buildForecast, forecasts days days starting on today from the daily sales of the days from up to but excluding
+// today, days without sales count as days without revenue and orders. Revenue is forecast in the currency of the
+// latest day with revenue, the seller's reporting currency, from the days reported in it only
+func buildForecast(sellerId int64, history []domain.DailySales, from, today time.Time, days int) *domain.Forecast {
+	n := int(today.Sub(from) / (24 * time.Hour))
+	revenue := make([]float64, n)
+	orders := make([]float64, n)
+	currencies := make([]string, n)
+	currency := money.DefaultCurrency
+	latest := -1
+	for _, h := range history {
+		i := int(toDay(time.Time(h.Date)).Sub(from) / (24 * time.Hour))
+		if i < 0 || i >= n {
+			continue
+		}
+		revenue[i] = float64(h.Revenue.Amount)
+		orders[i] = float64(h.Orders)
+		currencies[i] = h.Revenue.Currency
+		if h.Revenue.Currency != "" && i > latest {
+			currency = h.Revenue.Currency
+			latest = i
+		}
+	}
+
+	// the history starts with the seller's first day of sales, earlier days are before the seller sold anything
+	first := 0
+	for first < n && revenue[first] == 0 && orders[first] == 0 {
+		first++
+	}
+	// amounts of another currency can't be added up with the ones of the reporting currency, a seller that changed
+	// its reporting currency is forecast from the days since the change
+	for i := n - 1; i >= first; i-- {
+		if currencies[i] != "" && currencies[i] != currency {
+			first = i + 1
+			break
+		}
+	}
+	weekday := int(from.AddDate(0, 0, first).Weekday())
+
+	result := &domain.Forecast{
+		SellerID: sellerId,
+		Model:    domain.ForecastModelWeekdayAverage,
+		Days:     make([]domain.ForecastDay, 0, days),
+	}
+	fit := fitWeekdayAverage
+	if n-first >= 2*forecastSeason {
+		result.Model = domain.ForecastModelHoltWinters
+		fit = fitHoltWinters
+	}
+	revenueForecast := fit(revenue[first:], weekday, days)
+	ordersForecast := fit(orders[first:], weekday, days)
+
+	for k := 0; k < days; k++ {
+		result.Days = append(result.Days, domain.ForecastDay{
+			Date:         today.AddDate(0, 0, k).Format(domain.AnalyticDateFormat),
+			Revenue:      money.New(int64(math.Round(revenueForecast[k].value)), currency),
+			RevenueLower: money.New(int64(math.Round(revenueForecast[k].lower)), currency),
+			RevenueUpper: money.New(int64(math.Round(revenueForecast[k].upper)), currency),
+			Orders:       roundHundredths(ordersForecast[k].value),
+			OrdersLower:  roundHundredths(ordersForecast[k].lower),
+			OrdersUpper:  roundHundredths(ordersForecast[k].upper),
+		})
+	}
+	return result
+}
+
+// prediction, a forecast value and its confidence band
+type prediction struct {
+	value float64
+	lower float64
+	upper float64
+}
+
+// newPrediction, a prediction of value within spread either way, sales can't be negative
+func newPrediction(value, spread float64) prediction {
+	return prediction{
+		value: math.Max(value, 0),
+		lower: math.Max(value-spread, 0),
+		upper: math.Max(value+spread, 0),
+	}
+}
+
+// fitHoltWinters, fits additive Holt-Winters with a weekly season on series choosing the smoothing factors with the
+// smallest squared one step ahead error and forecasts the days after it, the band widens with the horizon
+func fitHoltWinters(series []float64, _ int, days int) []prediction {
+	best, bestSSE := []float64(nil), math.Inf(1)
+	var sigma float64
+	for _, alpha := range forecastSmoothing {
+		for _, beta := range forecastSmoothing {
+			for _, gamma := range forecastSmoothing {
+				forecast, sse := holtWinters(series, alpha, beta, gamma, days)
+				if sse < bestSSE {
+					best, bestSSE = forecast, sse
+					sigma = math.Sqrt(sse / float64(len(series)-forecastSeason))
+				}
+			}
+		}
+	}
+
+	result := make([]prediction, days)
+	for k := range result {
+		result[k] = newPrediction(best[k], forecastZ*sigma*math.Sqrt(float64(k+1)))
+	}
+	return result
+}
+
+// holtWinters, smooths series with the given factors initialised on its first two seasons, returns the forecast of
+// the days after it and the squared one step ahead error
+func holtWinters(series []float64, alpha, beta, gamma float64, days int) ([]float64, float64) {
+	m := forecastSeason
+	first, second := mean(series[:m]), mean(series[m:2*m])
+	level, trend := first, (second-first)/float64(m)
+	season := make([]float64, len(series))
+	for i := 0; i < m; i++ {
+		season[i] = series[i] - first
+	}
+
+	var sse float64
+	for t := m; t < len(series); t++ {
+		err := series[t] - (level + trend + season[t-m])
+		sse += err * err
+
+		prevLevel := level
+		level = alpha*(series[t]-season[t-m]) + (1-alpha)*(level+trend)
+		trend = beta*(level-prevLevel) + (1-beta)*trend
+		season[t] = gamma*(series[t]-level) + (1-gamma)*season[t-m]
+	}
+
+	forecast := make([]float64, days)
+	for k := range forecast {
+		forecast[k] = level + float64(k+1)*trend + season[len(series)-m+k%m]
+	}
+	return forecast, sse
+}
+
+// fitWeekdayAverage, forecasts every day as the average of the same weekday in series, or of every day when the
+// weekday is missing, weekday being the weekday of the first day of series
+func fitWeekdayAverage(series []float64, weekday int, days int) []prediction {
+	var sums, counts [forecastSeason]float64
+	for t, v := range series {
+		d := (weekday + t) % forecastSeason
+		sums[d] += v
+		counts[d]++
+	}
+	average := func(d int) float64 {
+		if counts[d] == 0 {
+			return mean(series)
+		}
+		return sums[d] / counts[d]
+	}
+
+	var sse float64
+	for t, v := range series {
+		err := v - average((weekday+t)%forecastSeason)
+		sse += err * err
+	}
+	var sigma float64
+	if len(series) > 1 {
+		sigma = math.Sqrt(sse / float64(len(series)-1))
+	}
+
+	result := make([]prediction, days)
+	for k := range result {
+		result[k] = newPrediction(average((weekday+len(series)+k)%forecastSeason), forecastZ*sigma)
+	}
+	return result
+}
+
+// mean, average of values, zero without values
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+// roundHundredths, rounds value to two decimals
+func roundHundredths(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,242 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/repository"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/repository/mocks"
+	"gorm.io/datatypes"
+)
+
+func Test_holtWinters(t *testing.T) {
+	week := []float64{100, 200, 300, 400, 500, 600, 700}
+	var series []float64
+	for i := 0; i < 4; i++ {
+		series = append(series, week...)
+	}
+
+	// a season repeating exactly is forecast exactly whatever the smoothing
+	forecast, sse := holtWinters(series, 0.5, 0.3, 0.1, 9)
+	want := []float64{100, 200, 300, 400, 500, 600, 700, 100, 200}
+	if !reflect.DeepEqual(forecast, want) || sse != 0 {
+		t.Errorf("holtWinters() = %v, %v, want %v, 0", forecast, sse, want)
+	}
+}
+
+func Test_buildForecast(t *testing.T) {
+	// 2022-01-03 is a monday
+	from := time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC)
+	today := from.AddDate(0, 0, domain.ForecastHistoryDays)
+
+	tests := []struct {
+		name    string
+		history []domain.DailySales
+		days    int
+		want    *domain.Forecast
+	}{
+		{
+			name: "holt winters",
+			history: func() []domain.DailySales {
+				var history []domain.DailySales
+				for i := 0; i < domain.ForecastHistoryDays; i++ {
+					history = append(history, domain.DailySales{
+						Date:    datatypes.Date(from.AddDate(0, 0, i)),
+						Revenue: money.New(int64(100*(i%7+1)), "IDR"),
+						Orders:  int64(i%7 + 1),
+					})
+				}
+				return history
+			}(),
+			days: 2,
+			want: &domain.Forecast{
+				SellerID: 2,
+				Model:    domain.ForecastModelHoltWinters,
+				Days: []domain.ForecastDay{
+					{
+						Date:         "2022-03-28",
+						Revenue:      money.New(100, "IDR"),
+						RevenueLower: money.New(100, "IDR"),
+						RevenueUpper: money.New(100, "IDR"),
+						Orders:       1,
+						OrdersLower:  1,
+						OrdersUpper:  1,
+					},
+					{
+						Date:         "2022-03-29",
+						Revenue:      money.New(200, "IDR"),
+						RevenueLower: money.New(200, "IDR"),
+						RevenueUpper: money.New(200, "IDR"),
+						Orders:       2,
+						OrdersLower:  2,
+						OrdersUpper:  2,
+					},
+				},
+			},
+		},
+		{
+			name: "weekday average",
+			history: []domain.DailySales{
+				{Date: datatypes.Date(time.Date(2022, 3, 24, 0, 0, 0, 0, time.UTC)), Revenue: money.New(100, "IDR"), Orders: 1},
+				{Date: datatypes.Date(time.Date(2022, 3, 25, 0, 0, 0, 0, time.UTC)), Revenue: money.New(300, "IDR"), Orders: 3},
+				{Date: datatypes.Date(time.Date(2022, 3, 27, 0, 0, 0, 0, time.UTC)), Revenue: money.New(200, "IDR"), Orders: 2},
+			},
+			days: 4,
+			want: &domain.Forecast{
+				SellerID: 2,
+				Model:    domain.ForecastModelWeekdayAverage,
+				Days: []domain.ForecastDay{
+					{Date: "2022-03-28", Revenue: money.New(150, "IDR"), RevenueLower: money.New(150, "IDR"), RevenueUpper: money.New(150, "IDR"), Orders: 1.5, OrdersLower: 1.5, OrdersUpper: 1.5},
+					{Date: "2022-03-29", Revenue: money.New(150, "IDR"), RevenueLower: money.New(150, "IDR"), RevenueUpper: money.New(150, "IDR"), Orders: 1.5, OrdersLower: 1.5, OrdersUpper: 1.5},
+					{Date: "2022-03-30", Revenue: money.New(150, "IDR"), RevenueLower: money.New(150, "IDR"), RevenueUpper: money.New(150, "IDR"), Orders: 1.5, OrdersLower: 1.5, OrdersUpper: 1.5},
+					{Date: "2022-03-31", Revenue: money.New(100, "IDR"), RevenueLower: money.New(100, "IDR"), RevenueUpper: money.New(100, "IDR"), Orders: 1, OrdersLower: 1, OrdersUpper: 1},
+				},
+			},
+		},
+		{
+			name: "reporting currency changed",
+			history: []domain.DailySales{
+				{Date: datatypes.Date(time.Date(2022, 3, 24, 0, 0, 0, 0, time.UTC)), Revenue: money.New(999900, "USD"), Orders: 5},
+				{Date: datatypes.Date(time.Date(2022, 3, 25, 0, 0, 0, 0, time.UTC)), Revenue: money.New(300, "IDR"), Orders: 3},
+				{Date: datatypes.Date(time.Date(2022, 3, 27, 0, 0, 0, 0, time.UTC)), Revenue: money.New(200, "IDR"), Orders: 2},
+			},
+			days: 1,
+			want: &domain.Forecast{
+				SellerID: 2,
+				Model:    domain.ForecastModelWeekdayAverage,
+				Days: []domain.ForecastDay{
+					{Date: "2022-03-28", Revenue: money.New(167, "IDR"), RevenueLower: money.New(167, "IDR"), RevenueUpper: money.New(167, "IDR"), Orders: 1.67, OrdersLower: 1.67, OrdersUpper: 1.67},
+				},
+			},
+		},
+		{
+			name: "no history",
+			days: 1,
+			want: &domain.Forecast{
+				SellerID: 2,
+				Model:    domain.ForecastModelWeekdayAverage,
+				Days: []domain.ForecastDay{
+					{Date: "2022-03-28", Revenue: money.New(0, "IDR"), RevenueLower: money.New(0, "IDR"), RevenueUpper: money.New(0, "IDR")},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := buildForecast(2, tt.history, from, today, tt.days); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("buildForecast() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_fitWeekdayAverage(t *testing.T) {
+	// two mondays of 100 and 300 spread 100 either way of their average
+	got := fitWeekdayAverage([]float64{100, 0, 0, 0, 0, 0, 0, 300}, 1, 1)
+	if got[0].value != 0 || got[0].lower != 0 || got[0].upper <= 0 {
+		t.Errorf("fitWeekdayAverage() = %v, want a zero tuesday with a positive upper bound", got)
+	}
+}
+
+func Test_forecastUsecase_HandleStatisticEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	date := datatypes.Date(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name  string
+		event domain.StatisticEvent
+		repo  func() repository.ForecastRepository
+	}{
+		{
+			name: "sukses",
+			event: domain.StatisticEvent{
+				SellerID:       2,
+				TotalRevenue:   money.New(100, "IDR"),
+				TotalOrder:     3,
+				CompletedOrder: 2,
+				CanceledOrder:  1,
+				Date:           "2022-01-01",
+			},
+			repo: func() repository.ForecastRepository {
+				m := mocks.NewMockForecastRepository(ctrl)
+				m.EXPECT().SaveDailySales(gomock.Any(), domain.DailySales{
+					SellerID:        2,
+					Date:            date,
+					Revenue:         money.New(100, "IDR"),
+					Orders:          3,
+					CompletedOrders: 2,
+					CanceledOrders:  1,
+				}).Return(nil)
+				return m
+			},
+		},
+		{
+			name: "invalid date",
+			event: domain.StatisticEvent{
+				SellerID: 2,
+				Date:     "2022,01-01",
+			},
+			repo: func() repository.ForecastRepository {
+				return mocks.NewMockForecastRepository(ctrl)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fu := NewForecastUsecase(tt.repo())
+			fu.HandleStatisticEvent(tt.event)
+		})
+	}
+}
+
+func Test_forecastUsecase_GetForecast(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name     string
+		wantDays int
+		wantErr  bool
+		repo     func() repository.ForecastRepository
+	}{
+		{
+			name:     "sukses",
+			wantDays: 3,
+			repo: func() repository.ForecastRepository {
+				m := mocks.NewMockForecastRepository(ctrl)
+				m.EXPECT().GetDailySales(gomock.Any(), int64(2), gomock.Any(), gomock.Any()).Return(nil, nil)
+				return m
+			},
+		},
+		{
+			name:    "error",
+			wantErr: true,
+			repo: func() repository.ForecastRepository {
+				m := mocks.NewMockForecastRepository(ctrl)
+				m.EXPECT().GetDailySales(gomock.Any(), int64(2), gomock.Any(), gomock.Any()).Return(nil, errors.New("mock error"))
+				return m
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fu := NewForecastUsecase(tt.repo())
+			got, err := fu.GetForecast(context.TODO(), 2, 3)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("forecastUsecase.GetForecast() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got.Days) != tt.wantDays {
+				t.Errorf("forecastUsecase.GetForecast() days = %v, want %v", len(got.Days), tt.wantDays)
+			}
+		})
+	}
+}
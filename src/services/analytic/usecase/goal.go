@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/validation"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/repository"
+)
+
+type GoalUsecase interface {
+	GetGoals(ctx context.Context, sellerId int64, period time.Time) (*domain.GoalReport, error)
+	CreateGoal(ctx context.Context, goal domain.Goal) (*domain.Goal, error)
+	UpdateGoal(ctx context.Context, sellerId int64, id uint, target float64) (*domain.Goal, error)
+	DeleteGoal(ctx context.Context, sellerId int64, id uint) error
+}
+
+type goalUsecase struct {
+	goalRepo     repository.GoalRepository
+	forecastRepo repository.ForecastRepository
+}
+
+func NewGoalUsecase(goalRepo repository.GoalRepository, forecastRepo repository.ForecastRepository) GoalUsecase {
+	return &goalUsecase{
+		goalRepo:     goalRepo,
+		forecastRepo: forecastRepo,
+	}
+}
+
+// GetGoals, progress of the seller's goals for the month of period, tracked against the daily sales reported so far
+func (gu *goalUsecase) GetGoals(ctx context.Context, sellerId int64, period time.Time) (*domain.GoalReport, error) {
+	start := time.Date(period.Year(), period.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	goals, err := gu.goalRepo.GetGoals(ctx, sellerId, start.Format(domain.GoalPeriodFormat))
+	if err != nil {
+		return nil, err
+	}
+
+	var sales []domain.DailySales
+	if len(goals) > 0 {
+		sales, err = gu.forecastRepo.GetDailySales(ctx, sellerId, start, start.AddDate(0, 1, 0))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return buildGoalReport(sellerId, start, goals, sales, toDay(time.Now())), nil
+}
+
+// CreateGoal, sets a goal for the seller, a rate can't be targeted over 100 percent
+func (gu *goalUsecase) CreateGoal(ctx context.Context, goal domain.Goal) (*domain.Goal, error) {
+	if err := validateGoalTarget(goal.Metric, goal.Target); err != nil {
+		return nil, err
+	}
+	return gu.goalRepo.CreateGoal(ctx, goal)
+}
+
+// UpdateGoal, changes the target of the goal of the seller, ErrGoalNotFound for the goal of another seller
+func (gu *goalUsecase) UpdateGoal(ctx context.Context, sellerId int64, id uint, target float64) (*domain.Goal, error) {
+	goal, err := gu.goalRepo.GetGoal(ctx, sellerId, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateGoalTarget(goal.Metric, target); err != nil {
+		return nil, err
+	}
+
+	goal.Target = target
+	return gu.goalRepo.UpdateGoal(ctx, *goal)
+}
+
+// DeleteGoal, removes the goal of the seller, ErrGoalNotFound for the goal of another seller
+func (gu *goalUsecase) DeleteGoal(ctx context.Context, sellerId int64, id uint) error {
+	return gu.goalRepo.DeleteGoal(ctx, sellerId, id)
+}
+
+// validateGoalTarget, checks target is a possible value of metric
+func validateGoalTarget(metric string, target float64) error {
+	if target < 0 {
+		return validation.NewError("target", "must not be negative")
+	}
+	if metric == domain.GoalMetricCancellationRate && target > 100 {
+		return validation.NewError("target", "must be at most 100 for a rate")
+	}
+	return nil
+}
+
+// buildGoalReport, progress of the goals of the month starting on start given its daily sales, the days up to and
+// including today have elapsed
+func buildGoalReport(sellerId int64, start time.Time, goals []domain.Goal, sales []domain.DailySales, today time.Time) *domain.GoalReport {
+	periodDays := int(start.AddDate(0, 1, 0).Sub(start) / (24 * time.Hour))
+	elapsedDays := int(today.Sub(start)/(24*time.Hour)) + 1
+	if elapsedDays < 0 {
+		elapsedDays = 0
+	} else if elapsedDays > periodDays {
+		elapsedDays = periodDays
+	}
+
+	// revenue is tracked in the currency of the latest day with revenue, the seller's reporting currency, amounts of
+	// days reported in another currency can't be added up with it
+	currency := money.DefaultCurrency
+	var latest time.Time
+	for _, s := range sales {
+		if s.Revenue.Currency != "" && !time.Time(s.Date).Before(latest) {
+			currency = s.Revenue.Currency
+			latest = time.Time(s.Date)
+		}
+	}
+
+	var revenue float64
+	var orders, canceled int64
+	for _, s := range sales {
+		if s.Revenue.Currency == currency {
+			revenue += s.Revenue.Major()
+		}
+		orders += s.Orders
+		canceled += s.CanceledOrders
+	}
+
+	result := &domain.GoalReport{
+		SellerID:    sellerId,
+		Period:      start.Format(domain.GoalPeriodFormat),
+		ElapsedDays: elapsedDays,
+		PeriodDays:  periodDays,
+		Goals:       make([]domain.GoalProgress, 0, len(goals)),
+	}
+	for _, goal := range goals {
+		progress := domain.GoalProgress{Goal: goal}
+
+		switch goal.Metric {
+		case domain.GoalMetricRevenue:
+			progress.Currency = currency
+			progress.Current = revenue
+		case domain.GoalMetricOrders:
+			progress.Current = float64(orders)
+		case domain.GoalMetricCancellationRate:
+			if orders > 0 {
+				progress.Current = float64(canceled) / float64(orders) * 100
+			}
+		}
+
+		if domain.IsCeilingGoal(goal.Metric) {
+			progress.Projected = progress.Current
+			progress.Attainment = 100
+			if progress.Current > goal.Target {
+				progress.Attainment = goal.Target / progress.Current * 100
+			}
+			progress.OnTrack = progress.Projected <= goal.Target
+		} else {
+			if elapsedDays > 0 {
+				progress.Projected = progress.Current / float64(elapsedDays) * float64(periodDays)
+			}
+			progress.Attainment = 100
+			if goal.Target > 0 {
+				progress.Attainment = progress.Current / goal.Target * 100
+			}
+			progress.OnTrack = progress.Projected >= goal.Target
+		}
+
+		progress.Current = roundHundredths(progress.Current)
+		progress.Projected = roundHundredths(progress.Projected)
+		progress.Attainment = roundHundredths(progress.Attainment)
+		result.Goals = append(result.Goals, progress)
+	}
+	return result
+}
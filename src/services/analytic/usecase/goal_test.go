@@ -0,0 +1,300 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/validation"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/repository"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/repository/mocks"
+	"gorm.io/datatypes"
+)
+
+func Test_buildGoalReport(t *testing.T) {
+	start := time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC)
+	goals := []domain.Goal{
+		{SellerID: 2, Period: "2022-04", Metric: domain.GoalMetricRevenue, Target: 3000},
+		{SellerID: 2, Period: "2022-04", Metric: domain.GoalMetricOrders, Target: 20},
+		{SellerID: 2, Period: "2022-04", Metric: domain.GoalMetricCancellationRate, Target: 5},
+	}
+	sales := []domain.DailySales{
+		{SellerID: 2, Date: datatypes.Date(start), Revenue: money.New(50000, "USD"), Orders: 6, CanceledOrders: 1},
+		{SellerID: 2, Date: datatypes.Date(start.AddDate(0, 0, 9)), Revenue: money.New(50000, "USD"), Orders: 4, CanceledOrders: 0},
+	}
+
+	tests := []struct {
+		name  string
+		sales []domain.DailySales
+		today time.Time
+		want  *domain.GoalReport
+	}{
+		{
+			name:  "month in progress",
+			sales: sales,
+			today: start.AddDate(0, 0, 9),
+			want: &domain.GoalReport{
+				SellerID:    2,
+				Period:      "2022-04",
+				ElapsedDays: 10,
+				PeriodDays:  30,
+				Goals: []domain.GoalProgress{
+					{Goal: goals[0], Currency: "USD", Current: 1000, Projected: 3000, Attainment: 33.33, OnTrack: true},
+					{Goal: goals[1], Current: 10, Projected: 30, Attainment: 50, OnTrack: true},
+					{Goal: goals[2], Current: 10, Projected: 10, Attainment: 50, OnTrack: false},
+				},
+			},
+		},
+		{
+			name:  "month not started",
+			today: start.AddDate(0, 0, -1),
+			want: &domain.GoalReport{
+				SellerID:   2,
+				Period:     "2022-04",
+				PeriodDays: 30,
+				Goals: []domain.GoalProgress{
+					{Goal: goals[0], Currency: "IDR"},
+					{Goal: goals[1]},
+					{Goal: goals[2], Attainment: 100, OnTrack: true},
+				},
+			},
+		},
+		{
+			name:  "month over",
+			sales: sales,
+			today: start.AddDate(0, 2, 0),
+			want: &domain.GoalReport{
+				SellerID:    2,
+				Period:      "2022-04",
+				ElapsedDays: 30,
+				PeriodDays:  30,
+				Goals: []domain.GoalProgress{
+					{Goal: goals[0], Currency: "USD", Current: 1000, Projected: 1000, Attainment: 33.33, OnTrack: false},
+					{Goal: goals[1], Current: 10, Projected: 10, Attainment: 50, OnTrack: false},
+					{Goal: goals[2], Current: 10, Projected: 10, Attainment: 50, OnTrack: false},
+				},
+			},
+		},
+		{
+			name: "reporting currency changed",
+			sales: append([]domain.DailySales{
+				{SellerID: 2, Date: datatypes.Date(start.AddDate(0, 0, 4)), Revenue: money.New(7500000, "IDR"), Orders: 2},
+			}, sales...),
+			today: start.AddDate(0, 0, 9),
+			want: &domain.GoalReport{
+				SellerID:    2,
+				Period:      "2022-04",
+				ElapsedDays: 10,
+				PeriodDays:  30,
+				Goals: []domain.GoalProgress{
+					{Goal: goals[0], Currency: "USD", Current: 1000, Projected: 3000, Attainment: 33.33, OnTrack: true},
+					{Goal: goals[1], Current: 12, Projected: 36, Attainment: 60, OnTrack: true},
+					{Goal: goals[2], Current: 8.33, Projected: 8.33, Attainment: 60, OnTrack: false},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := buildGoalReport(2, start, goals, tt.sales, tt.today); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("buildGoalReport() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_goalUsecase_GetGoals(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	period := time.Date(2022, 4, 15, 0, 0, 0, 0, time.UTC)
+	start := time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		wantGoals    int
+		wantErr      bool
+		goalRepo     func() repository.GoalRepository
+		forecastRepo func() repository.ForecastRepository
+	}{
+		{
+			name:      "sukses",
+			wantGoals: 1,
+			goalRepo: func() repository.GoalRepository {
+				m := mocks.NewMockGoalRepository(ctrl)
+				m.EXPECT().GetGoals(gomock.Any(), int64(2), "2022-04").Return([]domain.Goal{{Metric: domain.GoalMetricOrders, Target: 10}}, nil)
+				return m
+			},
+			forecastRepo: func() repository.ForecastRepository {
+				m := mocks.NewMockForecastRepository(ctrl)
+				m.EXPECT().GetDailySales(gomock.Any(), int64(2), start, start.AddDate(0, 1, 0)).Return(nil, nil)
+				return m
+			},
+		},
+		{
+			name: "no goals",
+			goalRepo: func() repository.GoalRepository {
+				m := mocks.NewMockGoalRepository(ctrl)
+				m.EXPECT().GetGoals(gomock.Any(), int64(2), "2022-04").Return(nil, nil)
+				return m
+			},
+			forecastRepo: func() repository.ForecastRepository {
+				return mocks.NewMockForecastRepository(ctrl)
+			},
+		},
+		{
+			name:    "error get daily sales",
+			wantErr: true,
+			goalRepo: func() repository.GoalRepository {
+				m := mocks.NewMockGoalRepository(ctrl)
+				m.EXPECT().GetGoals(gomock.Any(), int64(2), "2022-04").Return([]domain.Goal{{Metric: domain.GoalMetricOrders}}, nil)
+				return m
+			},
+			forecastRepo: func() repository.ForecastRepository {
+				m := mocks.NewMockForecastRepository(ctrl)
+				m.EXPECT().GetDailySales(gomock.Any(), int64(2), gomock.Any(), gomock.Any()).Return(nil, errors.New("mock error"))
+				return m
+			},
+		},
+		{
+			name:    "error get goals",
+			wantErr: true,
+			goalRepo: func() repository.GoalRepository {
+				m := mocks.NewMockGoalRepository(ctrl)
+				m.EXPECT().GetGoals(gomock.Any(), int64(2), "2022-04").Return(nil, errors.New("mock error"))
+				return m
+			},
+			forecastRepo: func() repository.ForecastRepository {
+				return mocks.NewMockForecastRepository(ctrl)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gu := NewGoalUsecase(tt.goalRepo(), tt.forecastRepo())
+			got, err := gu.GetGoals(context.TODO(), 2, period)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("goalUsecase.GetGoals() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && (got.Period != "2022-04" || len(got.Goals) != tt.wantGoals) {
+				t.Errorf("goalUsecase.GetGoals() = %v, want %v goals of 2022-04", got, tt.wantGoals)
+			}
+		})
+	}
+}
+
+func Test_goalUsecase_CreateGoal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	goal := domain.Goal{SellerID: 2, Period: "2022-04", Metric: domain.GoalMetricCancellationRate, Target: 5}
+
+	tests := []struct {
+		name        string
+		goal        domain.Goal
+		wantErr     bool
+		wantInvalid bool
+		repo        func() repository.GoalRepository
+	}{
+		{
+			name: "sukses",
+			goal: goal,
+			repo: func() repository.GoalRepository {
+				m := mocks.NewMockGoalRepository(ctrl)
+				m.EXPECT().CreateGoal(gomock.Any(), goal).Return(&goal, nil)
+				return m
+			},
+		},
+		{
+			name:        "rate over 100",
+			goal:        domain.Goal{SellerID: 2, Period: "2022-04", Metric: domain.GoalMetricCancellationRate, Target: 101},
+			wantErr:     true,
+			wantInvalid: true,
+			repo: func() repository.GoalRepository {
+				return mocks.NewMockGoalRepository(ctrl)
+			},
+		},
+		{
+			name:        "negative target",
+			goal:        domain.Goal{SellerID: 2, Period: "2022-04", Metric: domain.GoalMetricRevenue, Target: -1},
+			wantErr:     true,
+			wantInvalid: true,
+			repo: func() repository.GoalRepository {
+				return mocks.NewMockGoalRepository(ctrl)
+			},
+		},
+		{
+			name:    "already set",
+			goal:    goal,
+			wantErr: true,
+			repo: func() repository.GoalRepository {
+				m := mocks.NewMockGoalRepository(ctrl)
+				m.EXPECT().CreateGoal(gomock.Any(), goal).Return(nil, domain.ErrGoalExists)
+				return m
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gu := NewGoalUsecase(tt.repo(), mocks.NewMockForecastRepository(ctrl))
+			_, err := gu.CreateGoal(context.TODO(), tt.goal)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("goalUsecase.CreateGoal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if _, ok := validation.AsError(err); ok != tt.wantInvalid {
+				t.Errorf("goalUsecase.CreateGoal() error = %v, wantInvalid %v", err, tt.wantInvalid)
+			}
+		})
+	}
+}
+
+func Test_goalUsecase_UpdateGoal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	goal := domain.Goal{SellerID: 2, Period: "2022-04", Metric: domain.GoalMetricCancellationRate, Target: 5}
+	updated := goal
+	updated.Target = 3
+
+	tests := []struct {
+		name    string
+		target  float64
+		wantErr error
+		repo    func() repository.GoalRepository
+	}{
+		{
+			name:   "sukses",
+			target: 3,
+			repo: func() repository.GoalRepository {
+				m := mocks.NewMockGoalRepository(ctrl)
+				m.EXPECT().GetGoal(gomock.Any(), int64(2), uint(1)).Return(&goal, nil)
+				m.EXPECT().UpdateGoal(gomock.Any(), updated).Return(&updated, nil)
+				return m
+			},
+		},
+		{
+			name:    "goal of another seller",
+			target:  3,
+			wantErr: domain.ErrGoalNotFound,
+			repo: func() repository.GoalRepository {
+				m := mocks.NewMockGoalRepository(ctrl)
+				m.EXPECT().GetGoal(gomock.Any(), int64(2), uint(1)).Return(nil, domain.ErrGoalNotFound)
+				return m
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gu := NewGoalUsecase(tt.repo(), mocks.NewMockForecastRepository(ctrl))
+			if _, err := gu.UpdateGoal(context.TODO(), 2, 1, tt.target); !errors.Is(err, tt.wantErr) {
+				t.Errorf("goalUsecase.UpdateGoal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
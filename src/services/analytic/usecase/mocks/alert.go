@@ -0,0 +1,78 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: alert.go
+
+// Package mock_usecase is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+)
+
+// MockAlertUsecase is a mock of AlertUsecase interface.
+type MockAlertUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAlertUsecaseMockRecorder
+}
+
+// MockAlertUsecaseMockRecorder is the mock recorder for MockAlertUsecase.
+type MockAlertUsecaseMockRecorder struct {
+	mock *MockAlertUsecase
+}
+
+// NewMockAlertUsecase creates a new mock instance.
+func NewMockAlertUsecase(ctrl *gomock.Controller) *MockAlertUsecase {
+	mock := &MockAlertUsecase{ctrl: ctrl}
+	mock.recorder = &MockAlertUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAlertUsecase) EXPECT() *MockAlertUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AcknowledgeAlert mocks base method.
+func (m *MockAlertUsecase) AcknowledgeAlert(ctx context.Context, sellerId int64, id uint) (*domain.Alert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcknowledgeAlert", ctx, sellerId, id)
+	ret0, _ := ret[0].(*domain.Alert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcknowledgeAlert indicates an expected call of AcknowledgeAlert.
+func (mr *MockAlertUsecaseMockRecorder) AcknowledgeAlert(ctx, sellerId, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcknowledgeAlert", reflect.TypeOf((*MockAlertUsecase)(nil).AcknowledgeAlert), ctx, sellerId, id)
+}
+
+// GetAlerts mocks base method.
+func (m *MockAlertUsecase) GetAlerts(ctx context.Context, sellerId int64, acknowledged *bool) ([]domain.Alert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlerts", ctx, sellerId, acknowledged)
+	ret0, _ := ret[0].([]domain.Alert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlerts indicates an expected call of GetAlerts.
+func (mr *MockAlertUsecaseMockRecorder) GetAlerts(ctx, sellerId, acknowledged interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlerts", reflect.TypeOf((*MockAlertUsecase)(nil).GetAlerts), ctx, sellerId, acknowledged)
+}
+
+// HandleStatisticEvent mocks base method.
+func (m *MockAlertUsecase) HandleStatisticEvent(statisticEvent domain.StatisticEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleStatisticEvent", statisticEvent)
+}
+
+// HandleStatisticEvent indicates an expected call of HandleStatisticEvent.
+func (mr *MockAlertUsecaseMockRecorder) HandleStatisticEvent(statisticEvent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleStatisticEvent", reflect.TypeOf((*MockAlertUsecase)(nil).HandleStatisticEvent), statisticEvent)
+}
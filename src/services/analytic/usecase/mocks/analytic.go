@@ -37,18 +37,18 @@ func (m *MockAnalyticUsecase) EXPECT() *MockAnalyticUsecaseMockRecorder {
 }
 
 // GetAnalyticByDate mocks base method.
-func (m *MockAnalyticUsecase) GetAnalyticByDate(ctx context.Context, date time.Time) (*domain.Analytic, error) {
+func (m *MockAnalyticUsecase) GetAnalyticByDate(ctx context.Context, sellerId int64, date time.Time) (*domain.Analytic, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAnalyticByDate", ctx, date)
+	ret := m.ctrl.Call(m, "GetAnalyticByDate", ctx, sellerId, date)
 	ret0, _ := ret[0].(*domain.Analytic)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAnalyticByDate indicates an expected call of GetAnalyticByDate.
-func (mr *MockAnalyticUsecaseMockRecorder) GetAnalyticByDate(ctx, date interface{}) *gomock.Call {
+func (mr *MockAnalyticUsecaseMockRecorder) GetAnalyticByDate(ctx, sellerId, date interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnalyticByDate", reflect.TypeOf((*MockAnalyticUsecase)(nil).GetAnalyticByDate), ctx, date)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnalyticByDate", reflect.TypeOf((*MockAnalyticUsecase)(nil).GetAnalyticByDate), ctx, sellerId, date)
 }
 
 // HandleStatisticEvent mocks base method.
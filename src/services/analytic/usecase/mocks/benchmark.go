@@ -0,0 +1,63 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: benchmark.go
+
+// Package mock_usecase is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+)
+
+// MockBenchmarkUsecase is a mock of BenchmarkUsecase interface.
+type MockBenchmarkUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockBenchmarkUsecaseMockRecorder
+}
+
+// MockBenchmarkUsecaseMockRecorder is the mock recorder for MockBenchmarkUsecase.
+type MockBenchmarkUsecaseMockRecorder struct {
+	mock *MockBenchmarkUsecase
+}
+
+// NewMockBenchmarkUsecase creates a new mock instance.
+func NewMockBenchmarkUsecase(ctrl *gomock.Controller) *MockBenchmarkUsecase {
+	mock := &MockBenchmarkUsecase{ctrl: ctrl}
+	mock.recorder = &MockBenchmarkUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBenchmarkUsecase) EXPECT() *MockBenchmarkUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetBenchmark mocks base method.
+func (m *MockBenchmarkUsecase) GetBenchmark(ctx context.Context, sellerId, categoryId int64) (*domain.Benchmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBenchmark", ctx, sellerId, categoryId)
+	ret0, _ := ret[0].(*domain.Benchmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBenchmark indicates an expected call of GetBenchmark.
+func (mr *MockBenchmarkUsecaseMockRecorder) GetBenchmark(ctx, sellerId, categoryId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBenchmark", reflect.TypeOf((*MockBenchmarkUsecase)(nil).GetBenchmark), ctx, sellerId, categoryId)
+}
+
+// HandleOrderEvent mocks base method.
+func (m *MockBenchmarkUsecase) HandleOrderEvent(orderEvent domain.OrderEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleOrderEvent", orderEvent)
+}
+
+// HandleOrderEvent indicates an expected call of HandleOrderEvent.
+func (mr *MockBenchmarkUsecaseMockRecorder) HandleOrderEvent(orderEvent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleOrderEvent", reflect.TypeOf((*MockBenchmarkUsecase)(nil).HandleOrderEvent), orderEvent)
+}
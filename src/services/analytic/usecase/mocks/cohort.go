@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: cohort.go
+
+// Package mock_usecase is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+)
+
+// MockCohortUsecase is a mock of CohortUsecase interface.
+type MockCohortUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCohortUsecaseMockRecorder
+}
+
+// MockCohortUsecaseMockRecorder is the mock recorder for MockCohortUsecase.
+type MockCohortUsecaseMockRecorder struct {
+	mock *MockCohortUsecase
+}
+
+// NewMockCohortUsecase creates a new mock instance.
+func NewMockCohortUsecase(ctrl *gomock.Controller) *MockCohortUsecase {
+	mock := &MockCohortUsecase{ctrl: ctrl}
+	mock.recorder = &MockCohortUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCohortUsecase) EXPECT() *MockCohortUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetCohorts mocks base method.
+func (m *MockCohortUsecase) GetCohorts(ctx context.Context, sellerId int64, from time.Time, weeks int) (*domain.CohortMatrix, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCohorts", ctx, sellerId, from, weeks)
+	ret0, _ := ret[0].(*domain.CohortMatrix)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCohorts indicates an expected call of GetCohorts.
+func (mr *MockCohortUsecaseMockRecorder) GetCohorts(ctx, sellerId, from, weeks interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCohorts", reflect.TypeOf((*MockCohortUsecase)(nil).GetCohorts), ctx, sellerId, from, weeks)
+}
+
+// HandleOrderEvent mocks base method.
+func (m *MockCohortUsecase) HandleOrderEvent(orderEvent domain.OrderEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleOrderEvent", orderEvent)
+}
+
+// HandleOrderEvent indicates an expected call of HandleOrderEvent.
+func (mr *MockCohortUsecaseMockRecorder) HandleOrderEvent(orderEvent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleOrderEvent", reflect.TypeOf((*MockCohortUsecase)(nil).HandleOrderEvent), orderEvent)
+}
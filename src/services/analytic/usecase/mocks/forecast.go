@@ -0,0 +1,63 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: forecast.go
+
+// Package mock_usecase is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+)
+
+// MockForecastUsecase is a mock of ForecastUsecase interface.
+type MockForecastUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockForecastUsecaseMockRecorder
+}
+
+// MockForecastUsecaseMockRecorder is the mock recorder for MockForecastUsecase.
+type MockForecastUsecaseMockRecorder struct {
+	mock *MockForecastUsecase
+}
+
+// NewMockForecastUsecase creates a new mock instance.
+func NewMockForecastUsecase(ctrl *gomock.Controller) *MockForecastUsecase {
+	mock := &MockForecastUsecase{ctrl: ctrl}
+	mock.recorder = &MockForecastUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForecastUsecase) EXPECT() *MockForecastUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetForecast mocks base method.
+func (m *MockForecastUsecase) GetForecast(ctx context.Context, sellerId int64, days int) (*domain.Forecast, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForecast", ctx, sellerId, days)
+	ret0, _ := ret[0].(*domain.Forecast)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForecast indicates an expected call of GetForecast.
+func (mr *MockForecastUsecaseMockRecorder) GetForecast(ctx, sellerId, days interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForecast", reflect.TypeOf((*MockForecastUsecase)(nil).GetForecast), ctx, sellerId, days)
+}
+
+// HandleStatisticEvent mocks base method.
+func (m *MockForecastUsecase) HandleStatisticEvent(statisticEvent domain.StatisticEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleStatisticEvent", statisticEvent)
+}
+
+// HandleStatisticEvent indicates an expected call of HandleStatisticEvent.
+func (mr *MockForecastUsecaseMockRecorder) HandleStatisticEvent(statisticEvent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleStatisticEvent", reflect.TypeOf((*MockForecastUsecase)(nil).HandleStatisticEvent), statisticEvent)
+}
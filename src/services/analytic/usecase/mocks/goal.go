@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: goal.go
+
+// Package mock_usecase is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+)
+
+// MockGoalUsecase is a mock of GoalUsecase interface.
+type MockGoalUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGoalUsecaseMockRecorder
+}
+
+// MockGoalUsecaseMockRecorder is the mock recorder for MockGoalUsecase.
+type MockGoalUsecaseMockRecorder struct {
+	mock *MockGoalUsecase
+}
+
+// NewMockGoalUsecase creates a new mock instance.
+func NewMockGoalUsecase(ctrl *gomock.Controller) *MockGoalUsecase {
+	mock := &MockGoalUsecase{ctrl: ctrl}
+	mock.recorder = &MockGoalUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGoalUsecase) EXPECT() *MockGoalUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreateGoal mocks base method.
+func (m *MockGoalUsecase) CreateGoal(ctx context.Context, goal domain.Goal) (*domain.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGoal", ctx, goal)
+	ret0, _ := ret[0].(*domain.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGoal indicates an expected call of CreateGoal.
+func (mr *MockGoalUsecaseMockRecorder) CreateGoal(ctx, goal interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGoal", reflect.TypeOf((*MockGoalUsecase)(nil).CreateGoal), ctx, goal)
+}
+
+// DeleteGoal mocks base method.
+func (m *MockGoalUsecase) DeleteGoal(ctx context.Context, sellerId int64, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGoal", ctx, sellerId, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGoal indicates an expected call of DeleteGoal.
+func (mr *MockGoalUsecaseMockRecorder) DeleteGoal(ctx, sellerId, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGoal", reflect.TypeOf((*MockGoalUsecase)(nil).DeleteGoal), ctx, sellerId, id)
+}
+
+// GetGoals mocks base method.
+func (m *MockGoalUsecase) GetGoals(ctx context.Context, sellerId int64, period time.Time) (*domain.GoalReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGoals", ctx, sellerId, period)
+	ret0, _ := ret[0].(*domain.GoalReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGoals indicates an expected call of GetGoals.
+func (mr *MockGoalUsecaseMockRecorder) GetGoals(ctx, sellerId, period interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGoals", reflect.TypeOf((*MockGoalUsecase)(nil).GetGoals), ctx, sellerId, period)
+}
+
+// UpdateGoal mocks base method.
+func (m *MockGoalUsecase) UpdateGoal(ctx context.Context, sellerId int64, id uint, target float64) (*domain.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGoal", ctx, sellerId, id, target)
+	ret0, _ := ret[0].(*domain.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGoal indicates an expected call of UpdateGoal.
+func (mr *MockGoalUsecaseMockRecorder) UpdateGoal(ctx, sellerId, id, target interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGoal", reflect.TypeOf((*MockGoalUsecase)(nil).UpdateGoal), ctx, sellerId, id, target)
+}
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: report.go
+
+// Package mock_usecase is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+)
+
+// MockReportUsecase is a mock of ReportUsecase interface.
+type MockReportUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportUsecaseMockRecorder
+}
+
+// MockReportUsecaseMockRecorder is the mock recorder for MockReportUsecase.
+type MockReportUsecaseMockRecorder struct {
+	mock *MockReportUsecase
+}
+
+// NewMockReportUsecase creates a new mock instance.
+func NewMockReportUsecase(ctrl *gomock.Controller) *MockReportUsecase {
+	mock := &MockReportUsecase{ctrl: ctrl}
+	mock.recorder = &MockReportUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportUsecase) EXPECT() *MockReportUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GenerateReports mocks base method.
+func (m *MockReportUsecase) GenerateReports(ctx context.Context, period string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateReports", ctx, period, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GenerateReports indicates an expected call of GenerateReports.
+func (mr *MockReportUsecaseMockRecorder) GenerateReports(ctx, period, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateReports", reflect.TypeOf((*MockReportUsecase)(nil).GenerateReports), ctx, period, now)
+}
+
+// GetReport mocks base method.
+func (m *MockReportUsecase) GetReport(ctx context.Context, sellerId int64, id uint) (*domain.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReport", ctx, sellerId, id)
+	ret0, _ := ret[0].(*domain.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReport indicates an expected call of GetReport.
+func (mr *MockReportUsecaseMockRecorder) GetReport(ctx, sellerId, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*MockReportUsecase)(nil).GetReport), ctx, sellerId, id)
+}
+
+// GetReports mocks base method.
+func (m *MockReportUsecase) GetReports(ctx context.Context, sellerId int64, period string) ([]domain.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReports", ctx, sellerId, period)
+	ret0, _ := ret[0].([]domain.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReports indicates an expected call of GetReports.
+func (mr *MockReportUsecaseMockRecorder) GetReports(ctx, sellerId, period interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReports", reflect.TypeOf((*MockReportUsecase)(nil).GetReports), ctx, sellerId, period)
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: segment.go
+
+// Package mock_usecase is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+)
+
+// MockSegmentUsecase is a mock of SegmentUsecase interface.
+type MockSegmentUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSegmentUsecaseMockRecorder
+}
+
+// MockSegmentUsecaseMockRecorder is the mock recorder for MockSegmentUsecase.
+type MockSegmentUsecaseMockRecorder struct {
+	mock *MockSegmentUsecase
+}
+
+// NewMockSegmentUsecase creates a new mock instance.
+func NewMockSegmentUsecase(ctrl *gomock.Controller) *MockSegmentUsecase {
+	mock := &MockSegmentUsecase{ctrl: ctrl}
+	mock.recorder = &MockSegmentUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSegmentUsecase) EXPECT() *MockSegmentUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetSegmentBuyers mocks base method.
+func (m *MockSegmentUsecase) GetSegmentBuyers(ctx context.Context, sellerId int64, segment string) (*domain.SegmentMembers, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSegmentBuyers", ctx, sellerId, segment)
+	ret0, _ := ret[0].(*domain.SegmentMembers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSegmentBuyers indicates an expected call of GetSegmentBuyers.
+func (mr *MockSegmentUsecaseMockRecorder) GetSegmentBuyers(ctx, sellerId, segment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSegmentBuyers", reflect.TypeOf((*MockSegmentUsecase)(nil).GetSegmentBuyers), ctx, sellerId, segment)
+}
+
+// GetSegments mocks base method.
+func (m *MockSegmentUsecase) GetSegments(ctx context.Context, sellerId int64) (*domain.SegmentSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSegments", ctx, sellerId)
+	ret0, _ := ret[0].(*domain.SegmentSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSegments indicates an expected call of GetSegments.
+func (mr *MockSegmentUsecaseMockRecorder) GetSegments(ctx, sellerId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSegments", reflect.TypeOf((*MockSegmentUsecase)(nil).GetSegments), ctx, sellerId)
+}
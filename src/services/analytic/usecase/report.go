@@ -0,0 +1,299 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/xlsx"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/repository"
+	"gorm.io/datatypes"
+)
+
+// reportColumns, header of the report table
+var reportColumns = []string{
+	"date", "currency", "revenue", "net_revenue", "orders", "completed_orders", "canceled_orders",
+	"average_order_value", "cancellation_order_rate", "order_completion_rate", "refund_rate", "sales_conversion_rate",
+	"unique_buyers", "new_buyers", "returning_buyers", "average_rating",
+}
+
+type ReportUsecase interface {
+	GenerateReports(ctx context.Context, period string, now time.Time) error
+	GetReports(ctx context.Context, sellerId int64, period string) ([]domain.Report, error)
+	GetReport(ctx context.Context, sellerId int64, id uint) (*domain.Report, error)
+}
+
+type reportUsecase struct {
+	reportRepo   repository.ReportRepository
+	analyticRepo repository.AnalyticRepository
+	forecastRepo repository.ForecastRepository
+	sinks        []repository.ReportSink
+	formats      []string
+}
+
+func NewReportUsecase(reportRepo repository.ReportRepository, analyticRepo repository.AnalyticRepository, forecastRepo repository.ForecastRepository, sinks []repository.ReportSink, reportCfg domain.ReportConfig) ReportUsecase {
+	formats := reportCfg.Formats
+	if len(formats) == 0 {
+		formats = []string{domain.ReportFormatCSV, domain.ReportFormatXLSX}
+	}
+
+	return &reportUsecase{
+		reportRepo:   reportRepo,
+		analyticRepo: analyticRepo,
+		forecastRepo: forecastRepo,
+		sinks:        sinks,
+		formats:      formats,
+	}
+}
+
+// GenerateReports, delivers the reports of period the sinks failed to take before again, then generates the reports
+// of the last complete period before now of every seller with analytic in it and delivers them to the sinks.
+// Reports generated before are left as they are, a seller failing doesn't stop the others
+func (ru *reportUsecase) GenerateReports(ctx context.Context, period string, now time.Time) error {
+	undelivered, err := ru.reportRepo.GetUndeliveredReports(ctx, period)
+	if err != nil {
+		return err
+	}
+	for _, report := range undelivered {
+		if err := ru.deliverReport(ctx, report, now); err != nil {
+			log.Println("[GenerateReports] error redeliver", report.FileName, err)
+		}
+	}
+
+	from, to := reportWindow(period, now)
+	sellers, err := ru.reportRepo.GetReportSellers(ctx, from, to)
+	if err != nil {
+		return err
+	}
+
+	for _, sellerId := range sellers {
+		if err := ru.generateSellerReports(ctx, sellerId, period, from, to, now); err != nil {
+			log.Println("[GenerateReports] error seller", sellerId, err)
+		}
+	}
+	return nil
+}
+
+// generateSellerReports, generates the seller's report of the days from up to but excluding to in every format
+func (ru *reportUsecase) generateSellerReports(ctx context.Context, sellerId int64, period string, from, to, now time.Time) error {
+	sales, err := ru.forecastRepo.GetDailySales(ctx, sellerId, from, to)
+	if err != nil {
+		return err
+	}
+	analytics, err := ru.analyticRepo.GetAnalytics(ctx, sellerId, from, to)
+	if err != nil {
+		return err
+	}
+
+	table := buildReportTable(sales, analytics)
+	for _, format := range ru.formats {
+		content, err := renderReport(table, format)
+		if err != nil {
+			return err
+		}
+
+		report, err := ru.reportRepo.CreateReport(ctx, domain.Report{
+			SellerID: sellerId,
+			Period:   period,
+			Format:   format,
+			FileName: fmt.Sprintf("seller-%d-%s-%s.%s", sellerId, period, from.Format(domain.AnalyticDateFormat), format),
+			Size:     int64(len(content)),
+			Content:  content,
+			FromDate: datatypes.Date(from),
+			ToDate:   datatypes.Date(to.AddDate(0, 0, -1)),
+		})
+		if err != nil {
+			return err
+		}
+		if report == nil {
+			continue
+		}
+
+		if err := ru.deliverReport(ctx, *report, now); err != nil {
+			log.Println("[GenerateReports] error Deliver", report.FileName, err)
+		}
+	}
+	return nil
+}
+
+// deliverReport, delivers the report to every sink and records it delivered at now once all of them took it. A
+// report a sink failed is left undelivered, to be delivered to every sink again on the next run of its period
+func (ru *reportUsecase) deliverReport(ctx context.Context, report domain.Report, now time.Time) error {
+	for _, sink := range ru.sinks {
+		if err := sink.Deliver(ctx, report); err != nil {
+			return err
+		}
+	}
+	return ru.reportRepo.SetReportDelivered(ctx, report.ID, now)
+}
+
+// GetReports, the latest reports of the seller, of every period when period is empty
+func (ru *reportUsecase) GetReports(ctx context.Context, sellerId int64, period string) ([]domain.Report, error) {
+	return ru.reportRepo.GetReports(ctx, sellerId, period)
+}
+
+// GetReport, the report of the seller with its content to download, ErrReportNotFound for the report of another seller
+func (ru *reportUsecase) GetReport(ctx context.Context, sellerId int64, id uint) (*domain.Report, error) {
+	return ru.reportRepo.GetReport(ctx, sellerId, id)
+}
+
+// reportWindow, the days from up to but excluding to of the last complete period before now, the previous day,
+// the previous monday to sunday week or the previous calendar month
+func reportWindow(period string, now time.Time) (time.Time, time.Time) {
+	today := toDay(now)
+	switch period {
+	case domain.ReportPeriodWeekly:
+		to := domain.CohortWeek(today)
+		return to.AddDate(0, 0, -7), to
+	case domain.ReportPeriodMonthly:
+		to := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return to.AddDate(0, -1, 0), to
+	default:
+		return today.AddDate(0, 0, -1), today
+	}
+}
+
+// reportDay, the sales and analytic of a day of the report, either may be missing
+type reportDay struct {
+	sales    *domain.DailySales
+	analytic *domain.Analytic
+}
+
+// buildReportTable, the report table of a header, a row per day with sales or analytic, oldest first, and a total
+// row. Cells of data a day lacks are left empty, the total only sums the counts and derives the rates from them
+func buildReportTable(sales []domain.DailySales, analytics []domain.Analytic) [][]xlsx.Cell {
+	days := make(map[string]*reportDay)
+	day := func(date datatypes.Date) *reportDay {
+		key := time.Time(date).Format(domain.AnalyticDateFormat)
+		if _, ok := days[key]; !ok {
+			days[key] = &reportDay{}
+		}
+		return days[key]
+	}
+	for i := range sales {
+		day(sales[i].Date).sales = &sales[i]
+	}
+	for i := range analytics {
+		day(analytics[i].Date).analytic = &analytics[i]
+	}
+
+	dates := make([]string, 0, len(days))
+	for date := range days {
+		dates = append(dates, date)
+	}
+	sort.Strings(dates)
+
+	header := make([]xlsx.Cell, len(reportColumns))
+	for i, column := range reportColumns {
+		header[i] = xlsx.String(column)
+	}
+	table := [][]xlsx.Cell{header}
+
+	var (
+		revenue, netRevenue                    money.Money
+		revenueOk, netRevenueOk                = true, true
+		orders, completed, canceled, newBuyers int64
+	)
+	amount := func(m money.Money) xlsx.Cell { return xlsx.Number(m.Major()) }
+	rate := func(r float32) xlsx.Cell { return xlsx.Number(roundHundredths(float64(r))) }
+
+	for _, date := range dates {
+		d := days[date]
+		row := make([]xlsx.Cell, len(reportColumns))
+		row[0] = xlsx.String(date)
+
+		if s := d.sales; s != nil {
+			row[1] = xlsx.String(s.Revenue.Currency)
+			row[2] = amount(s.Revenue)
+			row[4], row[5], row[6] = xlsx.Number(float64(s.Orders)), xlsx.Number(float64(s.CompletedOrders)), xlsx.Number(float64(s.CanceledOrders))
+
+			if sum, err := revenue.Add(s.Revenue); err != nil {
+				revenueOk = false
+			} else {
+				revenue = sum
+			}
+			orders, completed, canceled = orders+s.Orders, completed+s.CompletedOrders, canceled+s.CanceledOrders
+		}
+		if a := d.analytic; a != nil {
+			if d.sales == nil {
+				row[1] = xlsx.String(a.NetRevenue.Currency)
+			}
+			row[3] = amount(a.NetRevenue)
+			row[7] = amount(a.AverageOrderValue)
+			row[8], row[9], row[10], row[11] = rate(a.CancellationOrderRate), rate(a.OrderCompletionRate), rate(a.RefundRate), rate(a.SalesConvertionRate)
+			row[12], row[13], row[14] = xlsx.Number(float64(a.UniqueBuyers)), xlsx.Number(float64(a.NewBuyers)), xlsx.Number(float64(a.ReturningBuyers))
+			row[15] = rate(a.AverageRating)
+
+			if sum, err := netRevenue.Add(a.NetRevenue); err != nil {
+				netRevenueOk = false
+			} else {
+				netRevenue = sum
+			}
+			newBuyers += a.NewBuyers
+		}
+		table = append(table, row)
+	}
+
+	total := make([]xlsx.Cell, len(reportColumns))
+	total[0] = xlsx.String("total")
+	currency := ""
+	if revenueOk && revenue.Currency != "" {
+		currency, total[2] = revenue.Currency, amount(revenue)
+		if completed > 0 {
+			total[7] = xlsx.Number(roundHundredths(revenue.Major() / float64(completed)))
+		}
+	}
+	if netRevenueOk && netRevenue.Currency != "" && (currency == "" || currency == netRevenue.Currency) {
+		currency, total[3] = netRevenue.Currency, amount(netRevenue)
+	}
+	if currency != "" {
+		total[1] = xlsx.String(currency)
+	}
+	total[4], total[5], total[6] = xlsx.Number(float64(orders)), xlsx.Number(float64(completed)), xlsx.Number(float64(canceled))
+	if orders > 0 {
+		total[8] = xlsx.Number(roundHundredths(float64(canceled) / float64(orders) * 100))
+		total[9] = xlsx.Number(roundHundredths(float64(completed) / float64(orders) * 100))
+	}
+	total[13] = xlsx.Number(float64(newBuyers))
+
+	return append(table, total)
+}
+
+// renderReport, the report table as a csv or xlsx file
+func renderReport(table [][]xlsx.Cell, format string) ([]byte, error) {
+	var buf bytes.Buffer
+	switch format {
+	case domain.ReportFormatCSV:
+		w := csv.NewWriter(&buf)
+		for _, row := range table {
+			record := make([]string, len(row))
+			for i, cell := range row {
+				record[i] = cell.Text()
+			}
+			if err := w.Write(record); err != nil {
+				return nil, err
+			}
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			return nil, err
+		}
+	case domain.ReportFormatXLSX:
+		wb := xlsx.New("Report")
+		for _, row := range table {
+			wb.AddRow(row...)
+		}
+		if _, err := wb.WriteTo(&buf); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown report format %q", format)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,299 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/repository"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/repository/mocks"
+	"gorm.io/datatypes"
+)
+
+func Test_reportWindow(t *testing.T) {
+	// 2022-02-02 is a wednesday
+	now := time.Date(2022, 2, 2, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		period   string
+		wantFrom time.Time
+		wantTo   time.Time
+	}{
+		{
+			period:   domain.ReportPeriodDaily,
+			wantFrom: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
+			wantTo:   time.Date(2022, 2, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			period:   domain.ReportPeriodWeekly,
+			wantFrom: time.Date(2022, 1, 24, 0, 0, 0, 0, time.UTC),
+			wantTo:   time.Date(2022, 1, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			period:   domain.ReportPeriodMonthly,
+			wantFrom: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			wantTo:   time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.period, func(t *testing.T) {
+			from, to := reportWindow(tt.period, now)
+			if !from.Equal(tt.wantFrom) || !to.Equal(tt.wantTo) {
+				t.Errorf("reportWindow() = %v, %v, want %v, %v", from, to, tt.wantFrom, tt.wantTo)
+			}
+		})
+	}
+}
+
+func Test_renderReport(t *testing.T) {
+	day := func(d int) datatypes.Date { return datatypes.Date(time.Date(2022, 1, d, 0, 0, 0, 0, time.UTC)) }
+	sales := []domain.DailySales{
+		{SellerID: 2, Date: day(1), Revenue: money.New(100000, "IDR"), Orders: 4, CompletedOrders: 2, CanceledOrders: 1},
+		{SellerID: 2, Date: day(2), Revenue: money.New(50000, "IDR"), Orders: 2, CompletedOrders: 1},
+	}
+	analytics := []domain.Analytic{
+		{
+			SellerID: 2, Date: day(1), NetRevenue: money.New(90000, "IDR"), AverageOrderValue: money.New(50000, "IDR"),
+			CancellationOrderRate: 25, OrderCompletionRate: 50, RefundRate: 10, SalesConvertionRate: 5,
+			UniqueBuyers: 3, NewBuyers: 2, ReturningBuyers: 1, AverageRating: 4.5,
+		},
+		{SellerID: 2, Date: day(3), NetRevenue: money.New(0, "IDR"), AverageOrderValue: money.New(0, "IDR")},
+	}
+
+	tests := []struct {
+		name      string
+		sales     []domain.DailySales
+		analytics []domain.Analytic
+		want      string
+	}{
+		{
+			name:      "days with sales or analytic",
+			sales:     sales,
+			analytics: analytics,
+			want: "date,currency,revenue,net_revenue,orders,completed_orders,canceled_orders,average_order_value,cancellation_order_rate,order_completion_rate,refund_rate,sales_conversion_rate,unique_buyers,new_buyers,returning_buyers,average_rating\n" +
+				"2022-01-01,IDR,1000,900,4,2,1,500,25,50,10,5,3,2,1,4.5\n" +
+				"2022-01-02,IDR,500,,2,1,0,,,,,,,,,\n" +
+				"2022-01-03,IDR,,0,,,,0,0,0,0,0,0,0,0,0\n" +
+				"total,IDR,1500,900,6,3,1,500,16.67,50,,,,2,,\n",
+		},
+		{
+			name: "no days",
+			want: "date,currency,revenue,net_revenue,orders,completed_orders,canceled_orders,average_order_value,cancellation_order_rate,order_completion_rate,refund_rate,sales_conversion_rate,unique_buyers,new_buyers,returning_buyers,average_rating\n" +
+				"total,,,,0,0,0,,,,,,,0,,\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := buildReportTable(tt.sales, tt.analytics)
+
+			got, err := renderReport(table, domain.ReportFormatCSV)
+			if err != nil || string(got) != tt.want {
+				t.Errorf("renderReport() = %q, %v, want %q", got, err, tt.want)
+			}
+
+			// xlsx files are zip archives
+			got, err = renderReport(table, domain.ReportFormatXLSX)
+			if err != nil || !bytes.HasPrefix(got, []byte("PK")) {
+				t.Errorf("renderReport() xlsx error = %v", err)
+			}
+		})
+	}
+}
+
+func Test_reportUsecase_GenerateReports(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2022, 1, 2, 1, 0, 0, 0, time.UTC)
+	from := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 1)
+
+	tests := []struct {
+		name         string
+		wantErr      bool
+		deliveries   int
+		sinkErr      error
+		reportRepo   func() repository.ReportRepository
+		forecastRepo func() repository.ForecastRepository
+		analyticRepo func() repository.AnalyticRepository
+	}{
+		{
+			name:       "sukses",
+			deliveries: 1,
+			reportRepo: func() repository.ReportRepository {
+				m := mocks.NewMockReportRepository(ctrl)
+				m.EXPECT().GetUndeliveredReports(gomock.Any(), domain.ReportPeriodDaily).Return(nil, nil)
+				m.EXPECT().GetReportSellers(gomock.Any(), from, to).Return([]int64{2}, nil)
+				m.EXPECT().CreateReport(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, report domain.Report) (*domain.Report, error) {
+					if report.SellerID != 2 || report.FileName != "seller-2-daily-2022-01-01.csv" || report.Size != int64(len(report.Content)) ||
+						time.Time(report.FromDate) != from || time.Time(report.ToDate) != from {
+						t.Errorf("CreateReport() report = %v", report)
+					}
+					report.ID = 1
+					return &report, nil
+				})
+				m.EXPECT().SetReportDelivered(gomock.Any(), uint(1), now).Return(nil)
+				return m
+			},
+			forecastRepo: func() repository.ForecastRepository {
+				m := mocks.NewMockForecastRepository(ctrl)
+				m.EXPECT().GetDailySales(gomock.Any(), int64(2), from, to).Return(nil, nil)
+				return m
+			},
+			analyticRepo: func() repository.AnalyticRepository {
+				m := mocks.NewMockAnalyticRepository(ctrl)
+				m.EXPECT().GetAnalytics(gomock.Any(), int64(2), from, to).Return(nil, nil)
+				return m
+			},
+		},
+		{
+			name:       "delivery failed left undelivered",
+			deliveries: 1,
+			sinkErr:    errors.New("mock error"),
+			reportRepo: func() repository.ReportRepository {
+				m := mocks.NewMockReportRepository(ctrl)
+				m.EXPECT().GetUndeliveredReports(gomock.Any(), domain.ReportPeriodDaily).Return(nil, nil)
+				m.EXPECT().GetReportSellers(gomock.Any(), from, to).Return([]int64{2}, nil)
+				m.EXPECT().CreateReport(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, report domain.Report) (*domain.Report, error) {
+					report.ID = 1
+					return &report, nil
+				})
+				return m
+			},
+			forecastRepo: func() repository.ForecastRepository {
+				m := mocks.NewMockForecastRepository(ctrl)
+				m.EXPECT().GetDailySales(gomock.Any(), int64(2), from, to).Return(nil, nil)
+				return m
+			},
+			analyticRepo: func() repository.AnalyticRepository {
+				m := mocks.NewMockAnalyticRepository(ctrl)
+				m.EXPECT().GetAnalytics(gomock.Any(), int64(2), from, to).Return(nil, nil)
+				return m
+			},
+		},
+		{
+			name:       "undelivered delivered again",
+			deliveries: 1,
+			reportRepo: func() repository.ReportRepository {
+				m := mocks.NewMockReportRepository(ctrl)
+				m.EXPECT().GetUndeliveredReports(gomock.Any(), domain.ReportPeriodDaily).Return([]domain.Report{
+					{Model: yugabyte.Model{ID: 3}, SellerID: 2, Period: domain.ReportPeriodDaily, Content: []byte("date\n")},
+				}, nil)
+				m.EXPECT().SetReportDelivered(gomock.Any(), uint(3), now).Return(nil)
+				m.EXPECT().GetReportSellers(gomock.Any(), from, to).Return(nil, nil)
+				return m
+			},
+			forecastRepo: func() repository.ForecastRepository {
+				return mocks.NewMockForecastRepository(ctrl)
+			},
+			analyticRepo: func() repository.AnalyticRepository {
+				return mocks.NewMockAnalyticRepository(ctrl)
+			},
+		},
+		{
+			name:       "undelivered failing again",
+			deliveries: 1,
+			sinkErr:    errors.New("mock error"),
+			reportRepo: func() repository.ReportRepository {
+				m := mocks.NewMockReportRepository(ctrl)
+				m.EXPECT().GetUndeliveredReports(gomock.Any(), domain.ReportPeriodDaily).Return([]domain.Report{
+					{Model: yugabyte.Model{ID: 3}, SellerID: 2, Period: domain.ReportPeriodDaily},
+				}, nil)
+				m.EXPECT().GetReportSellers(gomock.Any(), from, to).Return(nil, nil)
+				return m
+			},
+			forecastRepo: func() repository.ForecastRepository {
+				return mocks.NewMockForecastRepository(ctrl)
+			},
+			analyticRepo: func() repository.AnalyticRepository {
+				return mocks.NewMockAnalyticRepository(ctrl)
+			},
+		},
+		{
+			name: "already generated",
+			reportRepo: func() repository.ReportRepository {
+				m := mocks.NewMockReportRepository(ctrl)
+				m.EXPECT().GetUndeliveredReports(gomock.Any(), domain.ReportPeriodDaily).Return(nil, nil)
+				m.EXPECT().GetReportSellers(gomock.Any(), from, to).Return([]int64{2}, nil)
+				m.EXPECT().CreateReport(gomock.Any(), gomock.Any()).Return(nil, nil)
+				return m
+			},
+			forecastRepo: func() repository.ForecastRepository {
+				m := mocks.NewMockForecastRepository(ctrl)
+				m.EXPECT().GetDailySales(gomock.Any(), int64(2), from, to).Return(nil, nil)
+				return m
+			},
+			analyticRepo: func() repository.AnalyticRepository {
+				m := mocks.NewMockAnalyticRepository(ctrl)
+				m.EXPECT().GetAnalytics(gomock.Any(), int64(2), from, to).Return(nil, nil)
+				return m
+			},
+		},
+		{
+			name: "error seller skipped",
+			reportRepo: func() repository.ReportRepository {
+				m := mocks.NewMockReportRepository(ctrl)
+				m.EXPECT().GetUndeliveredReports(gomock.Any(), domain.ReportPeriodDaily).Return(nil, nil)
+				m.EXPECT().GetReportSellers(gomock.Any(), from, to).Return([]int64{2}, nil)
+				return m
+			},
+			forecastRepo: func() repository.ForecastRepository {
+				m := mocks.NewMockForecastRepository(ctrl)
+				m.EXPECT().GetDailySales(gomock.Any(), int64(2), from, to).Return(nil, errors.New("mock error"))
+				return m
+			},
+			analyticRepo: func() repository.AnalyticRepository {
+				return mocks.NewMockAnalyticRepository(ctrl)
+			},
+		},
+		{
+			name:    "error get undelivered reports",
+			wantErr: true,
+			reportRepo: func() repository.ReportRepository {
+				m := mocks.NewMockReportRepository(ctrl)
+				m.EXPECT().GetUndeliveredReports(gomock.Any(), domain.ReportPeriodDaily).Return(nil, errors.New("mock error"))
+				return m
+			},
+			forecastRepo: func() repository.ForecastRepository {
+				return mocks.NewMockForecastRepository(ctrl)
+			},
+			analyticRepo: func() repository.AnalyticRepository {
+				return mocks.NewMockAnalyticRepository(ctrl)
+			},
+		},
+		{
+			name:    "error get report sellers",
+			wantErr: true,
+			reportRepo: func() repository.ReportRepository {
+				m := mocks.NewMockReportRepository(ctrl)
+				m.EXPECT().GetUndeliveredReports(gomock.Any(), domain.ReportPeriodDaily).Return(nil, nil)
+				m.EXPECT().GetReportSellers(gomock.Any(), from, to).Return(nil, errors.New("mock error"))
+				return m
+			},
+			forecastRepo: func() repository.ForecastRepository {
+				return mocks.NewMockForecastRepository(ctrl)
+			},
+			analyticRepo: func() repository.AnalyticRepository {
+				return mocks.NewMockAnalyticRepository(ctrl)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink := mocks.NewMockReportSink(ctrl)
+			sink.EXPECT().Deliver(gomock.Any(), gomock.Any()).Return(tt.sinkErr).Times(tt.deliveries)
+
+			cfg := domain.ReportConfig{Formats: []string{domain.ReportFormatCSV}}
+			ru := NewReportUsecase(tt.reportRepo(), tt.analyticRepo(), tt.forecastRepo(), []repository.ReportSink{sink}, cfg)
+			if err := ru.GenerateReports(context.TODO(), domain.ReportPeriodDaily, now); (err != nil) != tt.wantErr {
+				t.Errorf("reportUsecase.GenerateReports() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
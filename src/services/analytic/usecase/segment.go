@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/repository"
+)
+
+type SegmentUsecase interface {
+	GetSegments(ctx context.Context, sellerId int64) (*domain.SegmentSummary, error)
+	GetSegmentBuyers(ctx context.Context, sellerId int64, segment string) (*domain.SegmentMembers, error)
+}
+
+type segmentUsecase struct {
+	segmentRepo repository.SegmentRepository
+}
+
+func NewSegmentUsecase(segmentRepo repository.SegmentRepository) SegmentUsecase {
+	return &segmentUsecase{
+		segmentRepo: segmentRepo,
+	}
+}
+
+// GetSegments, number of buyers of the seller per RFM segment
+func (su *segmentUsecase) GetSegments(ctx context.Context, sellerId int64) (*domain.SegmentSummary, error) {
+	orders, err := su.segmentRepo.GetBuyerOrders(ctx, sellerId)
+	if err != nil {
+		return nil, err
+	}
+
+	buyers := scoreBuyers(orders, time.Now())
+	counts := make(map[string]int64, len(domain.Segments))
+	for _, b := range buyers {
+		counts[b.Segment] += 1
+	}
+
+	result := &domain.SegmentSummary{
+		SellerID: sellerId,
+		Buyers:   int64(len(buyers)),
+		Segments: make([]domain.SegmentCount, 0, len(domain.Segments)),
+	}
+	for _, segment := range domain.Segments {
+		count := domain.SegmentCount{
+			Segment: segment,
+			Buyers:  counts[segment],
+		}
+		if result.Buyers != 0 {
+			count.Share = float32(count.Buyers) / float32(result.Buyers) * 100
+		}
+		result.Segments = append(result.Segments, count)
+	}
+	return result, nil
+}
+
+// GetSegmentBuyers, the buyers of the seller in segment with their RFM scores, best scored first
+func (su *segmentUsecase) GetSegmentBuyers(ctx context.Context, sellerId int64, segment string) (*domain.SegmentMembers, error) {
+	orders, err := su.segmentRepo.GetBuyerOrders(ctx, sellerId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.SegmentMembers{
+		SellerID: sellerId,
+		Segment:  segment,
+		Buyers:   []domain.BuyerRFM{},
+	}
+	for _, b := range scoreBuyers(orders, time.Now()) {
+		if b.Segment == segment {
+			result.Buyers = append(result.Buyers, b)
+		}
+	}
+	sort.SliceStable(result.Buyers, func(i, j int) bool {
+		a, b := result.Buyers[i], result.Buyers[j]
+		return a.RecencyScore+a.FrequencyScore+a.MonetaryScore > b.RecencyScore+b.FrequencyScore+b.MonetaryScore
+	})
+	return result, nil
+}
+
+// scoreBuyers, scores the recency, frequency and monetary value of every buyer against the other buyers of the
+// seller as of now, in buyer order. Amounts in different currencies can't be compared, a buyer's monetary score is
+// the best one among the buyers paying in the same currency
+func scoreBuyers(orders []domain.BuyerOrders, now time.Time) []domain.BuyerRFM {
+	var result []domain.BuyerRFM
+	index := make(map[int64]int)
+	lastOrders := []time.Time{}
+	spent := make(map[string][]int64)
+	spenders := make(map[string][]int)
+
+	today := toDay(now)
+	for _, o := range orders {
+		i, ok := index[o.BuyerID]
+		if !ok {
+			i = len(result)
+			index[o.BuyerID] = i
+			result = append(result, domain.BuyerRFM{BuyerID: o.BuyerID, Monetary: []money.Money{}})
+			lastOrders = append(lastOrders, o.LastOrderDate)
+		}
+		result[i].Frequency += o.Orders
+		if o.LastOrderDate.After(lastOrders[i]) {
+			lastOrders[i] = o.LastOrderDate
+		}
+		result[i].Monetary = addRevenue(result[i].Monetary, o.Revenue)
+		spent[o.Revenue.Currency] = append(spent[o.Revenue.Currency], o.Revenue.Amount)
+		spenders[o.Revenue.Currency] = append(spenders[o.Revenue.Currency], i)
+	}
+
+	recency := make([]int64, len(result))
+	frequency := make([]int64, len(result))
+	for i := range result {
+		result[i].RecencyDays = int64(today.Sub(toDay(lastOrders[i])) / (24 * time.Hour))
+		// the fewer days since the latest order the better the recency
+		recency[i] = -result[i].RecencyDays
+		frequency[i] = result[i].Frequency
+	}
+
+	recencyScores := quintileScores(recency)
+	frequencyScores := quintileScores(frequency)
+	for i := range result {
+		result[i].RecencyScore = recencyScores[i]
+		result[i].FrequencyScore = frequencyScores[i]
+		result[i].MonetaryScore = 1
+	}
+	for currency, amounts := range spent {
+		for j, score := range quintileScores(amounts) {
+			if i := spenders[currency][j]; score > result[i].MonetaryScore {
+				result[i].MonetaryScore = score
+			}
+		}
+	}
+
+	for i := range result {
+		result[i].Segment = rfmSegment(result[i])
+	}
+	return result
+}
+
+// quintileScores, scores every value from 1 to 5 by the quintile it falls in, equal values share the lower score
+func quintileScores(values []int64) []int {
+	sorted := make([]int64, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	result := make([]int, len(values))
+	for i, v := range values {
+		rank := sort.Search(len(sorted), func(j int) bool { return sorted[j] >= v })
+		result[i] = 1 + rank*5/len(sorted)
+	}
+	return result
+}
+
+// rfmSegment, the segment of a buyer given their scores, a recent buyer with a single order is new to the seller
+func rfmSegment(buyer domain.BuyerRFM) string {
+	recency := buyer.RecencyScore
+	// frequency and monetary together tell how valuable the buyer is, rounded up
+	value := (buyer.FrequencyScore + buyer.MonetaryScore + 1) / 2
+	switch {
+	case recency >= 4 && value >= 4:
+		return domain.SegmentChampions
+	case recency >= 4 && buyer.Frequency == 1:
+		return domain.SegmentNew
+	case recency >= 3 && value >= 3:
+		return domain.SegmentLoyal
+	case recency >= 3:
+		return domain.SegmentPotentialLoyalists
+	case value >= 3:
+		return domain.SegmentAtRisk
+	case recency == 2:
+		return domain.SegmentHibernating
+	default:
+		return domain.SegmentLost
+	}
+}
+
+// toDay, the calendar day of t regardless of its location
+func toDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/repository"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/analytic/repository/mocks"
+)
+
+func Test_scoreBuyers(t *testing.T) {
+	now := time.Date(2022, 3, 1, 15, 0, 0, 0, time.UTC)
+	orders := []domain.BuyerOrders{
+		{BuyerID: 1, Orders: 6, LastOrderDate: time.Date(2022, 2, 28, 0, 0, 0, 0, time.UTC), Revenue: money.New(600, "IDR")},
+		{BuyerID: 2, Orders: 3, LastOrderDate: time.Date(2022, 2, 20, 0, 0, 0, 0, time.UTC), Revenue: money.New(400, "IDR")},
+		{BuyerID: 2, Orders: 1, LastOrderDate: time.Date(2022, 2, 10, 0, 0, 0, 0, time.UTC), Revenue: money.New(5, "USD")},
+		{BuyerID: 3, Orders: 1, LastOrderDate: time.Date(2022, 2, 27, 0, 0, 0, 0, time.UTC), Revenue: money.New(50, "IDR")},
+		{BuyerID: 4, Orders: 3, LastOrderDate: time.Date(2022, 1, 10, 0, 0, 0, 0, time.UTC), Revenue: money.New(300, "IDR")},
+		{BuyerID: 5, Orders: 1, LastOrderDate: time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC), Revenue: money.New(10, "IDR")},
+	}
+
+	want := []domain.BuyerRFM{
+		{BuyerID: 1, RecencyDays: 1, Frequency: 6, Monetary: []money.Money{money.New(600, "IDR")}, RecencyScore: 5, FrequencyScore: 5, MonetaryScore: 5, Segment: domain.SegmentChampions},
+		{BuyerID: 2, RecencyDays: 9, Frequency: 4, Monetary: []money.Money{money.New(400, "IDR"), money.New(5, "USD")}, RecencyScore: 3, FrequencyScore: 4, MonetaryScore: 4, Segment: domain.SegmentLoyal},
+		{BuyerID: 3, RecencyDays: 2, Frequency: 1, Monetary: []money.Money{money.New(50, "IDR")}, RecencyScore: 4, FrequencyScore: 1, MonetaryScore: 2, Segment: domain.SegmentNew},
+		{BuyerID: 4, RecencyDays: 50, Frequency: 3, Monetary: []money.Money{money.New(300, "IDR")}, RecencyScore: 2, FrequencyScore: 3, MonetaryScore: 3, Segment: domain.SegmentAtRisk},
+		{BuyerID: 5, RecencyDays: 90, Frequency: 1, Monetary: []money.Money{money.New(10, "IDR")}, RecencyScore: 1, FrequencyScore: 1, MonetaryScore: 1, Segment: domain.SegmentLost},
+	}
+
+	got := scoreBuyers(orders, now)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("scoreBuyers() = %v, want %v", got, want)
+	}
+}
+
+func Test_quintileScores(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int64
+		want   []int
+	}{
+		{
+			name:   "ten values",
+			values: []int64{10, 9, 8, 7, 6, 5, 4, 3, 2, 1},
+			want:   []int{5, 5, 4, 4, 3, 3, 2, 2, 1, 1},
+		},
+		{
+			name:   "equal values",
+			values: []int64{1, 1, 1, 2},
+			want:   []int{1, 1, 1, 4},
+		},
+		{
+			name:   "single value",
+			values: []int64{7},
+			want:   []int{1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := quintileScores(tt.values); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("quintileScores() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_rfmSegment(t *testing.T) {
+	tests := []struct {
+		name  string
+		buyer domain.BuyerRFM
+		want  string
+	}{
+		{name: "champions", buyer: domain.BuyerRFM{Frequency: 5, RecencyScore: 4, FrequencyScore: 4, MonetaryScore: 4}, want: domain.SegmentChampions},
+		{name: "new", buyer: domain.BuyerRFM{Frequency: 1, RecencyScore: 5, FrequencyScore: 1, MonetaryScore: 5}, want: domain.SegmentNew},
+		{name: "loyal", buyer: domain.BuyerRFM{Frequency: 3, RecencyScore: 3, FrequencyScore: 3, MonetaryScore: 3}, want: domain.SegmentLoyal},
+		{name: "potential loyalists", buyer: domain.BuyerRFM{Frequency: 2, RecencyScore: 4, FrequencyScore: 2, MonetaryScore: 2}, want: domain.SegmentPotentialLoyalists},
+		{name: "at risk", buyer: domain.BuyerRFM{Frequency: 5, RecencyScore: 1, FrequencyScore: 5, MonetaryScore: 5}, want: domain.SegmentAtRisk},
+		{name: "hibernating", buyer: domain.BuyerRFM{Frequency: 1, RecencyScore: 2, FrequencyScore: 1, MonetaryScore: 2}, want: domain.SegmentHibernating},
+		{name: "lost", buyer: domain.BuyerRFM{Frequency: 1, RecencyScore: 1, FrequencyScore: 1, MonetaryScore: 1}, want: domain.SegmentLost},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rfmSegment(tt.buyer); got != tt.want {
+				t.Errorf("rfmSegment() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_segmentUsecase_GetSegments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	today := time.Now()
+
+	tests := []struct {
+		name    string
+		want    *domain.SegmentSummary
+		wantErr bool
+		repo    func() repository.SegmentRepository
+	}{
+		{
+			// the better of two buyers falls in the third quintile
+			name: "sukses",
+			want: &domain.SegmentSummary{
+				SellerID: 2,
+				Buyers:   2,
+				Segments: []domain.SegmentCount{
+					{Segment: domain.SegmentChampions},
+					{Segment: domain.SegmentLoyal, Buyers: 1, Share: 50},
+					{Segment: domain.SegmentNew},
+					{Segment: domain.SegmentPotentialLoyalists},
+					{Segment: domain.SegmentAtRisk},
+					{Segment: domain.SegmentHibernating},
+					{Segment: domain.SegmentLost, Buyers: 1, Share: 50},
+				},
+			},
+			repo: func() repository.SegmentRepository {
+				m := mocks.NewMockSegmentRepository(ctrl)
+				m.EXPECT().GetBuyerOrders(gomock.Any(), int64(2)).Return([]domain.BuyerOrders{
+					{BuyerID: 1, Orders: 3, LastOrderDate: today, Revenue: money.New(300, "IDR")},
+					{BuyerID: 2, Orders: 1, LastOrderDate: today.AddDate(0, -1, 0), Revenue: money.New(100, "IDR")},
+				}, nil)
+				return m
+			},
+		},
+		{
+			name:    "error",
+			wantErr: true,
+			repo: func() repository.SegmentRepository {
+				m := mocks.NewMockSegmentRepository(ctrl)
+				m.EXPECT().GetBuyerOrders(gomock.Any(), int64(2)).Return(nil, errors.New("mock error"))
+				return m
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			su := NewSegmentUsecase(tt.repo())
+			got, err := su.GetSegments(context.TODO(), 2)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("segmentUsecase.GetSegments() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("segmentUsecase.GetSegments() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_segmentUsecase_GetSegmentBuyers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	today := time.Now()
+
+	m := mocks.NewMockSegmentRepository(ctrl)
+	m.EXPECT().GetBuyerOrders(gomock.Any(), int64(2)).Return([]domain.BuyerOrders{
+		{BuyerID: 1, Orders: 3, LastOrderDate: today, Revenue: money.New(300, "IDR")},
+		{BuyerID: 2, Orders: 1, LastOrderDate: today.AddDate(0, -1, 0), Revenue: money.New(100, "IDR")},
+	}, nil)
+
+	su := NewSegmentUsecase(m)
+	got, err := su.GetSegmentBuyers(context.TODO(), 2, domain.SegmentLoyal)
+	if err != nil {
+		t.Errorf("segmentUsecase.GetSegmentBuyers() error = %v", err)
+		return
+	}
+	want := &domain.SegmentMembers{
+		SellerID: 2,
+		Segment:  domain.SegmentLoyal,
+		Buyers: []domain.BuyerRFM{
+			{BuyerID: 1, Frequency: 3, Monetary: []money.Money{money.New(300, "IDR")}, RecencyScore: 3, FrequencyScore: 3, MonetaryScore: 3, Segment: domain.SegmentLoyal},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("segmentUsecase.GetSegmentBuyers() = %v, want %v", got, want)
+	}
+}
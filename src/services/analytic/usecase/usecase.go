@@ -4,4 +4,11 @@ import "go.uber.org/fx"
 
 var Module = fx.Options(
 	fx.Provide(NewAnalyticsUsecase),
+	fx.Provide(NewCohortUsecase),
+	fx.Provide(NewSegmentUsecase),
+	fx.Provide(NewForecastUsecase),
+	fx.Provide(NewAlertUsecase),
+	fx.Provide(NewGoalUsecase),
+	fx.Provide(NewBenchmarkUsecase),
+	fx.Provide(NewReportUsecase),
 )
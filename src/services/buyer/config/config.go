@@ -5,6 +5,7 @@ import (
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
 	mhttp "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/http/domain"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/messagequeue"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
 	"go.uber.org/fx"
 )
 
@@ -14,6 +15,15 @@ var Module = fx.Provide(
 	NewDatabaseCfg,
 	NewRabbitMQCfg,
 	NewPublisherCfg,
+	NewInventoryCfg,
+	fx.Annotate(NewStockPublisherCfg, fx.ResultTags(`name:"stockPublisher"`)),
+	fx.Annotate(NewShipmentPublisherCfg, fx.ResultTags(`name:"shipmentPublisher"`)),
+	NewShippingCfg,
+	NewOrderExpiryCfg,
+	fx.Annotate(NewPaymentPublisherCfg, fx.ResultTags(`name:"paymentPublisher"`)),
+	NewPaymentCfg,
+	fx.Annotate(NewReviewPublisherCfg, fx.ResultTags(`name:"reviewPublisher"`)),
+	fx.Annotate(NewFunnelPublisherCfg, fx.ResultTags(`name:"funnelPublisher"`)),
 )
 
 type Config struct {
@@ -21,6 +31,19 @@ type Config struct {
 	Database       yugabyte.YugabyteDBConfig
 	RabbitMQ       messagequeue.RabbitMQConfig
 	OrderPublisher messagequeue.PublisherConfig
+	StockPublisher messagequeue.PublisherConfig
+	// ShipmentPublisher, publishes the shipment events
+	ShipmentPublisher messagequeue.PublisherConfig
+	Inventory         domain.InventoryConfig
+	Shipping          domain.ShippingConfig
+	OrderExpiry       domain.OrderExpiryConfig
+	// PaymentPublisher, publishes the payment events
+	PaymentPublisher messagequeue.PublisherConfig
+	Payment          domain.PaymentConfig
+	// ReviewPublisher, publishes the review events
+	ReviewPublisher messagequeue.PublisherConfig
+	// FunnelPublisher, publishes the product view, add to cart and checkout events
+	FunnelPublisher messagequeue.PublisherConfig
 }
 
 // NewHTTPServerCfg, provides http config to dependency injection
@@ -42,3 +65,48 @@ func NewRabbitMQCfg(cfg *Config) messagequeue.RabbitMQConfig {
 func NewPublisherCfg(cfg *Config) messagequeue.PublisherConfig {
 	return cfg.OrderPublisher
 }
+
+// NewStockPublisherCfg, provides stock event mq publisher config to dependency injection
+func NewStockPublisherCfg(cfg *Config) messagequeue.PublisherConfig {
+	return cfg.StockPublisher
+}
+
+// NewInventoryCfg, provides inventory config to dependency injection
+func NewInventoryCfg(cfg *Config) domain.InventoryConfig {
+	return cfg.Inventory
+}
+
+// NewShipmentPublisherCfg, provides shipment event mq publisher config to dependency injection
+func NewShipmentPublisherCfg(cfg *Config) messagequeue.PublisherConfig {
+	return cfg.ShipmentPublisher
+}
+
+// NewShippingCfg, provides shipping config to dependency injection
+func NewShippingCfg(cfg *Config) domain.ShippingConfig {
+	return cfg.Shipping
+}
+
+// NewOrderExpiryCfg, provides order expiry config to dependency injection
+func NewOrderExpiryCfg(cfg *Config) domain.OrderExpiryConfig {
+	return cfg.OrderExpiry
+}
+
+// NewPaymentPublisherCfg, provides payment event mq publisher config to dependency injection
+func NewPaymentPublisherCfg(cfg *Config) messagequeue.PublisherConfig {
+	return cfg.PaymentPublisher
+}
+
+// NewPaymentCfg, provides payment provider config to dependency injection
+func NewPaymentCfg(cfg *Config) domain.PaymentConfig {
+	return cfg.Payment
+}
+
+// NewReviewPublisherCfg, provides review event mq publisher config to dependency injection
+func NewReviewPublisherCfg(cfg *Config) messagequeue.PublisherConfig {
+	return cfg.ReviewPublisher
+}
+
+// NewFunnelPublisherCfg, provides funnel event mq publisher config to dependency injection
+func NewFunnelPublisherCfg(cfg *Config) messagequeue.PublisherConfig {
+	return cfg.FunnelPublisher
+}
@@ -1,10 +1,45 @@
 package domain
 
-import "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+import (
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+)
 
 // Buyer, represents a buyer entity
 type Buyer struct {
 	yugabyte.Model
 	Username string  `json:"username"`
+	Name     string  `json:"name"`
+	Email    string  `json:"email"`
+	Phone    string  `json:"phone"`
 	Orders   []Order `json:"orders,omitempty"`
+	// DeactivatedAt, when the buyer closed the account, a deactivated buyer can no longer log in
+	DeactivatedAt *time.Time `json:"deactivated_at,omitempty"`
+}
+
+// IsDeactivated, whether the buyer closed the account
+func (b Buyer) IsDeactivated() bool {
+	return b.DeactivatedAt != nil
+}
+
+// ShippingAddress, where an order is delivered, copied onto the order when it is placed so later changes
+// to the buyer's address book don't affect the order
+type ShippingAddress struct {
+	RecipientName string `json:"recipient_name"`
+	Phone         string `json:"phone"`
+	Street        string `json:"street"`
+	City          string `json:"city"`
+	Province      string `json:"province"`
+	PostalCode    string `json:"postal_code"`
+	Country       string `json:"country"`
+}
+
+// Address, an entry of the buyer's address book, a buyer has at most one default address
+type Address struct {
+	yugabyte.Model
+	BuyerID uint   `json:"buyer_id" gorm:"index"`
+	Label   string `json:"label"`
+	ShippingAddress
+	IsDefault bool `json:"is_default"`
 }
@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+)
+
+// Cart, represents a buyer's persistent shopping cart, a buyer only has one cart
+type Cart struct {
+	yugabyte.Model
+	BuyerID uint       `json:"buyer_id" gorm:"uniqueIndex"`
+	Items   []CartItem `json:"items"`
+
+	TotalQuantity int         `json:"total_quantity" gorm:"-"`
+	TotalAmount   money.Money `json:"total_amount" gorm:"-"`
+}
+
+// CartItem, a product and its quantity inside a cart
+type CartItem struct {
+	yugabyte.Model
+	CartID    uint    `json:"-" gorm:"uniqueIndex:idx_cart_items_cart_product"`
+	ProductID uint    `json:"product_id" gorm:"uniqueIndex:idx_cart_items_cart_product"`
+	Product   Product `json:"product"`
+	Quantity  int     `json:"quantity"`
+
+	Subtotal money.Money `json:"subtotal" gorm:"-"`
+}
@@ -2,12 +2,50 @@ package domain
 
 const BuyerKey = "buyer"
 
+const SellerKey = "seller"
+
 const (
 	OrderStatusNew       = "new"
 	OrderStatusCancelled = "cancelled"
 	OrderStatusCompleted = "completed"
+	// OrderStatusPaid, a new order the buyer paid for at the payment provider
+	OrderStatusPaid = "paid"
+	// OrderStatusShipped, an order the seller handed over to a courier
+	OrderStatusShipped = "shipped"
+	// OrderStatusDelivered, an order the courier delivered to the buyer, the buyer still completes it
+	OrderStatusDelivered = "delivered"
+	// OrderStatusPartiallyRefunded, a completed order of which some items were refunded
+	OrderStatusPartiallyRefunded = "partially_refunded"
+	// OrderStatusRefunded, a completed order of which every item was refunded
+	OrderStatusRefunded = "refunded"
 
 	OrderStatusNewInt       = 0
 	OrderStatusCompletedInt = 1
 	OrderStatusCancelledInt = 2
+	OrderStatusRefundedInt  = 3
 )
+
+// orderStatusPredecessors, statuses an order can be in to be moved to a status by UpdateOrderById, refunds
+// move completed orders on their own. Only paid orders can be shipped or completed, unpaid ones can be cancelled
+var orderStatusPredecessors = map[string][]string{
+	OrderStatusPaid:      {OrderStatusNew},
+	OrderStatusShipped:   {OrderStatusPaid},
+	OrderStatusDelivered: {OrderStatusShipped},
+	OrderStatusCompleted: {OrderStatusPaid, OrderStatusShipped, OrderStatusDelivered},
+	OrderStatusCancelled: {OrderStatusNew},
+}
+
+// PreviousOrderStatuses, statuses an order can be moved to status from
+func PreviousOrderStatuses(status string) []string {
+	return orderStatusPredecessors[status]
+}
+
+// CanChangeOrderStatus, whether an order in status from can be moved to status to
+func CanChangeOrderStatus(from, to string) bool {
+	for _, v := range orderStatusPredecessors[to] {
+		if v == from {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNotFound, returned when the requested entity does not exist
+	ErrNotFound = errors.New("not found")
+	// ErrForbidden, returned when the entity is not owned by the requester
+	ErrForbidden = errors.New("forbidden")
+	// ErrAccountDeactivated, returned when a deactivated buyer tries to log in
+	ErrAccountDeactivated = fmt.Errorf("%w: account is deactivated", ErrForbidden)
+	// ErrInsufficientStock, returned when a product does not have enough stock left to be reserved
+	ErrInsufficientStock = errors.New("insufficient stock")
+	// ErrVoucherUsedUp, returned when a voucher reached its usage limit while the order was placed
+	ErrVoucherUsedUp = errors.New("voucher usage limit reached")
+	// ErrInvalidOrderStatus, returned when an order is not in a status allowing the requested change
+	ErrInvalidOrderStatus = errors.New("invalid order status")
+	// ErrInvalidRefund, returned when a refund exceeds the quantity of an order item left to refund
+	ErrInvalidRefund = errors.New("invalid refund")
+	// ErrAlreadyReviewed, returned when the product of an order was already reviewed
+	ErrAlreadyReviewed = errors.New("product is already reviewed")
+	// ErrInvalidWebhook, returned when a payment provider notification can't be understood
+	ErrInvalidWebhook = errors.New("invalid webhook")
+	// ErrInvalidSignature, returned when a payment provider notification is not signed with the webhook secret
+	ErrInvalidSignature = fmt.Errorf("%w: invalid signature", ErrInvalidWebhook)
+	// ErrPaymentSettled, returned when a payment already succeeded or failed
+	ErrPaymentSettled = errors.New("payment is already settled")
+	// ErrInvalidCursor, returned when a pagination cursor was not issued by a previous listing
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
@@ -0,0 +1,20 @@
+package domain
+
+const (
+	// FunnelStepView, a buyer viewed a product
+	FunnelStepView = "view"
+	// FunnelStepCart, a buyer added a product to the cart
+	FunnelStepCart = "cart"
+	// FunnelStepCheckout, a buyer attempted to check out the products of a seller, whether or not the attempt
+	// placed an order
+	FunnelStepCheckout = "checkout"
+)
+
+// PayloadEventFunnel, event published when a buyer takes a step towards placing an order of the seller, the
+// product is empty for a checkout
+type PayloadEventFunnel struct {
+	Step      string `json:"step"`
+	SellerID  int64  `json:"seller_id"`
+	ProductID int64  `json:"product_id,omitempty"`
+	Date      string `json:"date"`
+}
@@ -0,0 +1,28 @@
+package domain
+
+import "fmt"
+
+// InvoiceSequence, last invoice number issued to a seller in a year, incremented in the transaction
+// inserting the order so numbers are never skipped nor issued twice
+type InvoiceSequence struct {
+	SellerID   uint  `gorm:"primaryKey;autoIncrement:false"`
+	Year       int   `gorm:"primaryKey;autoIncrement:false"`
+	LastNumber int64 `gorm:"not null"`
+}
+
+// FormatInvoiceNo, formats the number-th invoice of a seller in a year e.g. INV/12/2026/000123
+func FormatInvoiceNo(sellerId uint, year int, number int64) string {
+	return fmt.Sprintf("INV/%d/%d/%06d", sellerId, year, number)
+}
+
+const (
+	InvoiceFormatHTML = "html"
+	InvoiceFormatPDF  = "pdf"
+)
+
+// Invoice, everything printed on the invoice of an order
+type Invoice struct {
+	Order  Order
+	Seller Seller
+	Buyer  Buyer
+}
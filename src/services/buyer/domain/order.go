@@ -1,23 +1,55 @@
 package domain
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
 	"gorm.io/datatypes"
 )
 
 const OrderDateFormat = "2006-01-02"
 
+const (
+	OrderSortNewest     = "newest"
+	OrderSortOldest     = "oldest"
+	OrderSortAmountAsc  = "amount"
+	OrderSortAmountDesc = "-amount"
+
+	DefaultOrderPageSize = 20
+)
+
+// OrderSortColumns, maps accepted sort options to their order by clause, id breaks ties so cursors are stable
+var OrderSortColumns = map[string]string{
+	OrderSortNewest:     "id DESC",
+	OrderSortOldest:     "id ASC",
+	OrderSortAmountAsc:  "amount_amount ASC, id ASC",
+	OrderSortAmountDesc: "amount_amount DESC, id ASC",
+}
+
 type Order struct {
 	yugabyte.Model
 
-	BuyerID      uint    `json:"buyer_id"`
-	Status       string  `json:"status"`
-	Amount       float64 `json:"amount"`
-	InvoiceNo    string  `json:"invoice_number"`
-	OrderDateStr string  `json:"order_date"`
+	BuyerID        uint        `json:"buyer_id"`
+	SellerID       uint        `json:"seller_id"`
+	Status         string      `json:"status"`
+	Amount         money.Money `json:"amount" gorm:"embedded;embeddedPrefix:amount_"`
+	RefundedAmount money.Money `json:"refunded_amount" gorm:"embedded;embeddedPrefix:refunded_amount_"`
+	// Discount, what the voucher took off the order, Amount is what the buyer pays after the discount
+	Discount     money.Money `json:"discount" gorm:"embedded;embeddedPrefix:discount_"`
+	VoucherID    *uint       `json:"voucher_id,omitempty"`
+	VoucherCode  string      `json:"voucher_code,omitempty"`
+	InvoiceNo    string      `json:"invoice_number" gorm:"uniqueIndex"`
+	OrderDateStr string      `json:"order_date"`
+	// AddressID, address book entry the order is shipped to, the address itself is copied to ShippingAddress
+	AddressID       *uint           `json:"address_id,omitempty"`
+	ShippingAddress ShippingAddress `json:"shipping_address" gorm:"embedded;embeddedPrefix:shipping_"`
 
 	OrderDate    datatypes.Date `json:"-"`
 	OrderDetails []OrderDetail  `json:"order_details,omitempty"`
+	Shipment     *Shipment      `json:"shipment,omitempty"`
 }
 
 type OrderDetail struct {
@@ -26,24 +58,127 @@ type OrderDetail struct {
 	Product         Product `json:"product"`
 	ProductQuantity int     `json:"product_quantity"`
 	OrderID         uint    `json:"order_id"`
+	// UnitPrice, snapshot of the product price when the order was placed, later price changes don't affect the order
+	UnitPrice money.Money `json:"unit_price" gorm:"embedded;embeddedPrefix:unit_price_"`
+	// RefundedQuantity, how many of the ordered products were refunded
+	RefundedQuantity int `json:"refunded_quantity"`
 }
 
-type Product struct {
-	yugabyte.Model
-	ProductName string  `json:"product_name"`
-	Price       float32 `json:"price"`
+// GrossAmount, the amount of the order before the voucher discount
+func (o Order) GrossAmount() money.Money {
+	if o.Discount.IsZero() {
+		return o.Amount
+	}
+	return money.New(o.Amount.Amount+o.Discount.Amount, o.Amount.Currency)
+}
+
+// RefundableQuantity, how many of the ordered products can still be refunded
+func (od OrderDetail) RefundableQuantity() int {
+	return od.ProductQuantity - od.RefundedQuantity
+}
+
+// RefundItem, quantity of an order item to refund
+type RefundItem struct {
+	OrderDetailID uint
+	ProductID     uint
+	Quantity      int
 }
 
 type PayloadEventOrder struct {
-	OrderID          int64   `json:"order_id"`
-	OrderDate        string  `json:"order_date"`
-	OrderStatus      int64   `json:"order_status"`
-	TotalRevenue     float64 `json:"total_revenue"`
-	TotalProductSold int64   `json:"total_product_sold"`
+	OrderID          int64       `json:"order_id"`
+	SellerID         int64       `json:"seller_id"`
+	BuyerID          int64       `json:"buyer_id"`
+	OrderDate        string      `json:"order_date"`
+	OrderStatus      int64       `json:"order_status"`
+	TotalRevenue     money.Money `json:"total_revenue"`
+	TotalProductSold int64       `json:"total_product_sold"`
+	// RefundedAmount and RefundedQuantity, what a refund event gives back, FirstRefund is set on the first
+	// refund of an order so refunded orders are only counted once
+	RefundedAmount   money.Money `json:"refunded_amount"`
+	RefundedQuantity int64       `json:"refunded_quantity"`
+	FirstRefund      bool        `json:"first_refund"`
+	// Discount and VoucherCode, what the voucher of the order took off TotalRevenue
+	Discount    money.Money `json:"discount"`
+	VoucherCode string      `json:"voucher_code,omitempty"`
+	// CategoryID, category most of the products of a new order belong to, zero when none has a category
+	CategoryID int64 `json:"category_id,omitempty"`
+}
+
+// OrderExpiryConfig, config of the automatic cancellation of orders left in status new
+type OrderExpiryConfig struct {
+	// TTL, how long after being placed a new order is cancelled, zero disables the expiry
+	TTL time.Duration
+	// Interval, how often expired orders are looked for
+	Interval time.Duration
+	// BatchSize, how many expired orders are loaded at once
+	BatchSize int
 }
 
-var UpdateOrderStatusVal = map[string]string{
-	"cancelled": "cancelled",
-	"completed": "completed",
+// OrderSummary, lightweight projection of an order for list views, without its details
+type OrderSummary struct {
+	ID             uint        `json:"id"`
+	SellerID       uint        `json:"seller_id"`
+	Status         string      `json:"status"`
+	Amount         money.Money `json:"amount" gorm:"embedded;embeddedPrefix:amount_"`
+	RefundedAmount money.Money `json:"refunded_amount" gorm:"embedded;embeddedPrefix:refunded_amount_"`
+	InvoiceNo      string      `json:"invoice_number"`
+	// ItemCount, total quantity of products ordered
+	ItemCount    int64     `json:"item_count"`
+	OrderDateStr string    `json:"order_date" gorm:"-"`
+	CreatedAt    time.Time `json:"created_at"`
+
+	OrderDate datatypes.Date `json:"-"`
+}
+
+// OrderFilter, filters, sorting and cursor pagination of an order listing, From and To are inclusive order dates
+type OrderFilter struct {
+	BuyerID uint
+	Status  string
+	From    *time.Time
+	To      *time.Time
+	Sort    string
+	After   *OrderCursor
+	Limit   int
+}
+
+// WithDefaults, returns the filter with the default sort and page size filled in when unset
+func (f OrderFilter) WithDefaults() OrderFilter {
+	if _, ok := OrderSortColumns[f.Sort]; !ok {
+		f.Sort = OrderSortNewest
+	}
+	if f.Limit < 1 {
+		f.Limit = DefaultOrderPageSize
+	}
+	return f
+}
+
+// OrderCursor, position of the last order of a page, the next page starts right after it
+type OrderCursor struct {
+	ID     uint  `json:"id"`
+	Amount int64 `json:"amount,omitempty"`
 }
 
+// NewOrderCursor, cursor positioned at order
+func NewOrderCursor(order OrderSummary) OrderCursor {
+	return OrderCursor{ID: order.ID, Amount: order.Amount.Amount}
+}
+
+// Encode, opaque representation of the cursor handed to clients
+func (c OrderCursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeOrderCursor, parses a cursor returned by Encode, fails with ErrInvalidCursor when it is malformed
+func DecodeOrderCursor(s string) (*OrderCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var c OrderCursor
+	if err := json.Unmarshal(b, &c); err != nil || c.ID == 0 {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+)
+
+const (
+	PaymentStatusPending   = "pending"
+	PaymentStatusSucceeded = "succeeded"
+	PaymentStatusFailed    = "failed"
+)
+
+// PaymentProviderFake, local payment provider settling payments through signed webhook calls only
+const PaymentProviderFake = "fake"
+
+// Payment, an attempt to pay an order at the payment provider, an order can have several failed payments but
+// only one succeeds
+type Payment struct {
+	yugabyte.Model
+	OrderID   uint        `json:"order_id" gorm:"index"`
+	Provider  string      `json:"provider"`
+	Reference string      `json:"reference" gorm:"uniqueIndex"`
+	Amount    money.Money `json:"amount" gorm:"embedded;embeddedPrefix:amount_"`
+	Status    string      `json:"status"`
+	SettledAt *time.Time  `json:"settled_at,omitempty"`
+}
+
+// PaymentIntent, payment created at the payment provider, Reference identifies it in the provider's webhooks
+type PaymentIntent struct {
+	Reference string
+}
+
+// PaymentNotification, outcome of a payment reported by the payment provider
+type PaymentNotification struct {
+	Reference string `json:"reference"`
+	Status    string `json:"status"`
+}
+
+// PaymentConfig, config of the payment provider
+type PaymentConfig struct {
+	// Provider, name of the payment provider, defaults to PaymentProviderFake
+	Provider string
+	// WebhookSecret, key the payment provider signs its webhook calls with
+	WebhookSecret string
+}
+
+// PayloadEventPayment, event published when a payment succeeded or failed, OrderDate is the date the order was
+// placed so the payment is reported along with the revenue of the order
+type PayloadEventPayment struct {
+	OrderID   int64       `json:"order_id"`
+	SellerID  int64       `json:"seller_id"`
+	Status    string      `json:"status"`
+	Amount    money.Money `json:"amount"`
+	OrderDate string      `json:"order_date"`
+}
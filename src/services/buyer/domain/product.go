@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+)
+
+const (
+	ProductSortNewest    = "newest"
+	ProductSortOldest    = "oldest"
+	ProductSortPriceAsc  = "price"
+	ProductSortPriceDesc = "-price"
+	ProductSortNameAsc   = "name"
+	ProductSortNameDesc  = "-name"
+
+	DefaultProductPageSize = 20
+)
+
+// ProductSortColumns, maps accepted sort options to their order by clause
+var ProductSortColumns = map[string]string{
+	ProductSortNewest:    "id DESC",
+	ProductSortOldest:    "id ASC",
+	ProductSortPriceAsc:  "price_amount ASC, id ASC",
+	ProductSortPriceDesc: "price_amount DESC, id ASC",
+	ProductSortNameAsc:   "product_name ASC, id ASC",
+	ProductSortNameDesc:  "product_name DESC, id ASC",
+}
+
+type Product struct {
+	yugabyte.Model
+	SellerID    uint           `json:"seller_id" gorm:"uniqueIndex:idx_products_seller_sku,where:deleted_at IS NULL"`
+	SKU         string         `json:"sku" gorm:"uniqueIndex:idx_products_seller_sku,where:deleted_at IS NULL"`
+	ProductName string         `json:"product_name"`
+	Description string         `json:"description,omitempty"`
+	Price       money.Money    `json:"price" gorm:"embedded;embeddedPrefix:price_"`
+	Stock       int            `json:"stock"`
+	CategoryID  *uint          `json:"category_id,omitempty"`
+	Category    *Category      `json:"category,omitempty"`
+	Images      []ProductImage `json:"images,omitempty"`
+}
+
+// InventoryConfig, config of the stock keeping of products
+type InventoryConfig struct {
+	// LowStockThreshold, a low stock event is published once a product's stock drops to or below it
+	LowStockThreshold int
+}
+
+// PayloadEventLowStock, event published when an order makes a product's stock run low
+type PayloadEventLowStock struct {
+	ProductID int64  `json:"product_id"`
+	SellerID  int64  `json:"seller_id"`
+	Stock     int64  `json:"stock"`
+	Date      string `json:"date"`
+}
+
+// ProductImage, metadata of an image of a product, the image itself is hosted elsewhere
+type ProductImage struct {
+	yugabyte.Model
+	ProductID uint   `json:"-"`
+	URL       string `json:"url"`
+	AltText   string `json:"alt_text,omitempty"`
+	Position  int    `json:"position"`
+}
+
+// Category, a product category
+type Category struct {
+	yugabyte.Model
+	Name string `json:"name" gorm:"uniqueIndex"`
+}
+
+// ProductFilter, filters, sorting and pagination of a product listing
+type ProductFilter struct {
+	Search     string
+	CategoryID uint
+	SellerID   uint
+	Sort       string
+	Page       int
+	PageSize   int
+}
+
+// WithDefaults, returns the filter with the first page and default page size filled in when unset
+func (f ProductFilter) WithDefaults() ProductFilter {
+	if f.Page < 1 {
+		f.Page = 1
+	}
+	if f.PageSize < 1 {
+		f.PageSize = DefaultProductPageSize
+	}
+	return f
+}
@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+)
+
+// Review, rating from 1 to 5 stars a buyer gave a product of one of the buyer's completed orders, a product is
+// reviewed at most once per order
+type Review struct {
+	yugabyte.Model
+	OrderID   uint   `json:"order_id" gorm:"uniqueIndex:idx_reviews_order_product"`
+	ProductID uint   `json:"product_id" gorm:"uniqueIndex:idx_reviews_order_product;index"`
+	BuyerID   uint   `json:"buyer_id" gorm:"index"`
+	SellerID  uint   `json:"seller_id" gorm:"index"`
+	Rating    int    `json:"rating"`
+	Comment   string `json:"comment"`
+}
+
+// PayloadEventReview, event published when a buyer reviews a product, Date is the day of the review
+type PayloadEventReview struct {
+	ReviewID  int64  `json:"review_id"`
+	OrderID   int64  `json:"order_id"`
+	ProductID int64  `json:"product_id"`
+	SellerID  int64  `json:"seller_id"`
+	Rating    int64  `json:"rating"`
+	Date      string `json:"date"`
+}
@@ -0,0 +1,9 @@
+package domain
+
+import "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+
+// Seller, represents a seller owning products
+type Seller struct {
+	yugabyte.Model
+	Name string `json:"name"`
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+)
+
+const (
+	ShipmentStatusShipped   = "shipped"
+	ShipmentStatusDelivered = "delivered"
+)
+
+// Shipment, delivery of an order by a courier
+type Shipment struct {
+	yugabyte.Model
+	OrderID        uint       `json:"order_id" gorm:"uniqueIndex"`
+	Courier        string     `json:"courier"`
+	TrackingNumber string     `json:"tracking_number"`
+	ShippedAt      time.Time  `json:"shipped_at"`
+	DeliveredAt    *time.Time `json:"delivered_at,omitempty"`
+}
+
+// ShippingConfig, config of the order fulfilment
+type ShippingConfig struct {
+	// ShippingSLA, how long after being placed an order must be shipped to count as shipped on time
+	ShippingSLA time.Duration
+}
+
+// PayloadEventShipment, event published when an order is shipped or delivered. LeadTimeSeconds is the time from
+// placing the order to the event, OnTime tells whether a shipped order was shipped within the shipping SLA
+type PayloadEventShipment struct {
+	OrderID         int64  `json:"order_id"`
+	SellerID        int64  `json:"seller_id"`
+	Status          string `json:"status"`
+	LeadTimeSeconds int64  `json:"lead_time_seconds"`
+	OnTime          bool   `json:"on_time"`
+	Date            string `json:"date"`
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+)
+
+const (
+	// VoucherTypePercentage, takes Percentage percent off the order
+	VoucherTypePercentage = "percentage"
+	// VoucherTypeFixed, takes Amount off the order
+	VoucherTypeFixed = "fixed"
+)
+
+// Voucher, promo code of a seller discounting an order of the seller's products placed between StartsAt and
+// EndsAt when the order reaches MinSpend, at most UsageLimit orders can use it, zero means no limit
+type Voucher struct {
+	yugabyte.Model
+	SellerID   uint        `json:"seller_id" gorm:"index"`
+	Code       string      `json:"code" gorm:"uniqueIndex"`
+	Type       string      `json:"type"`
+	Percentage int         `json:"percentage,omitempty"`
+	Amount     money.Money `json:"amount" gorm:"embedded;embeddedPrefix:amount_"`
+	MinSpend   money.Money `json:"min_spend" gorm:"embedded;embeddedPrefix:min_spend_"`
+	UsageLimit int         `json:"usage_limit"`
+	UsedCount  int         `json:"used_count"`
+	StartsAt   *time.Time  `json:"starts_at,omitempty"`
+	EndsAt     *time.Time  `json:"ends_at,omitempty"`
+}
+
+// NormalizeVoucherCode, voucher codes are case insensitive and stored in upper case
+func NormalizeVoucherCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// IsActive, whether the voucher can be used at
+func (v Voucher) IsActive(at time.Time) bool {
+	return (v.StartsAt == nil || !at.Before(*v.StartsAt)) && (v.EndsAt == nil || at.Before(*v.EndsAt))
+}
+
+// IsUsedUp, whether the voucher reached its usage limit
+func (v Voucher) IsUsedUp() bool {
+	return v.UsageLimit > 0 && v.UsedCount >= v.UsageLimit
+}
+
+// Discount, what the voucher takes off an order of amount, never more than amount
+func (v Voucher) Discount(amount money.Money) money.Money {
+	discount := v.Amount.Amount
+	if v.Type == VoucherTypePercentage {
+		discount = amount.Amount * int64(v.Percentage) / 100
+	}
+
+	if discount > amount.Amount {
+		discount = amount.Amount
+	}
+	return money.New(discount, amount.Currency)
+}
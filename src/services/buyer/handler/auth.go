@@ -41,3 +41,37 @@ func (h *handler) Auth() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// SellerAuth, add the middleware function guarding seller only endpoints
+func (h *handler) SellerAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		session := sessions.Default(c)
+		sellerIdRaw := session.Get(domain.SellerKey)
+		if sellerIdRaw == nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"errors": "no authentication found"})
+			return
+		}
+
+		sellerId, ok := sellerIdRaw.(uint)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"errors": "invalid cookie"})
+			return
+		}
+		valid, err := h.SellerUsecase.IsSellerAuthenticated(c.Request.Context(), sellerId)
+		if err != nil {
+			c.Error(err)
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"errors": err})
+			return
+		}
+
+		if !valid {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"errors": "request does not have valid authentication"})
+			return
+		}
+
+		// set seller key into context
+		c.Set(domain.SellerKey, sellerId)
+
+		c.Next()
+	}
+}
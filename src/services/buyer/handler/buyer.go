@@ -2,14 +2,17 @@ package handler
 
 import (
 	"errors"
+	"io"
 	"net/http"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	httpdomain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/http/domain"
+	httpgin "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/http/gin"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/validation"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/usecase"
 	"go.uber.org/fx"
@@ -19,51 +22,96 @@ import (
 type Handler interface {
 	Auth() gin.HandlerFunc
 	Login(ctx *gin.Context)
+	Profile(ctx *gin.Context)
+	UpdateProfile(ctx *gin.Context)
+	Deactivate(ctx *gin.Context)
+	Addresses(ctx *gin.Context)
+	CreateAddress(ctx *gin.Context)
+	UpdateAddress(ctx *gin.Context)
+	DeleteAddress(ctx *gin.Context)
 	Products(ctx *gin.Context)
 	ProductByID(ctx *gin.Context)
 	Orders(ctx *gin.Context)
 	OrderByID(ctx *gin.Context)
 	UpdateOrderStatus(ctx *gin.Context)
+	RefundOrder(ctx *gin.Context)
+	OrderInvoice(ctx *gin.Context)
+	ShipOrder(ctx *gin.Context)
+	DeliverOrder(ctx *gin.Context)
+	PayOrder(ctx *gin.Context)
+	PaymentWebhook(ctx *gin.Context)
+	CreateVoucher(ctx *gin.Context)
+	Vouchers(ctx *gin.Context)
+	CreateReview(ctx *gin.Context)
+	ProductReviews(ctx *gin.Context)
 	CreateOrder(ctx *gin.Context)
+	Cart(ctx *gin.Context)
+	AddCartItem(ctx *gin.Context)
+	UpdateCartItem(ctx *gin.Context)
+	RemoveCartItem(ctx *gin.Context)
+	Checkout(ctx *gin.Context)
+	SellerAuth() gin.HandlerFunc
+	SellerLogin(ctx *gin.Context)
+	CreateProduct(ctx *gin.Context)
+	UpdateProduct(ctx *gin.Context)
+	DeleteProduct(ctx *gin.Context)
+	Categories(ctx *gin.Context)
+	CreateCategory(ctx *gin.Context)
 }
 
 type handler struct {
-	BuyerUsecase usecase.BuyerUsecase
-	OrderUsecase usecase.OrderUsecase
+	BuyerUsecase    usecase.BuyerUsecase
+	OrderUsecase    usecase.OrderUsecase
+	CartUsecase     usecase.CartUsecase
+	ProductUsecase  usecase.ProductUsecase
+	SellerUsecase   usecase.SellerUsecase
+	InvoiceUsecase  usecase.InvoiceUsecase
+	ShipmentUsecase usecase.ShipmentUsecase
+	PaymentUsecase  usecase.PaymentUsecase
+	VoucherUsecase  usecase.VoucherUsecase
+	ReviewUsecase   usecase.ReviewUsecase
 }
 
 type Params struct {
 	fx.In
-	BuyerUsecase usecase.BuyerUsecase
-	OrderUsecase usecase.OrderUsecase
+	BuyerUsecase    usecase.BuyerUsecase
+	OrderUsecase    usecase.OrderUsecase
+	CartUsecase     usecase.CartUsecase
+	ProductUsecase  usecase.ProductUsecase
+	SellerUsecase   usecase.SellerUsecase
+	InvoiceUsecase  usecase.InvoiceUsecase
+	ShipmentUsecase usecase.ShipmentUsecase
+	PaymentUsecase  usecase.PaymentUsecase
+	VoucherUsecase  usecase.VoucherUsecase
+	ReviewUsecase   usecase.ReviewUsecase
 }
 
 func NewBuyerHandler(param Params) Handler {
 	return &handler{
-		BuyerUsecase: param.BuyerUsecase,
-		OrderUsecase: param.OrderUsecase,
+		BuyerUsecase:    param.BuyerUsecase,
+		OrderUsecase:    param.OrderUsecase,
+		CartUsecase:     param.CartUsecase,
+		ProductUsecase:  param.ProductUsecase,
+		SellerUsecase:   param.SellerUsecase,
+		InvoiceUsecase:  param.InvoiceUsecase,
+		ShipmentUsecase: param.ShipmentUsecase,
+		PaymentUsecase:  param.PaymentUsecase,
+		VoucherUsecase:  param.VoucherUsecase,
+		ReviewUsecase:   param.ReviewUsecase,
 	}
 }
 
 func (h *handler) Login(ctx *gin.Context) {
 	session := sessions.Default(ctx)
 	request := new(LoginRequest)
-	if err := ctx.Bind(request); err != nil {
-		ctx.Error(err)
-		ctx.JSON(http.StatusBadRequest,
-			LoginResponse{
-				Error: "invalid body type",
-			})
+	if err := ctx.ShouldBind(request); err != nil {
+		httpgin.AbortWithBindError[domain.Buyer](ctx, err)
 		return
 	}
 
 	res, err := h.BuyerUsecase.Login(ctx, request.Username)
 	if err != nil {
-		ctx.Error(err)
-		ctx.JSON(http.StatusInternalServerError,
-			LoginResponse{
-				Error: "something happened on our end, please try at a later time",
-			})
+		abortWithError[domain.Buyer](ctx, err)
 		return
 	}
 
@@ -78,12 +126,22 @@ func (h *handler) Login(ctx *gin.Context) {
 
 // Products
 func (h *handler) Products(ctx *gin.Context) {
-	var (
-		res []domain.Product
-		err error
-	)
+	request := new(GetProductsRequest)
+	if err := ctx.ShouldBindQuery(request); err != nil {
+		httpgin.AbortWithBindError[[]domain.Product](ctx, err)
+		return
+	}
 
-	res, err = h.OrderUsecase.Products(ctx)
+	filter := domain.ProductFilter{
+		Search:     request.Search,
+		CategoryID: request.CategoryID,
+		SellerID:   request.SellerID,
+		Sort:       request.Sort,
+		Page:       request.Page,
+		PageSize:   request.PageSize,
+	}.WithDefaults()
+
+	res, total, err := h.OrderUsecase.Products(ctx, filter)
 	if err != nil {
 		ctx.Error(err)
 		ctx.JSON(http.StatusInternalServerError, ProductsResponse{
@@ -94,6 +152,11 @@ func (h *handler) Products(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, ProductsResponse{
 		Data: &res,
+		Meta: &httpdomain.PageMeta{
+			Page:     filter.Page,
+			PageSize: filter.PageSize,
+			Total:    total,
+		},
 	})
 }
 
@@ -131,53 +194,118 @@ func (h *handler) ProductByID(ctx *gin.Context) {
 
 // Orders
 func (h *handler) Orders(ctx *gin.Context) {
-	var (
-		res []domain.Order
-		err error
-	)
+	request := new(GetOrdersRequest)
+	if err := ctx.ShouldBindQuery(request); err != nil {
+		httpgin.AbortWithBindError[[]domain.OrderSummary](ctx, err)
+		return
+	}
 
 	session := sessions.Default(ctx)
 	buyerId := session.Get(domain.BuyerKey).(uint)
 
-	res, err = h.OrderUsecase.OrdersByBuyer(ctx, buyerId)
+	filter, err := request.toFilter(buyerId)
 	if err != nil {
-		ctx.Error(err)
-		ctx.JSON(http.StatusInternalServerError, OrdersResponse{
-			Error: "something happened on our end, please try at a later time",
-		})
+		abortWithError[[]domain.OrderSummary](ctx, err)
+		return
+	}
+
+	res, next, err := h.OrderUsecase.OrdersByBuyer(ctx, filter)
+	if err != nil {
+		abortWithError[[]domain.OrderSummary](ctx, err)
 		return
 	}
 
-	ctx.JSON(http.StatusOK, OrdersResponse{
+	ctx.JSON(http.StatusOK, OrderSummariesResponse{
 		Data: &res,
+		Cursor: &httpdomain.CursorMeta{
+			Limit:      filter.Limit,
+			NextCursor: next,
+		},
 	})
 }
 
+// toFilter, converts the request into an order filter of the buyer, invalid dates and cursors are reported
+// as validation error
+func (r *GetOrdersRequest) toFilter(buyerId uint) (domain.OrderFilter, error) {
+	filter := domain.OrderFilter{
+		BuyerID: buyerId,
+		Status:  r.Status,
+		Sort:    r.Sort,
+		Limit:   r.Limit,
+	}
+
+	// the dates were validated when binding
+	if r.From != "" {
+		from, _ := time.Parse(domain.OrderDateFormat, r.From)
+		filter.From = &from
+	}
+	if r.To != "" {
+		to, _ := time.Parse(domain.OrderDateFormat, r.To)
+		filter.To = &to
+	}
+	if filter.From != nil && filter.To != nil && filter.To.Before(*filter.From) {
+		return filter, validation.NewError("to", "must not be before from")
+	}
+
+	if r.Cursor != "" {
+		after, err := domain.DecodeOrderCursor(r.Cursor)
+		if err != nil {
+			return filter, validation.NewError("cursor", "is invalid, pass the next_cursor of a previous page")
+		}
+		filter.After = after
+	}
+
+	return filter.WithDefaults(), nil
+}
+
 // UpdateOrder
 func (h *handler) UpdateOrderStatus(ctx *gin.Context) {
 	request := new(UpdateOrderRequest)
-	if err := ctx.Bind(request); err != nil {
+	if err := ctx.ShouldBind(request); err != nil {
+		httpgin.AbortWithBindError[domain.Order](ctx, err)
+		return
+	}
+
+	res, err := h.OrderUsecase.UpdateOrderStatus(ctx, request.ID, request.Status)
+	if err != nil {
+		abortWithError[domain.Order](ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, OrderResponse{
+		Data: res,
+	})
+}
+
+// RefundOrder, refunds items of a completed order of the buyer
+func (h *handler) RefundOrder(ctx *gin.Context) {
+	orderId, err := strconv.ParseUint(ctx.Param("id"), 10, 0)
+	if err != nil {
 		ctx.Error(err)
 		ctx.JSON(http.StatusBadRequest, OrderResponse{
-			Error: "invalid body type",
+			Error: "please pass order id to path",
 		})
 		return
 	}
 
-	if _, ok := domain.UpdateOrderStatusVal[request.Status]; !ok {
-		ctx.Error(errors.New("unexpected status value, accepted status value is 'completed' and 'cancelled'"))
-		ctx.JSON(http.StatusBadRequest, OrderResponse{
-			Error: "unexpected status value, accepted status value is 'completed' and 'cancelled'",
-		})
+	// an empty body refunds the whole order
+	request := new(RefundOrderRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil && !errors.Is(err, io.EOF) {
+		httpgin.AbortWithBindError[domain.Order](ctx, err)
 		return
 	}
 
-	res, err := h.OrderUsecase.UpdateOrderStatus(ctx, request.ID, request.Status)
-	if err != nil {
-		ctx.Error(err)
-		ctx.JSON(http.StatusInternalServerError, OrderResponse{
-			Error: "something happened on our end, please try at a later time",
+	items := make([]domain.RefundItem, 0, len(request.Items))
+	for _, v := range request.Items {
+		items = append(items, domain.RefundItem{
+			OrderDetailID: v.OrderDetailID,
+			Quantity:      v.Quantity,
 		})
+	}
+
+	res, err := h.OrderUsecase.RefundOrder(ctx, uint(orderId), items)
+	if err != nil {
+		abortWithError[domain.Order](ctx, err)
 		return
 	}
 
@@ -193,11 +321,8 @@ func (h *handler) CreateOrder(ctx *gin.Context) {
 	)
 
 	request := new(CreateOrderRequest)
-	if err := ctx.Bind(request); err != nil {
-		ctx.Error(err)
-		ctx.JSON(http.StatusBadRequest, OrderResponse{
-			Error: "invalid body type",
-		})
+	if err := ctx.ShouldBind(request); err != nil {
+		httpgin.AbortWithBindError[domain.Order](ctx, err)
 		return
 	}
 
@@ -224,23 +349,14 @@ func (h *handler) CreateOrder(ctx *gin.Context) {
 		OrderDate:    datatypes.Date(time.Now()),
 		BuyerID:      buyerId,
 		Status:       domain.OrderStatusNew,
-		InvoiceNo:    "INV-" + time.Now().Format("20060102150405"),
+		AddressID:    request.AddressID,
+		VoucherCode:  request.VoucherCode,
 		OrderDetails: orderDetails,
 	}
 
 	res, err := h.OrderUsecase.CreateOrder(ctx, order)
 	if err != nil {
-		if strings.Contains(err.Error(), "ongoing order") {
-			ctx.JSON(http.StatusBadRequest, OrderResponse{
-				Error: "cannot create order, theres an ongoing order",
-			})
-		} else {
-			ctx.Error(err)
-			ctx.JSON(http.StatusInternalServerError, OrderResponse{
-				Error: "something happened on our end, please try at a later time : " + err.Error(),
-			})
-		}
-
+		abortWithError[domain.Order](ctx, err)
 		return
 	}
 
@@ -269,10 +385,7 @@ func (h *handler) OrderByID(ctx *gin.Context) {
 
 	res, err := h.OrderUsecase.OrderByID(ctx, uint(parsedId))
 	if err != nil {
-		ctx.Error(err)
-		ctx.JSON(http.StatusInternalServerError, OrderResponse{
-			Error: "something happened on our end, please try at a later time",
-		})
+		abortWithError[domain.Order](ctx, err)
 		return
 	}
 
@@ -280,3 +393,42 @@ func (h *handler) OrderByID(ctx *gin.Context) {
 		Data: res,
 	})
 }
+
+// abortWithError, responds to a request whose usecase failed, mapping domain errors to their status code
+func abortWithError[T any](ctx *gin.Context, err error) {
+	if verr, ok := validation.AsError(err); ok {
+		ctx.JSON(http.StatusUnprocessableEntity, httpdomain.ResponseModel[T]{
+			Error:  "invalid request",
+			Errors: verr.Fields,
+		})
+		return
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		ctx.JSON(http.StatusNotFound, httpdomain.ResponseModel[T]{
+			Error: err.Error(),
+		})
+	case errors.Is(err, domain.ErrInvalidSignature):
+		ctx.JSON(http.StatusUnauthorized, httpdomain.ResponseModel[T]{
+			Error: err.Error(),
+		})
+	case errors.Is(err, domain.ErrInvalidWebhook):
+		ctx.JSON(http.StatusBadRequest, httpdomain.ResponseModel[T]{
+			Error: err.Error(),
+		})
+	case errors.Is(err, domain.ErrForbidden):
+		ctx.JSON(http.StatusForbidden, httpdomain.ResponseModel[T]{
+			Error: err.Error(),
+		})
+	case errors.Is(err, domain.ErrInsufficientStock), errors.Is(err, domain.ErrVoucherUsedUp), errors.Is(err, domain.ErrInvalidOrderStatus), errors.Is(err, domain.ErrInvalidRefund), errors.Is(err, domain.ErrAlreadyReviewed):
+		ctx.JSON(http.StatusConflict, httpdomain.ResponseModel[T]{
+			Error: err.Error(),
+		})
+	default:
+		ctx.Error(err)
+		ctx.JSON(http.StatusInternalServerError, httpdomain.ResponseModel[T]{
+			Error: "something happened on our end, please try at a later time",
+		})
+	}
+}
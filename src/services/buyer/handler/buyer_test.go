@@ -13,6 +13,9 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	httpdomain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/http/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/validation"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/usecase"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/usecase/mocks"
@@ -66,6 +69,28 @@ func TestHandler_Login(t *testing.T) {
 				Error: "invalid body type",
 			},
 		},
+		{
+			name:     "validation error",
+			wantCode: http.StatusUnprocessableEntity,
+			request: func() *http.Request {
+				breq, _ := json.Marshal(LoginRequest{})
+				req, _ := http.NewRequest(http.MethodPost, "/buyer/login", bytes.NewReader(breq))
+				req.Header.Set("Content-Type", "application/json")
+				return req
+			},
+			usecase: func() usecase.BuyerUsecase {
+				return mocks.NewMockBuyerUsecase(ctrl)
+			},
+			want: LoginResponse{
+				Error: "invalid request",
+				Errors: []validation.FieldError{
+					{
+						Field:   "username",
+						Message: "is required",
+					},
+				},
+			},
+		},
 		{
 			name:     "login error",
 			wantCode: http.StatusInternalServerError,
@@ -157,13 +182,71 @@ func Test_handler_Products(t *testing.T) {
 			},
 			usecase: func() usecase.OrderUsecase {
 				m := mocks.NewMockOrderUsecase(ctrl)
-				m.EXPECT().Products(gomock.Any()).Return([]domain.Product{}, errors.New("expected error")).Times(1)
+				m.EXPECT().Products(gomock.Any(), gomock.Any()).Return([]domain.Product{}, int64(0), errors.New("expected error")).Times(1)
 				return m
 			},
 			want: ProductsResponse{
 				Error: "something happened on our end, please try at a later time",
 			},
 		},
+		{
+			name:     "success",
+			wantCode: http.StatusOK,
+			request: func() *http.Request {
+				req, _ := http.NewRequest(http.MethodGet, "/products/?q=product&sort=-price&page=2&page_size=1", nil)
+				return req
+			},
+			usecase: func() usecase.OrderUsecase {
+				m := mocks.NewMockOrderUsecase(ctrl)
+				m.EXPECT().Products(gomock.Any(), domain.ProductFilter{
+					Search:   "product",
+					Sort:     domain.ProductSortPriceDesc,
+					Page:     2,
+					PageSize: 1,
+				}).Return([]domain.Product{
+					{
+						Model:       yugabyte.Model{ID: 1},
+						ProductName: "Product 1",
+						Price:       money.New(100, "IDR"),
+					},
+				}, int64(2), nil).Times(1)
+				return m
+			},
+			want: ProductsResponse{
+				Data: &[]domain.Product{
+					{
+						Model:       yugabyte.Model{ID: 1},
+						ProductName: "Product 1",
+						Price:       money.New(100, "IDR"),
+					},
+				},
+				Meta: &httpdomain.PageMeta{
+					Page:     2,
+					PageSize: 1,
+					Total:    2,
+				},
+			},
+		},
+		{
+			name:     "invalid sort",
+			wantCode: http.StatusUnprocessableEntity,
+			request: func() *http.Request {
+				req, _ := http.NewRequest(http.MethodGet, "/products/?sort=random", nil)
+				return req
+			},
+			usecase: func() usecase.OrderUsecase {
+				return mocks.NewMockOrderUsecase(ctrl)
+			},
+			want: ProductsResponse{
+				Error: "invalid request",
+				Errors: []validation.FieldError{
+					{
+						Field:   "sort",
+						Message: "must be one of [newest oldest price -price name -name]",
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -212,7 +295,7 @@ func Test_handler_ProductByID(t *testing.T) {
 						ID: 1,
 					},
 					ProductName: "Product 1",
-					Price:       100,
+					Price:       money.New(100, "IDR"),
 				}
 				m.EXPECT().ProductByID(gomock.Any(), gomock.Any()).Return(resp, nil).Times(1)
 				return m
@@ -222,7 +305,7 @@ func Test_handler_ProductByID(t *testing.T) {
 				Data: &domain.Product{
 					Model:       yugabyte.Model{ID: 1},
 					ProductName: "Product 1",
-					Price:       100,
+					Price:       money.New(100, "IDR"),
 				},
 			},
 		},
@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	httpgin "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/http/gin"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+// Cart
+func (h *handler) Cart(ctx *gin.Context) {
+	session := sessions.Default(ctx)
+	buyerId := session.Get(domain.BuyerKey).(uint)
+
+	res, err := h.CartUsecase.Cart(ctx, buyerId)
+	if err != nil {
+		ctx.Error(err)
+		ctx.JSON(http.StatusInternalServerError, CartResponse{
+			Error: "something happened on our end, please try at a later time",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, CartResponse{
+		Data: res,
+	})
+}
+
+// AddCartItem
+func (h *handler) AddCartItem(ctx *gin.Context) {
+	request := new(AddCartItemRequest)
+	if err := ctx.ShouldBind(request); err != nil {
+		httpgin.AbortWithBindError[domain.Cart](ctx, err)
+		return
+	}
+
+	session := sessions.Default(ctx)
+	buyerId := session.Get(domain.BuyerKey).(uint)
+
+	res, err := h.CartUsecase.AddItem(ctx, buyerId, request.ProductID, request.Quantity)
+	writeCartResponse(ctx, res, err)
+}
+
+// UpdateCartItem
+func (h *handler) UpdateCartItem(ctx *gin.Context) {
+	productId, err := strconv.ParseUint(ctx.Param("product_id"), 10, 0)
+	if err != nil {
+		ctx.Error(err)
+		ctx.JSON(http.StatusBadRequest, CartResponse{
+			Error: "please pass a valid product id",
+		})
+		return
+	}
+
+	request := new(UpdateCartItemRequest)
+	if err := ctx.ShouldBind(request); err != nil {
+		httpgin.AbortWithBindError[domain.Cart](ctx, err)
+		return
+	}
+
+	session := sessions.Default(ctx)
+	buyerId := session.Get(domain.BuyerKey).(uint)
+
+	res, err := h.CartUsecase.UpdateItem(ctx, buyerId, uint(productId), request.Quantity)
+	writeCartResponse(ctx, res, err)
+}
+
+// RemoveCartItem
+func (h *handler) RemoveCartItem(ctx *gin.Context) {
+	productId, err := strconv.ParseUint(ctx.Param("product_id"), 10, 0)
+	if err != nil {
+		ctx.Error(err)
+		ctx.JSON(http.StatusBadRequest, CartResponse{
+			Error: "please pass a valid product id",
+		})
+		return
+	}
+
+	session := sessions.Default(ctx)
+	buyerId := session.Get(domain.BuyerKey).(uint)
+
+	res, err := h.CartUsecase.RemoveItem(ctx, buyerId, uint(productId))
+	writeCartResponse(ctx, res, err)
+}
+
+// Checkout
+func (h *handler) Checkout(ctx *gin.Context) {
+	// an empty body ships to the default address without a voucher
+	request := new(CheckoutRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil && !errors.Is(err, io.EOF) {
+		httpgin.AbortWithBindError[[]domain.Order](ctx, err)
+		return
+	}
+
+	session := sessions.Default(ctx)
+	buyerId := session.Get(domain.BuyerKey).(uint)
+
+	res, err := h.CartUsecase.Checkout(ctx, buyerId, request.AddressID, request.VoucherCode)
+	if err != nil {
+		abortWithError[[]domain.Order](ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, OrdersResponse{
+		Data: &res,
+	})
+}
+
+// writeCartResponse, writes the result of a cart mutation
+func writeCartResponse(ctx *gin.Context, res *domain.Cart, err error) {
+	if err != nil {
+		abortWithError[domain.Cart](ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, CartResponse{
+		Data: res,
+	})
+}
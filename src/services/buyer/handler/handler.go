@@ -3,19 +3,24 @@ package handler
 import (
 	"github.com/gin-gonic/gin"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/http/gin/middleware"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/validation"
 	"go.uber.org/fx"
 
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-contrib/sessions/cookie"
 )
 
-var Module = fx.Provide(
-	NewBuyerHandler,
-	ProvideGinEngine,
+var Module = fx.Options(
+	fx.Provide(
+		NewBuyerHandler,
+		ProvideGinEngine,
+	),
+	fx.Invoke(ScheduleOrderExpiry),
 )
 
 func ProvideGinEngine(handler Handler) *gin.Engine {
 	router := gin.Default()
+	validation.UseJSONFieldNames()
 
 	store := cookie.NewStore([]byte("secret"))
 	router.Use(sessions.Sessions("sha_session", store))
@@ -24,15 +29,52 @@ func ProvideGinEngine(handler Handler) *gin.Engine {
 
 	buyer.POST("/login", handler.Login)
 	buyer.GET("/orders", handler.Auth(), handler.Orders)
+	buyer.GET("/profile", handler.Auth(), handler.Profile)
+	buyer.PUT("/profile", handler.Auth(), handler.UpdateProfile)
+	buyer.DELETE("/account", handler.Auth(), handler.Deactivate)
+	buyer.GET("/addresses", handler.Auth(), handler.Addresses)
+	buyer.POST("/addresses", handler.Auth(), handler.CreateAddress)
+	buyer.PUT("/addresses/:id", handler.Auth(), handler.UpdateAddress)
+	buyer.DELETE("/addresses/:id", handler.Auth(), handler.DeleteAddress)
 
 	orders := router.Group("/orders", handler.Auth())
 	orders.GET("/:id", handler.OrderByID)
 	orders.POST("/", handler.CreateOrder)
 	orders.PUT("/status", handler.UpdateOrderStatus)
+	orders.POST("/:id/refund", handler.RefundOrder)
+	orders.GET("/:id/invoice", handler.OrderInvoice)
+	orders.POST("/:id/pay", handler.PayOrder)
+	orders.POST("/:id/reviews", handler.CreateReview)
+
+	// called by the payment provider, authenticated by the signature of the payload
+	router.POST("/payments/webhook", handler.PaymentWebhook)
+
+	cart := router.Group("/cart", handler.Auth())
+	cart.GET("/", handler.Cart)
+	cart.POST("/items", handler.AddCartItem)
+	cart.PUT("/items/:product_id", handler.UpdateCartItem)
+	cart.DELETE("/items/:product_id", handler.RemoveCartItem)
+	cart.POST("/checkout", handler.Checkout)
+
+	seller := router.Group("/seller")
+	seller.POST("/login", handler.SellerLogin)
+	seller.GET("/orders/:id/invoice", handler.SellerAuth(), handler.OrderInvoice)
+	seller.POST("/orders/:id/ship", handler.SellerAuth(), handler.ShipOrder)
+	seller.POST("/orders/:id/deliver", handler.SellerAuth(), handler.DeliverOrder)
+	seller.GET("/vouchers", handler.SellerAuth(), handler.Vouchers)
+	seller.POST("/vouchers", handler.SellerAuth(), handler.CreateVoucher)
 
 	products := router.Group("/products")
 	products.GET("/", handler.Products)
 	products.GET("/:id", handler.ProductByID)
+	products.GET("/:id/reviews", handler.ProductReviews)
+	products.POST("/", handler.SellerAuth(), handler.CreateProduct)
+	products.PUT("/:id", handler.SellerAuth(), handler.UpdateProduct)
+	products.DELETE("/:id", handler.SellerAuth(), handler.DeleteProduct)
+
+	categories := router.Group("/categories")
+	categories.GET("/", handler.Categories)
+	categories.POST("/", handler.SellerAuth(), handler.CreateCategory)
 
 	router.Use(middleware.LogErrors())
 
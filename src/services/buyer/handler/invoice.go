@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	httpgin "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/http/gin"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/pdf"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+//go:embed templates/invoice.html
+var invoiceHTML string
+
+var invoiceTemplate = template.Must(template.New("invoice").Funcs(template.FuncMap{
+	"subtotal": subtotal,
+}).Parse(invoiceHTML))
+
+// invoice layout in points
+const (
+	invoiceMargin     = 50.0
+	invoiceLineHeight = 16.0
+	invoiceFontSize   = 10.0
+)
+
+// OrderInvoice, renders the invoice of an order as html or pdf, available to the buyer and the seller of the order
+func (h *handler) OrderInvoice(ctx *gin.Context) {
+	orderId, err := strconv.ParseUint(ctx.Param("id"), 10, 0)
+	if err != nil {
+		ctx.Error(err)
+		ctx.JSON(http.StatusBadRequest, OrderResponse{
+			Error: "please pass order id to path",
+		})
+		return
+	}
+
+	request := new(GetInvoiceRequest)
+	if err := ctx.ShouldBindQuery(request); err != nil {
+		httpgin.AbortWithBindError[domain.Order](ctx, err)
+		return
+	}
+
+	invoice, err := h.InvoiceUsecase.Invoice(ctx, uint(orderId))
+	if err != nil {
+		abortWithError[domain.Order](ctx, err)
+		return
+	}
+
+	var (
+		buf         bytes.Buffer
+		contentType = "text/html; charset=utf-8"
+	)
+	if request.Format == domain.InvoiceFormatPDF {
+		contentType = "application/pdf"
+		_, err = renderInvoicePDF(*invoice).WriteTo(&buf)
+		ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", invoiceFileName(invoice.Order)))
+	} else {
+		err = invoiceTemplate.Execute(&buf, invoice)
+	}
+	if err != nil {
+		abortWithError[domain.Order](ctx, err)
+		return
+	}
+
+	ctx.Data(http.StatusOK, contentType, buf.Bytes())
+}
+
+// renderInvoicePDF, lays out the invoice on A4 pages, line items continue on a new page when one is full
+func renderInvoicePDF(invoice domain.Invoice) *pdf.Document {
+	doc := pdf.New()
+	order := invoice.Order
+	right := pdf.PageWidth - invoiceMargin
+	y := invoiceMargin + 20
+
+	doc.Text(pdf.Bold, 20, invoiceMargin, y, "Invoice")
+	y += 2 * invoiceLineHeight
+	for _, line := range []string{
+		"Invoice number: " + order.InvoiceNo,
+		"Order ID: " + strconv.FormatUint(uint64(order.ID), 10),
+		"Order date: " + order.OrderDateStr,
+		"Status: " + order.Status,
+		"Seller: " + invoice.Seller.Name,
+		"Billed to: " + invoice.Buyer.Username,
+	} {
+		doc.Text(pdf.Regular, invoiceFontSize, invoiceMargin, y, line)
+		y += invoiceLineHeight
+	}
+
+	// columns, amounts are right aligned to the end of their column
+	qtyEnd, priceEnd := right-220.0, right-110.0
+	header := func() {
+		y += invoiceLineHeight
+		doc.Text(pdf.Bold, invoiceFontSize, invoiceMargin, y, "Product")
+		rightText(doc, pdf.Bold, qtyEnd, y, "Qty")
+		rightText(doc, pdf.Bold, priceEnd, y, "Unit price")
+		rightText(doc, pdf.Bold, right, y, "Subtotal")
+		y += 4
+		doc.Line(invoiceMargin, y, right, y)
+		y += invoiceLineHeight
+	}
+	header()
+
+	for _, od := range order.OrderDetails {
+		if y > pdf.PageHeight-invoiceMargin-4*invoiceLineHeight {
+			doc.AddPage()
+			y = invoiceMargin
+			header()
+		}
+		doc.Text(pdf.Regular, invoiceFontSize, invoiceMargin, y, truncate(od.Product.ProductName, 40))
+		rightText(doc, pdf.Mono, qtyEnd, y, strconv.Itoa(od.ProductQuantity))
+		rightText(doc, pdf.Mono, priceEnd, y, od.UnitPrice.String())
+		rightText(doc, pdf.Mono, right, y, subtotal(od).String())
+		y += invoiceLineHeight
+	}
+
+	doc.Line(invoiceMargin, y-invoiceLineHeight+4, right, y-invoiceLineHeight+4)
+	y += 4
+	if !order.Discount.IsZero() {
+		doc.Text(pdf.Bold, invoiceFontSize, invoiceMargin, y, "Discount "+order.VoucherCode)
+		rightText(doc, pdf.Mono, right, y, "-"+order.Discount.String())
+		y += invoiceLineHeight
+	}
+	doc.Text(pdf.Bold, invoiceFontSize, invoiceMargin, y, "Total")
+	rightText(doc, pdf.Mono, right, y, order.Amount.String())
+	if !order.RefundedAmount.IsZero() {
+		y += invoiceLineHeight
+		doc.Text(pdf.Bold, invoiceFontSize, invoiceMargin, y, "Refunded")
+		rightText(doc, pdf.Mono, right, y, order.RefundedAmount.String())
+	}
+
+	return doc
+}
+
+// rightText, draws s so it ends at x
+func rightText(doc *pdf.Document, font pdf.Font, x, y float64, s string) {
+	doc.Text(font, invoiceFontSize, x-pdf.MonoWidth(invoiceFontSize, s), y, s)
+}
+
+// subtotal, price of a line item at the price snapshot of the order
+func subtotal(od domain.OrderDetail) money.Money {
+	return od.UnitPrice.Mul(int64(od.ProductQuantity))
+}
+
+// truncate, shortens s to at most n characters so long product names don't run into the amounts
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-3]) + "..."
+}
+
+// invoiceFileName, file name of the pdf invoice e.g. INV-12-2026-000123.pdf
+func invoiceFileName(order domain.Order) string {
+	name := order.InvoiceNo
+	if name == "" {
+		name = "invoice-" + strconv.FormatUint(uint64(order.ID), 10)
+	}
+	return strings.ReplaceAll(name, "/", "-") + ".pdf"
+}
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+func testInvoice(items int) domain.Invoice {
+	details := make([]domain.OrderDetail, 0, items)
+	for i := 0; i < items; i++ {
+		details = append(details, domain.OrderDetail{
+			Product:         domain.Product{ProductName: "Kopi <Arabika>", SKU: "KOPI-1"},
+			ProductQuantity: 2,
+			UnitPrice:       money.New(150000, "IDR"),
+		})
+	}
+
+	return domain.Invoice{
+		Order: domain.Order{
+			Model:          yugabyte.Model{ID: 1},
+			BuyerID:        1,
+			SellerID:       2,
+			Status:         domain.OrderStatusPartiallyRefunded,
+			Amount:         money.New(270000, "IDR"),
+			RefundedAmount: money.New(150000, "IDR"),
+			Discount:       money.New(30000, "IDR"),
+			VoucherCode:    "KOPI10",
+			InvoiceNo:      "INV/2/2022/000001",
+			OrderDateStr:   "2022-01-01",
+			OrderDetails:   details,
+		},
+		Seller: domain.Seller{Name: "Toko Kopi"},
+		Buyer:  domain.Buyer{Username: "buyer"},
+	}
+}
+
+func Test_invoiceTemplate(t *testing.T) {
+	var buf bytes.Buffer
+	err := invoiceTemplate.Execute(&buf, testInvoice(1))
+	assert.NoError(t, err)
+
+	html := buf.String()
+	assert.Contains(t, html, "INV/2/2022/000001")
+	assert.Contains(t, html, "Toko Kopi")
+	assert.Contains(t, html, "Kopi &lt;Arabika&gt;")
+	assert.Contains(t, html, money.New(150000, "IDR").String())
+	assert.Contains(t, html, money.New(300000, "IDR").String())
+	assert.Contains(t, html, "Discount KOPI10")
+	assert.Contains(t, html, money.New(270000, "IDR").String())
+	assert.Contains(t, html, "Refunded")
+}
+
+func Test_renderInvoicePDF(t *testing.T) {
+	tests := []struct {
+		name      string
+		items     int
+		wantPages int
+	}{
+		{
+			name:      "single page",
+			items:     3,
+			wantPages: 1,
+		},
+		{
+			name:      "items continue on the next page",
+			items:     60,
+			wantPages: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := renderInvoicePDF(testInvoice(tt.items))
+			assert.Equal(t, tt.wantPages, doc.Pages())
+
+			var buf bytes.Buffer
+			_, err := doc.WriteTo(&buf)
+			assert.NoError(t, err)
+			assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")))
+			assert.Contains(t, buf.String(), "(Invoice number: INV/2/2022/000001)")
+		})
+	}
+}
+
+func Test_invoiceFileName(t *testing.T) {
+	assert.Equal(t, "INV-2-2022-000001.pdf", invoiceFileName(domain.Order{InvoiceNo: "INV/2/2022/000001"}))
+	assert.Equal(t, "invoice-7.pdf", invoiceFileName(domain.Order{Model: yugabyte.Model{ID: 7}}))
+}
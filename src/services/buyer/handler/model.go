@@ -1,35 +1,175 @@
 package handler
 
 import (
+	"time"
+
 	httpdomain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/http/domain"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
 )
 
 type LoginRequest struct {
-	Username string
+	Username string `json:"username" binding:"required"`
 }
 type LoginResponse = httpdomain.ResponseModel[domain.Buyer]
 
+// UpdateProfileRequest, profile of the buyer, omitted fields are cleared
+type UpdateProfileRequest struct {
+	Name  string `json:"name" binding:"max=255"`
+	Email string `json:"email" binding:"omitempty,email,max=255"`
+	Phone string `json:"phone" binding:"max=32"`
+}
+type ProfileResponse = httpdomain.ResponseModel[domain.Buyer]
+
+// AddressRequest, an entry of the buyer's address book
+type AddressRequest struct {
+	Label         string `json:"label" binding:"max=50"`
+	RecipientName string `json:"recipient_name" binding:"required,max=255"`
+	Phone         string `json:"phone" binding:"required,max=32"`
+	Street        string `json:"street" binding:"required,max=500"`
+	City          string `json:"city" binding:"required,max=100"`
+	Province      string `json:"province" binding:"max=100"`
+	PostalCode    string `json:"postal_code" binding:"required,max=16"`
+	Country       string `json:"country" binding:"required,len=2,alpha"`
+	IsDefault     bool   `json:"is_default"`
+}
+type AddressResponse = httpdomain.ResponseModel[domain.Address]
+type AddressesResponse = httpdomain.ResponseModel[[]domain.Address]
+
 type GetProductsRequest struct {
-	Id int64
+	Search     string `form:"q"`
+	CategoryID uint   `form:"category_id"`
+	SellerID   uint   `form:"seller_id"`
+	Sort       string `form:"sort" binding:"omitempty,oneof=newest oldest price -price name -name"`
+	Page       int    `form:"page" binding:"omitempty,gte=1"`
+	PageSize   int    `form:"page_size" binding:"omitempty,gte=1,lte=100"`
 }
 
+// ProductRequest, the price is in minor units of the currency e.g. cents
+type ProductRequest struct {
+	SKU         string                `json:"sku" binding:"required,max=64"`
+	ProductName string                `json:"product_name" binding:"required,max=255"`
+	Description string                `json:"description" binding:"max=5000"`
+	Price       int64                 `json:"price" binding:"gt=0"`
+	Currency    string                `json:"currency" binding:"omitempty,len=3,alpha"`
+	Stock       int                   `json:"stock" binding:"gte=0"`
+	CategoryID  *uint                 `json:"category_id" binding:"omitempty,gt=0"`
+	Images      []ProductImageRequest `json:"images" binding:"max=10,dive"`
+}
+
+type ProductImageRequest struct {
+	URL     string `json:"url" binding:"required,url"`
+	AltText string `json:"alt_text" binding:"max=255"`
+}
+
+type CategoryRequest struct {
+	Name string `json:"name" binding:"required,max=100"`
+}
+
+type SellerLoginRequest struct {
+	Name string `json:"name" binding:"required"`
+}
+type SellerLoginResponse = httpdomain.ResponseModel[domain.Seller]
+
+// VoucherRequest, the amounts are in minor units of the currency e.g. cents, a usage limit of 0 means unlimited
+type VoucherRequest struct {
+	Code       string     `json:"code" binding:"required,max=32,alphanum"`
+	Type       string     `json:"type" binding:"required,oneof=percentage fixed"`
+	Percentage int        `json:"percentage" binding:"gte=0,lte=100"`
+	Amount     int64      `json:"amount" binding:"gte=0"`
+	MinSpend   int64      `json:"min_spend" binding:"gte=0"`
+	Currency   string     `json:"currency" binding:"omitempty,len=3,alpha"`
+	UsageLimit int        `json:"usage_limit" binding:"gte=0"`
+	StartsAt   *time.Time `json:"starts_at"`
+	EndsAt     *time.Time `json:"ends_at"`
+}
+
+type VoucherResponse = httpdomain.ResponseModel[domain.Voucher]
+type VouchersResponse = httpdomain.ResponseModel[[]domain.Voucher]
+
+type CategoryResponse = httpdomain.ResponseModel[domain.Category]
+type CategoriesResponse = httpdomain.ResponseModel[[]domain.Category]
+
 type ProductsResponse = httpdomain.ResponseModel[[]domain.Product]
 type ProductByIDResponse = httpdomain.ResponseModel[domain.Product]
 
 type UpdateOrderRequest struct {
-	ID     uint   `json:"order_id"`
-	Status string `json:"status"`
+	ID     uint   `json:"order_id" binding:"required"`
+	Status string `json:"status" binding:"required,oneof=completed cancelled"`
+}
+
+// ShipOrderRequest, courier the order was handed over to
+type ShipOrderRequest struct {
+	Courier        string `json:"courier" binding:"required,max=100"`
+	TrackingNumber string `json:"tracking_number" binding:"required,max=100"`
+}
+
+// RefundOrderRequest, items of the order to refund, the whole order is refunded when empty
+type RefundOrderRequest struct {
+	Items []RefundOrderRequestItem `json:"items" binding:"omitempty,dive"`
+}
+
+type RefundOrderRequestItem struct {
+	OrderDetailID uint `json:"order_detail_id" binding:"required"`
+	Quantity      int  `json:"quantity" binding:"gt=0"`
+}
+
+// GetInvoiceRequest, format of the rendered invoice, html when empty
+type GetInvoiceRequest struct {
+	Format string `form:"format" binding:"omitempty,oneof=html pdf"`
 }
 
 type CreateOrderRequest struct {
-	Products []CreateOrderRequestProductData `json:"products"`
+	Products []CreateOrderRequestProductData `json:"products" binding:"required,min=1,dive"`
+	// AddressID, address book entry to ship to, the default address when omitted
+	AddressID *uint `json:"address_id"`
+	// VoucherCode, voucher of the seller of the products discounting the order
+	VoucherCode string `json:"voucher_code" binding:"max=32"`
 }
 
 type CreateOrderRequestProductData struct {
-	ProductID  uint  `json:"product_id"`
-	ProductQty int32 `json:"product_qty"`
+	ProductID  uint  `json:"product_id" binding:"required"`
+	ProductQty int32 `json:"product_qty" binding:"gt=0"`
+}
+
+// GetOrdersRequest, filters, sorting and cursor pagination of the buyer's orders, from and to are inclusive
+type GetOrdersRequest struct {
+	Status string `form:"status" binding:"omitempty,oneof=new paid shipped delivered completed cancelled partially_refunded refunded"`
+	From   string `form:"from" binding:"omitempty,datetime=2006-01-02"`
+	To     string `form:"to" binding:"omitempty,datetime=2006-01-02"`
+	Sort   string `form:"sort" binding:"omitempty,oneof=newest oldest amount -amount"`
+	Cursor string `form:"cursor"`
+	Limit  int    `form:"limit" binding:"omitempty,gte=1,lte=100"`
 }
 
 type OrderResponse = httpdomain.ResponseModel[domain.Order]
 type OrdersResponse = httpdomain.ResponseModel[[]domain.Order]
+type OrderSummariesResponse = httpdomain.ResponseModel[[]domain.OrderSummary]
+type PaymentResponse = httpdomain.ResponseModel[domain.Payment]
+
+// ReviewRequest, rating from 1 to 5 stars of a product of the order
+type ReviewRequest struct {
+	ProductID uint   `json:"product_id" binding:"required"`
+	Rating    int    `json:"rating" binding:"gte=1,lte=5"`
+	Comment   string `json:"comment" binding:"max=2000"`
+}
+
+type ReviewResponse = httpdomain.ResponseModel[domain.Review]
+type ReviewsResponse = httpdomain.ResponseModel[[]domain.Review]
+
+type AddCartItemRequest struct {
+	ProductID uint `json:"product_id" binding:"required"`
+	Quantity  int  `json:"quantity" binding:"gt=0"`
+}
+
+type UpdateCartItemRequest struct {
+	Quantity int `json:"quantity" binding:"gt=0"`
+}
+
+// CheckoutRequest, address book entry every order of the cart ships to, the default address when omitted, and
+// the voucher discounting the order of the voucher's seller
+type CheckoutRequest struct {
+	AddressID   *uint  `json:"address_id"`
+	VoucherCode string `json:"voucher_code" binding:"max=32"`
+}
+
+type CartResponse = httpdomain.ResponseModel[domain.Cart]
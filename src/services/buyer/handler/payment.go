@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+// PaymentSignatureHeader, header the payment provider passes the signature of a webhook payload in
+const PaymentSignatureHeader = "X-Payment-Signature"
+
+// PayOrder, starts the payment of a new order of the buyer at the payment provider
+func (h *handler) PayOrder(ctx *gin.Context) {
+	orderId, err := strconv.ParseUint(ctx.Param("id"), 10, 0)
+	if err != nil {
+		ctx.Error(err)
+		ctx.JSON(http.StatusBadRequest, PaymentResponse{
+			Error: "please pass order id to path",
+		})
+		return
+	}
+
+	session := sessions.Default(ctx)
+	buyerId := session.Get(domain.BuyerKey).(uint)
+
+	res, err := h.PaymentUsecase.PayOrder(ctx, buyerId, uint(orderId))
+	if err != nil {
+		abortWithError[domain.Payment](ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, PaymentResponse{
+		Data: res,
+	})
+}
+
+// PaymentWebhook, receives the outcome of payments from the payment provider, the raw body is needed to check
+// its signature
+func (h *handler) PaymentWebhook(ctx *gin.Context) {
+	payload, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		ctx.Error(err)
+		ctx.JSON(http.StatusBadRequest, PaymentResponse{
+			Error: "invalid request",
+		})
+		return
+	}
+
+	if err := h.PaymentUsecase.HandleWebhook(ctx, payload, ctx.GetHeader(PaymentSignatureHeader)); err != nil {
+		abortWithError[domain.Payment](ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
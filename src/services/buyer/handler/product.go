@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	httpgin "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/http/gin"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+// CreateProduct
+func (h *handler) CreateProduct(ctx *gin.Context) {
+	request := new(ProductRequest)
+	if err := ctx.ShouldBind(request); err != nil {
+		httpgin.AbortWithBindError[domain.Product](ctx, err)
+		return
+	}
+
+	session := sessions.Default(ctx)
+	sellerId := session.Get(domain.SellerKey).(uint)
+
+	res, err := h.ProductUsecase.CreateProduct(ctx, request.toProduct(0, sellerId))
+	if err != nil {
+		abortWithError[domain.Product](ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, ProductByIDResponse{
+		Data: res,
+	})
+}
+
+// UpdateProduct
+func (h *handler) UpdateProduct(ctx *gin.Context) {
+	productId, err := strconv.ParseUint(ctx.Param("id"), 10, 0)
+	if err != nil {
+		ctx.Error(err)
+		ctx.JSON(http.StatusBadRequest, ProductByIDResponse{
+			Error: "please pass a valid id",
+		})
+		return
+	}
+
+	request := new(ProductRequest)
+	if err := ctx.ShouldBind(request); err != nil {
+		httpgin.AbortWithBindError[domain.Product](ctx, err)
+		return
+	}
+
+	session := sessions.Default(ctx)
+	sellerId := session.Get(domain.SellerKey).(uint)
+
+	res, err := h.ProductUsecase.UpdateProduct(ctx, request.toProduct(uint(productId), sellerId))
+	if err != nil {
+		abortWithError[domain.Product](ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ProductByIDResponse{
+		Data: res,
+	})
+}
+
+// DeleteProduct
+func (h *handler) DeleteProduct(ctx *gin.Context) {
+	productId, err := strconv.ParseUint(ctx.Param("id"), 10, 0)
+	if err != nil {
+		ctx.Error(err)
+		ctx.JSON(http.StatusBadRequest, ProductByIDResponse{
+			Error: "please pass a valid id",
+		})
+		return
+	}
+
+	session := sessions.Default(ctx)
+	sellerId := session.Get(domain.SellerKey).(uint)
+
+	if err := h.ProductUsecase.DeleteProduct(ctx, sellerId, uint(productId)); err != nil {
+		abortWithError[domain.Product](ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// Categories
+func (h *handler) Categories(ctx *gin.Context) {
+	res, err := h.ProductUsecase.Categories(ctx)
+	if err != nil {
+		ctx.Error(err)
+		ctx.JSON(http.StatusInternalServerError, CategoriesResponse{
+			Error: "something happened on our end, please try at a later time",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, CategoriesResponse{
+		Data: &res,
+	})
+}
+
+// CreateCategory
+func (h *handler) CreateCategory(ctx *gin.Context) {
+	request := new(CategoryRequest)
+	if err := ctx.ShouldBind(request); err != nil {
+		httpgin.AbortWithBindError[domain.Category](ctx, err)
+		return
+	}
+
+	res, err := h.ProductUsecase.CreateCategory(ctx, request.Name)
+	if err != nil {
+		abortWithError[domain.Category](ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, CategoryResponse{
+		Data: res,
+	})
+}
+
+// toProduct, converts handler request to domain product
+func (r *ProductRequest) toProduct(id uint, sellerId uint) domain.Product {
+	currency := r.Currency
+	if currency == "" {
+		currency = money.DefaultCurrency
+	}
+
+	product := domain.Product{
+		Model: yugabyte.Model{
+			ID: id,
+		},
+		SellerID:    sellerId,
+		SKU:         r.SKU,
+		ProductName: r.ProductName,
+		Description: r.Description,
+		Price:       money.New(r.Price, currency),
+		Stock:       r.Stock,
+		CategoryID:  r.CategoryID,
+	}
+
+	for i, v := range r.Images {
+		product.Images = append(product.Images, domain.ProductImage{
+			URL:      v.URL,
+			AltText:  v.AltText,
+			Position: i,
+		})
+	}
+
+	return product
+}
@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	httpgin "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/http/gin"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+// Profile
+func (h *handler) Profile(ctx *gin.Context) {
+	session := sessions.Default(ctx)
+	buyerId := session.Get(domain.BuyerKey).(uint)
+
+	res, err := h.BuyerUsecase.Profile(ctx, buyerId)
+	if err != nil {
+		abortWithError[domain.Buyer](ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ProfileResponse{
+		Data: res,
+	})
+}
+
+// UpdateProfile
+func (h *handler) UpdateProfile(ctx *gin.Context) {
+	request := new(UpdateProfileRequest)
+	if err := ctx.ShouldBind(request); err != nil {
+		httpgin.AbortWithBindError[domain.Buyer](ctx, err)
+		return
+	}
+
+	session := sessions.Default(ctx)
+	buyerId := session.Get(domain.BuyerKey).(uint)
+
+	res, err := h.BuyerUsecase.UpdateProfile(ctx, domain.Buyer{
+		Model: yugabyte.Model{ID: buyerId},
+		Name:  request.Name,
+		Email: request.Email,
+		Phone: request.Phone,
+	})
+	if err != nil {
+		abortWithError[domain.Buyer](ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ProfileResponse{
+		Data: res,
+	})
+}
+
+// Deactivate, closes the account of the buyer and logs the buyer out
+func (h *handler) Deactivate(ctx *gin.Context) {
+	session := sessions.Default(ctx)
+	buyerId := session.Get(domain.BuyerKey).(uint)
+
+	if err := h.BuyerUsecase.Deactivate(ctx, buyerId); err != nil {
+		abortWithError[domain.Buyer](ctx, err)
+		return
+	}
+
+	session.Delete(domain.BuyerKey)
+	session.Save()
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// Addresses
+func (h *handler) Addresses(ctx *gin.Context) {
+	session := sessions.Default(ctx)
+	buyerId := session.Get(domain.BuyerKey).(uint)
+
+	res, err := h.BuyerUsecase.Addresses(ctx, buyerId)
+	if err != nil {
+		abortWithError[[]domain.Address](ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, AddressesResponse{
+		Data: &res,
+	})
+}
+
+// CreateAddress
+func (h *handler) CreateAddress(ctx *gin.Context) {
+	request := new(AddressRequest)
+	if err := ctx.ShouldBind(request); err != nil {
+		httpgin.AbortWithBindError[domain.Address](ctx, err)
+		return
+	}
+
+	session := sessions.Default(ctx)
+	buyerId := session.Get(domain.BuyerKey).(uint)
+
+	res, err := h.BuyerUsecase.SaveAddress(ctx, request.toAddress(buyerId))
+	if err != nil {
+		abortWithError[domain.Address](ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, AddressResponse{
+		Data: res,
+	})
+}
+
+// UpdateAddress
+func (h *handler) UpdateAddress(ctx *gin.Context) {
+	addressId, err := strconv.ParseUint(ctx.Param("id"), 10, 0)
+	if err != nil {
+		ctx.Error(err)
+		ctx.JSON(http.StatusBadRequest, AddressResponse{
+			Error: "please pass a valid id",
+		})
+		return
+	}
+
+	request := new(AddressRequest)
+	if err := ctx.ShouldBind(request); err != nil {
+		httpgin.AbortWithBindError[domain.Address](ctx, err)
+		return
+	}
+
+	session := sessions.Default(ctx)
+	buyerId := session.Get(domain.BuyerKey).(uint)
+
+	address := request.toAddress(buyerId)
+	address.ID = uint(addressId)
+
+	res, err := h.BuyerUsecase.SaveAddress(ctx, address)
+	if err != nil {
+		abortWithError[domain.Address](ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, AddressResponse{
+		Data: res,
+	})
+}
+
+// DeleteAddress
+func (h *handler) DeleteAddress(ctx *gin.Context) {
+	addressId, err := strconv.ParseUint(ctx.Param("id"), 10, 0)
+	if err != nil {
+		ctx.Error(err)
+		ctx.JSON(http.StatusBadRequest, AddressResponse{
+			Error: "please pass a valid id",
+		})
+		return
+	}
+
+	session := sessions.Default(ctx)
+	buyerId := session.Get(domain.BuyerKey).(uint)
+
+	if err := h.BuyerUsecase.DeleteAddress(ctx, buyerId, uint(addressId)); err != nil {
+		abortWithError[domain.Address](ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// toAddress, converts the request to an address of the buyer
+func (r AddressRequest) toAddress(buyerId uint) domain.Address {
+	return domain.Address{
+		BuyerID: buyerId,
+		Label:   r.Label,
+		ShippingAddress: domain.ShippingAddress{
+			RecipientName: r.RecipientName,
+			Phone:         r.Phone,
+			Street:        r.Street,
+			City:          r.City,
+			Province:      r.Province,
+			PostalCode:    r.PostalCode,
+			Country:       r.Country,
+		},
+		IsDefault: r.IsDefault,
+	}
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	httpgin "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/http/gin"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+// CreateReview, reviews a product of a completed order of the buyer
+func (h *handler) CreateReview(ctx *gin.Context) {
+	orderId, err := strconv.ParseUint(ctx.Param("id"), 10, 0)
+	if err != nil {
+		ctx.Error(err)
+		ctx.JSON(http.StatusBadRequest, ReviewResponse{
+			Error: "please pass order id to path",
+		})
+		return
+	}
+
+	request := new(ReviewRequest)
+	if err := ctx.ShouldBind(request); err != nil {
+		httpgin.AbortWithBindError[domain.Review](ctx, err)
+		return
+	}
+
+	session := sessions.Default(ctx)
+	buyerId := session.Get(domain.BuyerKey).(uint)
+
+	res, err := h.ReviewUsecase.CreateReview(ctx, domain.Review{
+		OrderID:   uint(orderId),
+		ProductID: request.ProductID,
+		BuyerID:   buyerId,
+		Rating:    request.Rating,
+		Comment:   request.Comment,
+	})
+	if err != nil {
+		abortWithError[domain.Review](ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, ReviewResponse{
+		Data: res,
+	})
+}
+
+// ProductReviews
+func (h *handler) ProductReviews(ctx *gin.Context) {
+	productId, err := strconv.ParseUint(ctx.Param("id"), 10, 0)
+	if err != nil {
+		ctx.Error(err)
+		ctx.JSON(http.StatusBadRequest, ReviewsResponse{
+			Error: "please pass a valid id",
+		})
+		return
+	}
+
+	res, err := h.ReviewUsecase.ProductReviews(ctx, uint(productId))
+	if err != nil {
+		abortWithError[[]domain.Review](ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ReviewsResponse{
+		Data: &res,
+	})
+}
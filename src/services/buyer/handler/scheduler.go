@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/usecase"
+	"go.uber.org/fx"
+)
+
+// ScheduleOrderExpiry, cancels expired orders every interval in the background for as long as the service runs
+func ScheduleOrderExpiry(lc fx.Lifecycle, expiryUsecase usecase.OrderExpiryUsecase, expiryCfg domain.OrderExpiryConfig) {
+	if expiryCfg.TTL <= 0 {
+		log.Println("order expiry is disabled")
+		return
+	}
+
+	interval := expiryCfg.Interval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go runOrderExpiry(ctx, expiryUsecase, interval)
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			cancel()
+			return nil
+		},
+	})
+}
+
+// runOrderExpiry, expires orders every interval until ctx is done
+func runOrderExpiry(ctx context.Context, expiryUsecase usecase.OrderExpiryUsecase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			expired, err := expiryUsecase.ExpireOrders(ctx, now)
+			if err != nil {
+				log.Println("[ScheduleOrderExpiry] error", err)
+			}
+			if expired > 0 {
+				log.Printf("[ScheduleOrderExpiry] cancelled %d expired orders", expired)
+			}
+		}
+	}
+}
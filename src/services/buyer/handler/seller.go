@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	httpgin "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/http/gin"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+func (h *handler) SellerLogin(ctx *gin.Context) {
+	session := sessions.Default(ctx)
+	request := new(SellerLoginRequest)
+	if err := ctx.ShouldBind(request); err != nil {
+		httpgin.AbortWithBindError[domain.Seller](ctx, err)
+		return
+	}
+
+	res, err := h.SellerUsecase.Login(ctx, request.Name)
+	if err != nil {
+		ctx.Error(err)
+		ctx.JSON(http.StatusInternalServerError,
+			SellerLoginResponse{
+				Error: "something happened on our end, please try at a later time",
+			})
+		return
+	}
+
+	// set seller object to cookie
+	session.Set(domain.SellerKey, res.ID)
+	session.Save()
+
+	ctx.JSON(http.StatusOK, SellerLoginResponse{
+		Data: res,
+	})
+}
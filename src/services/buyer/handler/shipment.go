@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	httpgin "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/http/gin"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+// ShipOrder, marks an order of the seller as handed over to a courier
+func (h *handler) ShipOrder(ctx *gin.Context) {
+	orderId, err := strconv.ParseUint(ctx.Param("id"), 10, 0)
+	if err != nil {
+		ctx.Error(err)
+		ctx.JSON(http.StatusBadRequest, OrderResponse{
+			Error: "please pass order id to path",
+		})
+		return
+	}
+
+	request := new(ShipOrderRequest)
+	if err := ctx.ShouldBind(request); err != nil {
+		httpgin.AbortWithBindError[domain.Order](ctx, err)
+		return
+	}
+
+	session := sessions.Default(ctx)
+	sellerId := session.Get(domain.SellerKey).(uint)
+
+	res, err := h.ShipmentUsecase.ShipOrder(ctx, sellerId, uint(orderId), request.Courier, request.TrackingNumber)
+	if err != nil {
+		abortWithError[domain.Order](ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, OrderResponse{
+		Data: res,
+	})
+}
+
+// DeliverOrder, marks a shipped order of the seller as delivered
+func (h *handler) DeliverOrder(ctx *gin.Context) {
+	orderId, err := strconv.ParseUint(ctx.Param("id"), 10, 0)
+	if err != nil {
+		ctx.Error(err)
+		ctx.JSON(http.StatusBadRequest, OrderResponse{
+			Error: "please pass order id to path",
+		})
+		return
+	}
+
+	session := sessions.Default(ctx)
+	sellerId := session.Get(domain.SellerKey).(uint)
+
+	res, err := h.ShipmentUsecase.DeliverOrder(ctx, sellerId, uint(orderId))
+	if err != nil {
+		abortWithError[domain.Order](ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, OrderResponse{
+		Data: res,
+	})
+}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	httpgin "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/http/gin"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+// CreateVoucher
+func (h *handler) CreateVoucher(ctx *gin.Context) {
+	request := new(VoucherRequest)
+	if err := ctx.ShouldBind(request); err != nil {
+		httpgin.AbortWithBindError[domain.Voucher](ctx, err)
+		return
+	}
+
+	session := sessions.Default(ctx)
+	sellerId := session.Get(domain.SellerKey).(uint)
+
+	res, err := h.VoucherUsecase.CreateVoucher(ctx, request.toVoucher(sellerId))
+	if err != nil {
+		abortWithError[domain.Voucher](ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, VoucherResponse{
+		Data: res,
+	})
+}
+
+// Vouchers
+func (h *handler) Vouchers(ctx *gin.Context) {
+	session := sessions.Default(ctx)
+	sellerId := session.Get(domain.SellerKey).(uint)
+
+	res, err := h.VoucherUsecase.Vouchers(ctx, sellerId)
+	if err != nil {
+		abortWithError[[]domain.Voucher](ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, VouchersResponse{
+		Data: &res,
+	})
+}
+
+// toVoucher, converts handler request to domain voucher
+func (r *VoucherRequest) toVoucher(sellerId uint) domain.Voucher {
+	currency := r.Currency
+	if currency == "" {
+		currency = money.DefaultCurrency
+	}
+
+	return domain.Voucher{
+		SellerID:   sellerId,
+		Code:       r.Code,
+		Type:       r.Type,
+		Percentage: r.Percentage,
+		Amount:     money.New(r.Amount, currency),
+		MinSpend:   money.New(r.MinSpend, currency),
+		UsageLimit: r.UsageLimit,
+		StartsAt:   r.StartsAt,
+		EndsAt:     r.EndsAt,
+	}
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"gorm.io/gorm"
+)
+
+// AddressRepository, interface for address repository
+type AddressRepository interface {
+	GetAddresses(ctx context.Context, buyerId uint) ([]domain.Address, error)
+	GetAddress(ctx context.Context, buyerId uint, id uint) (*domain.Address, error)
+	GetDefaultAddress(ctx context.Context, buyerId uint) (*domain.Address, error)
+	SaveAddress(ctx context.Context, address domain.Address) (*domain.Address, error)
+	DeleteAddress(ctx context.Context, address domain.Address) error
+}
+
+// addressRepository, concrete implementation of address repository
+type addressRepository struct {
+	db *gorm.DB
+}
+
+// NewAddressRepository, constructor function for address repository
+func NewAddressRepository(db *gorm.DB) AddressRepository {
+	return &addressRepository{
+		db: db,
+	}
+}
+
+// GetAddresses, gets the address book of a buyer, the default address first
+func (ar *addressRepository) GetAddresses(ctx context.Context, buyerId uint) ([]domain.Address, error) {
+	var res []domain.Address
+
+	query := ar.db.WithContext(ctx)
+	if err := query.Where("buyer_id = ?", buyerId).Order("is_default DESC, id").Find(&res).Error; err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetAddress, gets an address of a buyer, returns nil when the address does not belong to the buyer
+func (ar *addressRepository) GetAddress(ctx context.Context, buyerId uint, id uint) (*domain.Address, error) {
+	var res domain.Address
+
+	query := ar.db.WithContext(ctx)
+	if err := query.Where("id = ? AND buyer_id = ?", id, buyerId).First(&res).Error; err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	} else if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+
+	return &res, nil
+}
+
+// GetDefaultAddress, gets the default address of a buyer, returns nil when the buyer has no address
+func (ar *addressRepository) GetDefaultAddress(ctx context.Context, buyerId uint) (*domain.Address, error) {
+	var res domain.Address
+
+	query := ar.db.WithContext(ctx)
+	if err := query.Where("buyer_id = ? AND is_default", buyerId).First(&res).Error; err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	} else if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+
+	return &res, nil
+}
+
+// SaveAddress, inserts or updates an address keeping exactly one default address per buyer: a new default
+// address replaces the previous one and an address stays default until another one is made default
+func (ar *addressRepository) SaveAddress(ctx context.Context, address domain.Address) (*domain.Address, error) {
+	err := ar.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		others := tx.Model(&domain.Address{}).Where("buyer_id = ? AND id <> ? AND is_default", address.BuyerID, address.ID)
+
+		if address.IsDefault {
+			if err := others.Update("is_default", false).Error; err != nil {
+				return err
+			}
+		} else {
+			var defaults int64
+			if err := others.Count(&defaults).Error; err != nil {
+				return err
+			}
+			address.IsDefault = defaults == 0
+		}
+
+		return tx.Save(&address).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &address, nil
+}
+
+// DeleteAddress, deletes an address, the oldest remaining address becomes the default when the default
+// address is deleted
+func (ar *addressRepository) DeleteAddress(ctx context.Context, address domain.Address) error {
+	return ar.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("buyer_id = ?", address.BuyerID).Delete(&domain.Address{}, address.ID).Error; err != nil {
+			return err
+		}
+
+		if !address.IsDefault {
+			return nil
+		}
+
+		return tx.Model(&domain.Address{}).
+			Where("id = (?)", tx.Model(&domain.Address{}).Select("MIN(id)").Where("buyer_id = ?", address.BuyerID)).
+			Update("is_default", true).Error
+	})
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+func Test_addressRepository_SaveAddress(t *testing.T) {
+	tests := []struct {
+		name          string
+		address       domain.Address
+		wantIsDefault bool
+		mock          func()
+	}{
+		{
+			name:          "new default address replaces the previous one",
+			address:       domain.Address{BuyerID: 1, IsDefault: true},
+			wantIsDefault: true,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "addresses" SET "is_default"=$1,"updated_at"=$2 WHERE (buyer_id = $3 AND id <> $4 AND is_default) AND "addresses"."deleted_at" IS NULL`)).
+					WithArgs(false, sqlmock.AnyArg(), 1, 0).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "addresses"`)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:          "first address becomes the default",
+			address:       domain.Address{BuyerID: 1},
+			wantIsDefault: true,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "addresses" WHERE (buyer_id = $1 AND id <> $2 AND is_default) AND "addresses"."deleted_at" IS NULL`)).
+					WithArgs(1, 0).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "addresses"`)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "buyer already has a default address",
+			address: domain.Address{BuyerID: 1},
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "addresses" WHERE (buyer_id = $1 AND id <> $2 AND is_default) AND "addresses"."deleted_at" IS NULL`)).
+					WithArgs(1, 0).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "addresses"`)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+				mock.ExpectCommit()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			res, err := NewAddressRepository(gormdb).SaveAddress(context.TODO(), tt.address)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantIsDefault, res.IsDefault)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_addressRepository_DeleteAddress(t *testing.T) {
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "addresses" SET "deleted_at"=$1 WHERE buyer_id = $2 AND "addresses"."id" = $3 AND "addresses"."deleted_at" IS NULL`)).
+		WithArgs(sqlmock.AnyArg(), 1, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "addresses" SET "is_default"=$1,"updated_at"=$2 WHERE id = (SELECT MIN(id) FROM "addresses" WHERE buyer_id = $3 AND "addresses"."deleted_at" IS NULL) AND "addresses"."deleted_at" IS NULL`)).
+		WithArgs(true, sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := NewAddressRepository(gormdb).DeleteAddress(context.TODO(), domain.Address{
+		Model:     yugabyte.Model{ID: 3},
+		BuyerID:   1,
+		IsDefault: true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
 	"gorm.io/gorm"
@@ -12,6 +13,8 @@ type BuyerRepository interface {
 	Get(ctx context.Context, buyer domain.Buyer) (*domain.Buyer, error)
 	Create(ctx context.Context, buyer domain.Buyer) (*domain.Buyer, error)
 	GetByUsername(ctx context.Context, username string) (*domain.Buyer, error)
+	UpdateProfile(ctx context.Context, buyer domain.Buyer) (*domain.Buyer, error)
+	Deactivate(ctx context.Context, buyerId uint, at time.Time) error
 }
 
 // buyerRepository, concrete implementation of buyer repository
@@ -58,3 +61,19 @@ func (br *buyerRepository) Create(ctx context.Context, buyer domain.Buyer) (*dom
 	}
 	return &buyer, nil
 }
+
+// UpdateProfile, overwrites the profile fields of a buyer, empty fields are cleared
+func (br *buyerRepository) UpdateProfile(ctx context.Context, buyer domain.Buyer) (*domain.Buyer, error) {
+	query := br.db.WithContext(ctx).Model(&domain.Buyer{}).Where("id = ?", buyer.ID)
+	if err := query.Select("name", "email", "phone").Updates(buyer).Error; err != nil {
+		return nil, err
+	}
+
+	return br.Get(ctx, domain.Buyer{Model: buyer.Model})
+}
+
+// Deactivate, marks the account of a buyer as deactivated, the orders of the buyer are kept
+func (br *buyerRepository) Deactivate(ctx context.Context, buyerId uint, at time.Time) error {
+	query := br.db.WithContext(ctx).Model(&domain.Buyer{}).Where("id = ? AND deactivated_at IS NULL", buyerId)
+	return query.Update("deactivated_at", at).Error
+}
@@ -178,8 +178,8 @@ func Test_repository_Create(t *testing.T) {
 			mock: func() {
 				mock.ExpectBegin()
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`INSERT INTO "buyers" ("created_at","updated_at","deleted_at","username") VALUES ($1,$2,$3,$4) RETURNING "id"`)).
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), "testuser").
+					`INSERT INTO "buyers" ("created_at","updated_at","deleted_at","username","name","email","phone","deactivated_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), "testuser", "", "", "", nil).
 					WillReturnRows(sqlmock.NewRows([]string{"username"}).
 						AddRow("testuser"))
 				mock.ExpectCommit()
@@ -195,8 +195,8 @@ func Test_repository_Create(t *testing.T) {
 			mock: func() {
 				mock.ExpectBegin()
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`INSERT INTO "buyers" ("created_at","updated_at","deleted_at","username") VALUES ($1,$2,$3,$4) RETURNING "id"`)).
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), "testuser").
+					`INSERT INTO "buyers" ("created_at","updated_at","deleted_at","username","name","email","phone","deactivated_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), "testuser", "", "", "", nil).
 					WillReturnError(errors.New("mock error"))
 			},
 		},
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CartRepository, interface for cart repository
+type CartRepository interface {
+	GetCartByBuyerID(ctx context.Context, buyerId uint) (*domain.Cart, error)
+	CreateCart(ctx context.Context, cart domain.Cart) (*domain.Cart, error)
+	SaveCartItem(ctx context.Context, item domain.CartItem) (*domain.CartItem, error)
+	DeleteCartItem(ctx context.Context, cartId uint, productId uint) (bool, error)
+	Checkout(ctx context.Context, cartId uint, orders []domain.Order) ([]domain.Order, error)
+}
+
+// cartRepository, concrete implementation of cart repository
+type cartRepository struct {
+	db *gorm.DB
+}
+
+// NewCartRepository, constructor function for cart repository
+func NewCartRepository(db *gorm.DB) CartRepository {
+	return &cartRepository{
+		db: db,
+	}
+}
+
+// GetCartByBuyerID, gets the cart of a buyer along with its items and products
+func (cr *cartRepository) GetCartByBuyerID(ctx context.Context, buyerId uint) (*domain.Cart, error) {
+	var res domain.Cart
+
+	query := cr.db.WithContext(ctx)
+	if err := query.Where("buyer_id = ?", buyerId).Preload("Items", func(db *gorm.DB) *gorm.DB {
+		return db.Order("cart_items.id")
+	}).Preload("Items.Product").First(&res).Error; err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	} else if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+
+	return &res, nil
+}
+
+// CreateCart, insert cart entity into database
+func (cr *cartRepository) CreateCart(ctx context.Context, cart domain.Cart) (*domain.Cart, error) {
+	if err := cr.db.WithContext(ctx).Create(&cart).Error; err != nil {
+		return nil, err
+	}
+	return &cart, nil
+}
+
+// SaveCartItem, inserts a cart item or overwrites the quantity when the product is already in the cart
+func (cr *cartRepository) SaveCartItem(ctx context.Context, item domain.CartItem) (*domain.CartItem, error) {
+	query := cr.db.WithContext(ctx).Omit("Product").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "cart_id"}, {Name: "product_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"quantity": item.Quantity, "updated_at": time.Now()}),
+	})
+	if err := query.Create(&item).Error; err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// DeleteCartItem, removes a product from the cart, returns false when the product was not in the cart
+func (cr *cartRepository) DeleteCartItem(ctx context.Context, cartId uint, productId uint) (bool, error) {
+	// items are hard deleted so that the product can be added back without violating the unique index
+	result := cr.db.WithContext(ctx).Unscoped().Where("cart_id = ? AND product_id = ?", cartId, productId).Delete(&domain.CartItem{})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// Checkout, inserts the orders built from a cart with their invoice numbers, reserves their stock, redeems their
+// vouchers and empties the cart in a single transaction
+func (cr *cartRepository) Checkout(ctx context.Context, cartId uint, orders []domain.Order) ([]domain.Order, error) {
+	err := cr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i := range orders {
+			if err := reserveStock(tx, &orders[i]); err != nil {
+				return err
+			}
+			if err := redeemVoucher(tx, orders[i]); err != nil {
+				return err
+			}
+			if err := assignInvoiceNo(tx, &orders[i]); err != nil {
+				return err
+			}
+			if err := tx.Create(&orders[i]).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Unscoped().Where("cart_id = ?", cartId).Delete(&domain.CartItem{}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range orders {
+		orders[i].OrderDateStr = time.Time(orders[i].OrderDate).Format(domain.OrderDateFormat)
+	}
+	return orders, nil
+}
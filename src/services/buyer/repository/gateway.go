@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+// PaymentGateway, interface for the payment provider buyers pay their orders at
+type PaymentGateway interface {
+	// Provider, name of the payment provider payments are created at
+	Provider() string
+	// CreateIntent, creates a payment at the payment provider, its outcome is reported to the webhook later
+	CreateIntent(ctx context.Context, payment domain.Payment) (*domain.PaymentIntent, error)
+	// ParseWebhook, verifies the signature of a webhook call and parses its payload
+	ParseWebhook(payload []byte, signature string) (*domain.PaymentNotification, error)
+}
+
+// NewPaymentGateway, constructor function for the payment gateway of the configured provider
+func NewPaymentGateway(cfg domain.PaymentConfig) (PaymentGateway, error) {
+	switch cfg.Provider {
+	case "", domain.PaymentProviderFake:
+		return NewFakePaymentGateway(cfg.WebhookSecret), nil
+	default:
+		return nil, fmt.Errorf("unknown payment provider %q", cfg.Provider)
+	}
+}
+
+// fakePaymentGateway, local payment gateway, payments only settle when the webhook is called with a payload
+// signed with the webhook secret
+type fakePaymentGateway struct {
+	secret []byte
+}
+
+// NewFakePaymentGateway, constructor function for the fake payment gateway
+func NewFakePaymentGateway(secret string) PaymentGateway {
+	return &fakePaymentGateway{
+		secret: []byte(secret),
+	}
+}
+
+// Provider
+func (fg *fakePaymentGateway) Provider() string {
+	return domain.PaymentProviderFake
+}
+
+// CreateIntent, issues a random reference for the payment
+func (fg *fakePaymentGateway) CreateIntent(ctx context.Context, payment domain.Payment) (*domain.PaymentIntent, error) {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	return &domain.PaymentIntent{
+		Reference: "fake_" + hex.EncodeToString(b),
+	}, nil
+}
+
+// ParseWebhook, signature is the hex encoded HMAC-SHA256 of payload keyed with the webhook secret
+func (fg *fakePaymentGateway) ParseWebhook(payload []byte, signature string) (*domain.PaymentNotification, error) {
+	sig, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(sig, fg.sign(payload)) {
+		return nil, domain.ErrInvalidSignature
+	}
+
+	var res domain.PaymentNotification
+	if err := json.Unmarshal(payload, &res); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidWebhook, err)
+	}
+
+	if res.Reference == "" || (res.Status != domain.PaymentStatusSucceeded && res.Status != domain.PaymentStatusFailed) {
+		return nil, fmt.Errorf("%w: unknown payment outcome", domain.ErrInvalidWebhook)
+	}
+	return &res, nil
+}
+
+// sign, signature of payload the webhook accepts
+func (fg *fakePaymentGateway) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, fg.secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
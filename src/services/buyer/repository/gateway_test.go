@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+func Test_fakePaymentGateway_ParseWebhook(t *testing.T) {
+	gateway := &fakePaymentGateway{secret: []byte("secret")}
+	sign := func(payload string) string {
+		return hex.EncodeToString(gateway.sign([]byte(payload)))
+	}
+
+	tests := []struct {
+		name      string
+		payload   string
+		signature string
+		want      *domain.PaymentNotification
+		wantErr   error
+	}{
+		{
+			name:      "signed notification",
+			payload:   `{"reference":"fake_1","status":"succeeded"}`,
+			signature: sign(`{"reference":"fake_1","status":"succeeded"}`),
+			want:      &domain.PaymentNotification{Reference: "fake_1", Status: domain.PaymentStatusSucceeded},
+		},
+		{
+			name:      "payload signed with another secret",
+			payload:   `{"reference":"fake_1","status":"succeeded"}`,
+			signature: hex.EncodeToString((&fakePaymentGateway{secret: []byte("other")}).sign([]byte(`{"reference":"fake_1","status":"succeeded"}`))),
+			wantErr:   domain.ErrInvalidSignature,
+		},
+		{
+			name:    "missing signature",
+			payload: `{"reference":"fake_1","status":"succeeded"}`,
+			wantErr: domain.ErrInvalidSignature,
+		},
+		{
+			name:      "unknown outcome",
+			payload:   `{"reference":"fake_1","status":"refunded"}`,
+			signature: sign(`{"reference":"fake_1","status":"refunded"}`),
+			wantErr:   domain.ErrInvalidWebhook,
+		},
+		{
+			name:      "malformed payload",
+			payload:   `reference=fake_1`,
+			signature: sign(`reference=fake_1`),
+			wantErr:   domain.ErrInvalidWebhook,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gateway.ParseWebhook([]byte(tt.payload), tt.signature)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
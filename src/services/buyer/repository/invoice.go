@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"gorm.io/gorm"
+)
+
+// assignInvoiceNo, issues the next invoice number of the order's seller for the year of the order date. The
+// sequence row stays locked until tx ends, concurrent orders of the seller wait for it and a rolled back
+// order gives its number back, keeping the sequence gap-free
+func assignInvoiceNo(tx *gorm.DB, order *domain.Order) error {
+	year := time.Time(order.OrderDate).Year()
+
+	var number int64
+	err := tx.Raw(`INSERT INTO invoice_sequences (seller_id, year, last_number) VALUES (?, ?, 1)
+		ON CONFLICT (seller_id, year) DO UPDATE SET last_number = invoice_sequences.last_number + 1
+		RETURNING last_number`, order.SellerID, year).Scan(&number).Error
+	if err != nil {
+		return err
+	}
+
+	order.InvoiceNo = domain.FormatInvoiceNo(order.SellerID, year, number)
+	return nil
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"gorm.io/datatypes"
+)
+
+func Test_assignInvoiceNo(t *testing.T) {
+	orderDate := datatypes.Date(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name    string
+		want    string
+		wantErr bool
+		mock    func()
+	}{
+		{
+			name: "success",
+			want: "INV/12/2026/000123",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO invoice_sequences (seller_id, year, last_number) VALUES ($1, $2, 1)`)).
+					WithArgs(uint(12), 2026).
+					WillReturnRows(sqlmock.NewRows([]string{"last_number"}).AddRow(123))
+			},
+		},
+		{
+			name:    "error",
+			wantErr: true,
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO invoice_sequences (seller_id, year, last_number) VALUES ($1, $2, 1)`)).
+					WithArgs(uint(12), 2026).
+					WillReturnError(errors.New("mock error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			order := domain.Order{SellerID: 12, OrderDate: orderDate}
+			err := assignInvoiceNo(gormdb, &order)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, order.InvoiceNo)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: address.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+// MockAddressRepository is a mock of AddressRepository interface.
+type MockAddressRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddressRepositoryMockRecorder
+}
+
+// MockAddressRepositoryMockRecorder is the mock recorder for MockAddressRepository.
+type MockAddressRepositoryMockRecorder struct {
+	mock *MockAddressRepository
+}
+
+// NewMockAddressRepository creates a new mock instance.
+func NewMockAddressRepository(ctrl *gomock.Controller) *MockAddressRepository {
+	mock := &MockAddressRepository{ctrl: ctrl}
+	mock.recorder = &MockAddressRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAddressRepository) EXPECT() *MockAddressRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteAddress mocks base method.
+func (m *MockAddressRepository) DeleteAddress(ctx context.Context, address domain.Address) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAddress", ctx, address)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAddress indicates an expected call of DeleteAddress.
+func (mr *MockAddressRepositoryMockRecorder) DeleteAddress(ctx, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAddress", reflect.TypeOf((*MockAddressRepository)(nil).DeleteAddress), ctx, address)
+}
+
+// GetAddress mocks base method.
+func (m *MockAddressRepository) GetAddress(ctx context.Context, buyerId, id uint) (*domain.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddress", ctx, buyerId, id)
+	ret0, _ := ret[0].(*domain.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddress indicates an expected call of GetAddress.
+func (mr *MockAddressRepositoryMockRecorder) GetAddress(ctx, buyerId, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddress", reflect.TypeOf((*MockAddressRepository)(nil).GetAddress), ctx, buyerId, id)
+}
+
+// GetAddresses mocks base method.
+func (m *MockAddressRepository) GetAddresses(ctx context.Context, buyerId uint) ([]domain.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddresses", ctx, buyerId)
+	ret0, _ := ret[0].([]domain.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddresses indicates an expected call of GetAddresses.
+func (mr *MockAddressRepositoryMockRecorder) GetAddresses(ctx, buyerId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddresses", reflect.TypeOf((*MockAddressRepository)(nil).GetAddresses), ctx, buyerId)
+}
+
+// GetDefaultAddress mocks base method.
+func (m *MockAddressRepository) GetDefaultAddress(ctx context.Context, buyerId uint) (*domain.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefaultAddress", ctx, buyerId)
+	ret0, _ := ret[0].(*domain.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefaultAddress indicates an expected call of GetDefaultAddress.
+func (mr *MockAddressRepositoryMockRecorder) GetDefaultAddress(ctx, buyerId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefaultAddress", reflect.TypeOf((*MockAddressRepository)(nil).GetDefaultAddress), ctx, buyerId)
+}
+
+// SaveAddress mocks base method.
+func (m *MockAddressRepository) SaveAddress(ctx context.Context, address domain.Address) (*domain.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAddress", ctx, address)
+	ret0, _ := ret[0].(*domain.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveAddress indicates an expected call of SaveAddress.
+func (mr *MockAddressRepositoryMockRecorder) SaveAddress(ctx, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAddress", reflect.TypeOf((*MockAddressRepository)(nil).SaveAddress), ctx, address)
+}
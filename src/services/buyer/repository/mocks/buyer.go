@@ -7,6 +7,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
@@ -50,6 +51,20 @@ func (mr *MockBuyerRepositoryMockRecorder) Create(ctx, buyer interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBuyerRepository)(nil).Create), ctx, buyer)
 }
 
+// Deactivate mocks base method.
+func (m *MockBuyerRepository) Deactivate(ctx context.Context, buyerId uint, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deactivate", ctx, buyerId, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Deactivate indicates an expected call of Deactivate.
+func (mr *MockBuyerRepositoryMockRecorder) Deactivate(ctx, buyerId, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deactivate", reflect.TypeOf((*MockBuyerRepository)(nil).Deactivate), ctx, buyerId, at)
+}
+
 // Get mocks base method.
 func (m *MockBuyerRepository) Get(ctx context.Context, buyer domain.Buyer) (*domain.Buyer, error) {
 	m.ctrl.T.Helper()
@@ -79,3 +94,18 @@ func (mr *MockBuyerRepositoryMockRecorder) GetByUsername(ctx, username interface
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUsername", reflect.TypeOf((*MockBuyerRepository)(nil).GetByUsername), ctx, username)
 }
+
+// UpdateProfile mocks base method.
+func (m *MockBuyerRepository) UpdateProfile(ctx context.Context, buyer domain.Buyer) (*domain.Buyer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProfile", ctx, buyer)
+	ret0, _ := ret[0].(*domain.Buyer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProfile indicates an expected call of UpdateProfile.
+func (mr *MockBuyerRepositoryMockRecorder) UpdateProfile(ctx, buyer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockBuyerRepository)(nil).UpdateProfile), ctx, buyer)
+}
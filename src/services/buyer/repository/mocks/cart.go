@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: cart.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+// MockCartRepository is a mock of CartRepository interface.
+type MockCartRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCartRepositoryMockRecorder
+}
+
+// MockCartRepositoryMockRecorder is the mock recorder for MockCartRepository.
+type MockCartRepositoryMockRecorder struct {
+	mock *MockCartRepository
+}
+
+// NewMockCartRepository creates a new mock instance.
+func NewMockCartRepository(ctrl *gomock.Controller) *MockCartRepository {
+	mock := &MockCartRepository{ctrl: ctrl}
+	mock.recorder = &MockCartRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCartRepository) EXPECT() *MockCartRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Checkout mocks base method.
+func (m *MockCartRepository) Checkout(ctx context.Context, cartId uint, orders []domain.Order) ([]domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Checkout", ctx, cartId, orders)
+	ret0, _ := ret[0].([]domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Checkout indicates an expected call of Checkout.
+func (mr *MockCartRepositoryMockRecorder) Checkout(ctx, cartId, orders interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkout", reflect.TypeOf((*MockCartRepository)(nil).Checkout), ctx, cartId, orders)
+}
+
+// CreateCart mocks base method.
+func (m *MockCartRepository) CreateCart(ctx context.Context, cart domain.Cart) (*domain.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCart", ctx, cart)
+	ret0, _ := ret[0].(*domain.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCart indicates an expected call of CreateCart.
+func (mr *MockCartRepositoryMockRecorder) CreateCart(ctx, cart interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCart", reflect.TypeOf((*MockCartRepository)(nil).CreateCart), ctx, cart)
+}
+
+// DeleteCartItem mocks base method.
+func (m *MockCartRepository) DeleteCartItem(ctx context.Context, cartId, productId uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCartItem", ctx, cartId, productId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCartItem indicates an expected call of DeleteCartItem.
+func (mr *MockCartRepositoryMockRecorder) DeleteCartItem(ctx, cartId, productId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCartItem", reflect.TypeOf((*MockCartRepository)(nil).DeleteCartItem), ctx, cartId, productId)
+}
+
+// GetCartByBuyerID mocks base method.
+func (m *MockCartRepository) GetCartByBuyerID(ctx context.Context, buyerId uint) (*domain.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCartByBuyerID", ctx, buyerId)
+	ret0, _ := ret[0].(*domain.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCartByBuyerID indicates an expected call of GetCartByBuyerID.
+func (mr *MockCartRepositoryMockRecorder) GetCartByBuyerID(ctx, buyerId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCartByBuyerID", reflect.TypeOf((*MockCartRepository)(nil).GetCartByBuyerID), ctx, buyerId)
+}
+
+// SaveCartItem mocks base method.
+func (m *MockCartRepository) SaveCartItem(ctx context.Context, item domain.CartItem) (*domain.CartItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCartItem", ctx, item)
+	ret0, _ := ret[0].(*domain.CartItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveCartItem indicates an expected call of SaveCartItem.
+func (mr *MockCartRepositoryMockRecorder) SaveCartItem(ctx, item interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCartItem", reflect.TypeOf((*MockCartRepository)(nil).SaveCartItem), ctx, item)
+}
@@ -7,8 +7,10 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
+	money "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
 	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
 )
 
@@ -35,19 +37,19 @@ func (m *MockOrderRepository) EXPECT() *MockOrderRepositoryMockRecorder {
 	return m.recorder
 }
 
-// GetOngoingOrders mocks base method.
-func (m *MockOrderRepository) GetOngoingOrders(ctx context.Context, buyerId uint) (bool, error) {
+// GetExpiredOrders mocks base method.
+func (m *MockOrderRepository) GetExpiredOrders(ctx context.Context, createdBefore time.Time, limit int) ([]domain.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOngoingOrders", ctx, buyerId)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "GetExpiredOrders", ctx, createdBefore, limit)
+	ret0, _ := ret[0].([]domain.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetOngoingOrders indicates an expected call of GetOngoingOrders.
-func (mr *MockOrderRepositoryMockRecorder) GetOngoingOrders(ctx, buyerId interface{}) *gomock.Call {
+// GetExpiredOrders indicates an expected call of GetExpiredOrders.
+func (mr *MockOrderRepositoryMockRecorder) GetExpiredOrders(ctx, createdBefore, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOngoingOrders", reflect.TypeOf((*MockOrderRepository)(nil).GetOngoingOrders), ctx, buyerId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredOrders", reflect.TypeOf((*MockOrderRepository)(nil).GetExpiredOrders), ctx, createdBefore, limit)
 }
 
 // GetOrderByID mocks base method.
@@ -65,19 +67,19 @@ func (mr *MockOrderRepositoryMockRecorder) GetOrderByID(ctx, id interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderByID", reflect.TypeOf((*MockOrderRepository)(nil).GetOrderByID), ctx, id)
 }
 
-// GetOrdersByBuyerID mocks base method.
-func (m *MockOrderRepository) GetOrdersByBuyerID(ctx context.Context, buyerId uint) ([]domain.Order, error) {
+// GetOrderSummaries mocks base method.
+func (m *MockOrderRepository) GetOrderSummaries(ctx context.Context, filter domain.OrderFilter) ([]domain.OrderSummary, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrdersByBuyerID", ctx, buyerId)
-	ret0, _ := ret[0].([]domain.Order)
+	ret := m.ctrl.Call(m, "GetOrderSummaries", ctx, filter)
+	ret0, _ := ret[0].([]domain.OrderSummary)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetOrdersByBuyerID indicates an expected call of GetOrdersByBuyerID.
-func (mr *MockOrderRepositoryMockRecorder) GetOrdersByBuyerID(ctx, buyerId interface{}) *gomock.Call {
+// GetOrderSummaries indicates an expected call of GetOrderSummaries.
+func (mr *MockOrderRepositoryMockRecorder) GetOrderSummaries(ctx, filter interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrdersByBuyerID", reflect.TypeOf((*MockOrderRepository)(nil).GetOrdersByBuyerID), ctx, buyerId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderSummaries", reflect.TypeOf((*MockOrderRepository)(nil).GetOrderSummaries), ctx, filter)
 }
 
 // GetProductByID mocks base method.
@@ -96,18 +98,19 @@ func (mr *MockOrderRepositoryMockRecorder) GetProductByID(ctx, id interface{}) *
 }
 
 // GetProducts mocks base method.
-func (m *MockOrderRepository) GetProducts(ctx context.Context) ([]domain.Product, error) {
+func (m *MockOrderRepository) GetProducts(ctx context.Context, filter domain.ProductFilter) ([]domain.Product, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProducts", ctx)
+	ret := m.ctrl.Call(m, "GetProducts", ctx, filter)
 	ret0, _ := ret[0].([]domain.Product)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // GetProducts indicates an expected call of GetProducts.
-func (mr *MockOrderRepositoryMockRecorder) GetProducts(ctx interface{}) *gomock.Call {
+func (mr *MockOrderRepositoryMockRecorder) GetProducts(ctx, filter interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProducts", reflect.TypeOf((*MockOrderRepository)(nil).GetProducts), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProducts", reflect.TypeOf((*MockOrderRepository)(nil).GetProducts), ctx, filter)
 }
 
 // InsertOrder mocks base method.
@@ -125,6 +128,34 @@ func (mr *MockOrderRepositoryMockRecorder) InsertOrder(ctx, order interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertOrder", reflect.TypeOf((*MockOrderRepository)(nil).InsertOrder), ctx, order)
 }
 
+// PublishFunnelEvent mocks base method.
+func (m *MockOrderRepository) PublishFunnelEvent(ctx context.Context, event domain.PayloadEventFunnel) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishFunnelEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishFunnelEvent indicates an expected call of PublishFunnelEvent.
+func (mr *MockOrderRepositoryMockRecorder) PublishFunnelEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishFunnelEvent", reflect.TypeOf((*MockOrderRepository)(nil).PublishFunnelEvent), ctx, event)
+}
+
+// PublishLowStockEvent mocks base method.
+func (m *MockOrderRepository) PublishLowStockEvent(ctx context.Context, event domain.PayloadEventLowStock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishLowStockEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishLowStockEvent indicates an expected call of PublishLowStockEvent.
+func (mr *MockOrderRepositoryMockRecorder) PublishLowStockEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishLowStockEvent", reflect.TypeOf((*MockOrderRepository)(nil).PublishLowStockEvent), ctx, event)
+}
+
 // PublishOrderEvent mocks base method.
 func (m *MockOrderRepository) PublishOrderEvent(ctx context.Context, event domain.PayloadEventOrder) error {
 	m.ctrl.T.Helper()
@@ -139,6 +170,21 @@ func (mr *MockOrderRepositoryMockRecorder) PublishOrderEvent(ctx, event interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishOrderEvent", reflect.TypeOf((*MockOrderRepository)(nil).PublishOrderEvent), ctx, event)
 }
 
+// RefundOrder mocks base method.
+func (m *MockOrderRepository) RefundOrder(ctx context.Context, order domain.Order, items []domain.RefundItem, amount money.Money) (*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundOrder", ctx, order, items, amount)
+	ret0, _ := ret[0].(*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefundOrder indicates an expected call of RefundOrder.
+func (mr *MockOrderRepositoryMockRecorder) RefundOrder(ctx, order, items, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundOrder", reflect.TypeOf((*MockOrderRepository)(nil).RefundOrder), ctx, order, items, amount)
+}
+
 // UpdateOrderById mocks base method.
 func (m *MockOrderRepository) UpdateOrderById(ctx context.Context, order domain.Order) (*domain.Order, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: payment.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+// MockPaymentRepository is a mock of PaymentRepository interface.
+type MockPaymentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentRepositoryMockRecorder
+}
+
+// MockPaymentRepositoryMockRecorder is the mock recorder for MockPaymentRepository.
+type MockPaymentRepositoryMockRecorder struct {
+	mock *MockPaymentRepository
+}
+
+// NewMockPaymentRepository creates a new mock instance.
+func NewMockPaymentRepository(ctrl *gomock.Controller) *MockPaymentRepository {
+	mock := &MockPaymentRepository{ctrl: ctrl}
+	mock.recorder = &MockPaymentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentRepository) EXPECT() *MockPaymentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreatePayment mocks base method.
+func (m *MockPaymentRepository) CreatePayment(ctx context.Context, payment domain.Payment) (*domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePayment", ctx, payment)
+	ret0, _ := ret[0].(*domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePayment indicates an expected call of CreatePayment.
+func (mr *MockPaymentRepositoryMockRecorder) CreatePayment(ctx, payment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayment", reflect.TypeOf((*MockPaymentRepository)(nil).CreatePayment), ctx, payment)
+}
+
+// GetPaymentByReference mocks base method.
+func (m *MockPaymentRepository) GetPaymentByReference(ctx context.Context, provider, reference string) (*domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentByReference", ctx, provider, reference)
+	ret0, _ := ret[0].(*domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentByReference indicates an expected call of GetPaymentByReference.
+func (mr *MockPaymentRepositoryMockRecorder) GetPaymentByReference(ctx, provider, reference interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentByReference", reflect.TypeOf((*MockPaymentRepository)(nil).GetPaymentByReference), ctx, provider, reference)
+}
+
+// GetPendingPayment mocks base method.
+func (m *MockPaymentRepository) GetPendingPayment(ctx context.Context, orderId uint) (*domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingPayment", ctx, orderId)
+	ret0, _ := ret[0].(*domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingPayment indicates an expected call of GetPendingPayment.
+func (mr *MockPaymentRepositoryMockRecorder) GetPendingPayment(ctx, orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingPayment", reflect.TypeOf((*MockPaymentRepository)(nil).GetPendingPayment), ctx, orderId)
+}
+
+// PublishPaymentEvent mocks base method.
+func (m *MockPaymentRepository) PublishPaymentEvent(ctx context.Context, event domain.PayloadEventPayment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishPaymentEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishPaymentEvent indicates an expected call of PublishPaymentEvent.
+func (mr *MockPaymentRepositoryMockRecorder) PublishPaymentEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishPaymentEvent", reflect.TypeOf((*MockPaymentRepository)(nil).PublishPaymentEvent), ctx, event)
+}
+
+// SettlePayment mocks base method.
+func (m *MockPaymentRepository) SettlePayment(ctx context.Context, payment domain.Payment) (*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SettlePayment", ctx, payment)
+	ret0, _ := ret[0].(*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SettlePayment indicates an expected call of SettlePayment.
+func (mr *MockPaymentRepositoryMockRecorder) SettlePayment(ctx, payment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SettlePayment", reflect.TypeOf((*MockPaymentRepository)(nil).SettlePayment), ctx, payment)
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: review.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+// MockReviewRepository is a mock of ReviewRepository interface.
+type MockReviewRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewRepositoryMockRecorder
+}
+
+// MockReviewRepositoryMockRecorder is the mock recorder for MockReviewRepository.
+type MockReviewRepositoryMockRecorder struct {
+	mock *MockReviewRepository
+}
+
+// NewMockReviewRepository creates a new mock instance.
+func NewMockReviewRepository(ctrl *gomock.Controller) *MockReviewRepository {
+	mock := &MockReviewRepository{ctrl: ctrl}
+	mock.recorder = &MockReviewRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewRepository) EXPECT() *MockReviewRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateReview mocks base method.
+func (m *MockReviewRepository) CreateReview(ctx context.Context, review domain.Review) (*domain.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReview", ctx, review)
+	ret0, _ := ret[0].(*domain.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReview indicates an expected call of CreateReview.
+func (mr *MockReviewRepositoryMockRecorder) CreateReview(ctx, review interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReview", reflect.TypeOf((*MockReviewRepository)(nil).CreateReview), ctx, review)
+}
+
+// GetProductReviews mocks base method.
+func (m *MockReviewRepository) GetProductReviews(ctx context.Context, productId uint) ([]domain.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductReviews", ctx, productId)
+	ret0, _ := ret[0].([]domain.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductReviews indicates an expected call of GetProductReviews.
+func (mr *MockReviewRepositoryMockRecorder) GetProductReviews(ctx, productId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductReviews", reflect.TypeOf((*MockReviewRepository)(nil).GetProductReviews), ctx, productId)
+}
+
+// PublishReviewEvent mocks base method.
+func (m *MockReviewRepository) PublishReviewEvent(ctx context.Context, event domain.PayloadEventReview) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishReviewEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishReviewEvent indicates an expected call of PublishReviewEvent.
+func (mr *MockReviewRepositoryMockRecorder) PublishReviewEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishReviewEvent", reflect.TypeOf((*MockReviewRepository)(nil).PublishReviewEvent), ctx, event)
+}
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: seller.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+// MockSellerRepository is a mock of SellerRepository interface.
+type MockSellerRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSellerRepositoryMockRecorder
+}
+
+// MockSellerRepositoryMockRecorder is the mock recorder for MockSellerRepository.
+type MockSellerRepositoryMockRecorder struct {
+	mock *MockSellerRepository
+}
+
+// NewMockSellerRepository creates a new mock instance.
+func NewMockSellerRepository(ctrl *gomock.Controller) *MockSellerRepository {
+	mock := &MockSellerRepository{ctrl: ctrl}
+	mock.recorder = &MockSellerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSellerRepository) EXPECT() *MockSellerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSellerRepository) Create(ctx context.Context, seller domain.Seller) (*domain.Seller, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, seller)
+	ret0, _ := ret[0].(*domain.Seller)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSellerRepositoryMockRecorder) Create(ctx, seller interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSellerRepository)(nil).Create), ctx, seller)
+}
+
+// Get mocks base method.
+func (m *MockSellerRepository) Get(ctx context.Context, id uint) (*domain.Seller, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*domain.Seller)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSellerRepositoryMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSellerRepository)(nil).Get), ctx, id)
+}
+
+// GetByName mocks base method.
+func (m *MockSellerRepository) GetByName(ctx context.Context, name string) (*domain.Seller, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByName", ctx, name)
+	ret0, _ := ret[0].(*domain.Seller)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByName indicates an expected call of GetByName.
+func (mr *MockSellerRepositoryMockRecorder) GetByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByName", reflect.TypeOf((*MockSellerRepository)(nil).GetByName), ctx, name)
+}
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: shipment.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+// MockShipmentRepository is a mock of ShipmentRepository interface.
+type MockShipmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockShipmentRepositoryMockRecorder
+}
+
+// MockShipmentRepositoryMockRecorder is the mock recorder for MockShipmentRepository.
+type MockShipmentRepositoryMockRecorder struct {
+	mock *MockShipmentRepository
+}
+
+// NewMockShipmentRepository creates a new mock instance.
+func NewMockShipmentRepository(ctrl *gomock.Controller) *MockShipmentRepository {
+	mock := &MockShipmentRepository{ctrl: ctrl}
+	mock.recorder = &MockShipmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShipmentRepository) EXPECT() *MockShipmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeliverOrder mocks base method.
+func (m *MockShipmentRepository) DeliverOrder(ctx context.Context, order domain.Order, deliveredAt time.Time) (*domain.Shipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeliverOrder", ctx, order, deliveredAt)
+	ret0, _ := ret[0].(*domain.Shipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeliverOrder indicates an expected call of DeliverOrder.
+func (mr *MockShipmentRepositoryMockRecorder) DeliverOrder(ctx, order, deliveredAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverOrder", reflect.TypeOf((*MockShipmentRepository)(nil).DeliverOrder), ctx, order, deliveredAt)
+}
+
+// PublishShipmentEvent mocks base method.
+func (m *MockShipmentRepository) PublishShipmentEvent(ctx context.Context, event domain.PayloadEventShipment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishShipmentEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishShipmentEvent indicates an expected call of PublishShipmentEvent.
+func (mr *MockShipmentRepositoryMockRecorder) PublishShipmentEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishShipmentEvent", reflect.TypeOf((*MockShipmentRepository)(nil).PublishShipmentEvent), ctx, event)
+}
+
+// ShipOrder mocks base method.
+func (m *MockShipmentRepository) ShipOrder(ctx context.Context, order domain.Order, shipment domain.Shipment) (*domain.Shipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShipOrder", ctx, order, shipment)
+	ret0, _ := ret[0].(*domain.Shipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShipOrder indicates an expected call of ShipOrder.
+func (mr *MockShipmentRepositoryMockRecorder) ShipOrder(ctx, order, shipment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShipOrder", reflect.TypeOf((*MockShipmentRepository)(nil).ShipOrder), ctx, order, shipment)
+}
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: voucher.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+// MockVoucherRepository is a mock of VoucherRepository interface.
+type MockVoucherRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockVoucherRepositoryMockRecorder
+}
+
+// MockVoucherRepositoryMockRecorder is the mock recorder for MockVoucherRepository.
+type MockVoucherRepositoryMockRecorder struct {
+	mock *MockVoucherRepository
+}
+
+// NewMockVoucherRepository creates a new mock instance.
+func NewMockVoucherRepository(ctrl *gomock.Controller) *MockVoucherRepository {
+	mock := &MockVoucherRepository{ctrl: ctrl}
+	mock.recorder = &MockVoucherRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVoucherRepository) EXPECT() *MockVoucherRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateVoucher mocks base method.
+func (m *MockVoucherRepository) CreateVoucher(ctx context.Context, voucher domain.Voucher) (*domain.Voucher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVoucher", ctx, voucher)
+	ret0, _ := ret[0].(*domain.Voucher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVoucher indicates an expected call of CreateVoucher.
+func (mr *MockVoucherRepositoryMockRecorder) CreateVoucher(ctx, voucher interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVoucher", reflect.TypeOf((*MockVoucherRepository)(nil).CreateVoucher), ctx, voucher)
+}
+
+// GetVoucherByCode mocks base method.
+func (m *MockVoucherRepository) GetVoucherByCode(ctx context.Context, code string) (*domain.Voucher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVoucherByCode", ctx, code)
+	ret0, _ := ret[0].(*domain.Voucher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVoucherByCode indicates an expected call of GetVoucherByCode.
+func (mr *MockVoucherRepositoryMockRecorder) GetVoucherByCode(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVoucherByCode", reflect.TypeOf((*MockVoucherRepository)(nil).GetVoucherByCode), ctx, code)
+}
+
+// GetVouchers mocks base method.
+func (m *MockVoucherRepository) GetVouchers(ctx context.Context, sellerId uint) ([]domain.Voucher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVouchers", ctx, sellerId)
+	ret0, _ := ret[0].([]domain.Voucher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVouchers indicates an expected call of GetVouchers.
+func (mr *MockVoucherRepositoryMockRecorder) GetVouchers(ctx, sellerId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVouchers", reflect.TypeOf((*MockVoucherRepository)(nil).GetVouchers), ctx, sellerId)
+}
@@ -2,46 +2,85 @@ package repository
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/messagequeue"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type OrderRepository interface {
-	GetProducts(ctx context.Context) ([]domain.Product, error)
+	GetProducts(ctx context.Context, filter domain.ProductFilter) ([]domain.Product, int64, error)
 	GetProductByID(ctx context.Context, id uint) (*domain.Product, error)
-	GetOrdersByBuyerID(ctx context.Context, buyerId uint) ([]domain.Order, error)
+	GetOrderSummaries(ctx context.Context, filter domain.OrderFilter) ([]domain.OrderSummary, error)
 	UpdateOrderById(ctx context.Context, order domain.Order) (*domain.Order, error)
 	InsertOrder(ctx context.Context, order domain.Order) (*domain.Order, error)
-	GetOngoingOrders(ctx context.Context, buyerId uint) (bool, error)
+	RefundOrder(ctx context.Context, order domain.Order, items []domain.RefundItem, amount money.Money) (*domain.Order, error)
 	PublishOrderEvent(ctx context.Context, event domain.PayloadEventOrder) error
+	PublishLowStockEvent(ctx context.Context, event domain.PayloadEventLowStock) error
+	PublishFunnelEvent(ctx context.Context, event domain.PayloadEventFunnel) error
 	GetOrderByID(ctx context.Context, id uint) (*domain.Order, error)
+	GetExpiredOrders(ctx context.Context, createdBefore time.Time, limit int) ([]domain.Order, error)
 }
 
 type orderRepository struct {
 	db               *gorm.DB
 	repoCoreRabbitMQ messagequeue.Publisher[domain.PayloadEventOrder]
+	stockPublisher   messagequeue.Publisher[domain.PayloadEventLowStock]
+	funnelPublisher  messagequeue.Publisher[domain.PayloadEventFunnel]
 }
 
-func NewOrderRepository(db *gorm.DB, repoCoreRabbitMQ messagequeue.Publisher[domain.PayloadEventOrder]) OrderRepository {
+func NewOrderRepository(
+	db *gorm.DB,
+	repoCoreRabbitMQ messagequeue.Publisher[domain.PayloadEventOrder],
+	stockPublisher messagequeue.Publisher[domain.PayloadEventLowStock],
+	funnelPublisher messagequeue.Publisher[domain.PayloadEventFunnel]) OrderRepository {
 	return &orderRepository{
 		db:               db,
 		repoCoreRabbitMQ: repoCoreRabbitMQ,
+		stockPublisher:   stockPublisher,
+		funnelPublisher:  funnelPublisher,
 	}
 }
 
-// GetProducts
-func (or *orderRepository) GetProducts(ctx context.Context) ([]domain.Product, error) {
-	var res []domain.Product
+// GetProducts, gets a page of products matching filter along with the total number of matching products
+func (or *orderRepository) GetProducts(ctx context.Context, filter domain.ProductFilter) ([]domain.Product, int64, error) {
+	var (
+		res   []domain.Product
+		total int64
+	)
 
-	if err := or.db.Find(&res).Error; err != nil && err != gorm.ErrRecordNotFound {
-		return res, err
-	} else if err == gorm.ErrRecordNotFound {
-		return nil, nil
+	query := or.db.WithContext(ctx).Model(&domain.Product{})
+	if filter.Search != "" {
+		search := "%" + filter.Search + "%"
+		query = query.Where("product_name ILIKE ? OR description ILIKE ? OR sku ILIKE ?", search, search, search)
 	}
-	return res, nil
+	if filter.CategoryID != 0 {
+		query = query.Where("category_id = ?", filter.CategoryID)
+	}
+	if filter.SellerID != 0 {
+		query = query.Where("seller_id = ?", filter.SellerID)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	sort, ok := domain.ProductSortColumns[filter.Sort]
+	if !ok {
+		sort = domain.ProductSortColumns[domain.ProductSortNewest]
+	}
+
+	if err := query.Preload("Category").Preload("Images", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position")
+	}).Order(sort).Offset((filter.Page - 1) * filter.PageSize).Limit(filter.PageSize).Find(&res).Error; err != nil {
+		return nil, 0, err
+	}
+	return res, total, nil
 }
 
 // GetProductByID
@@ -49,7 +88,9 @@ func (or *orderRepository) GetProductByID(ctx context.Context, id uint) (*domain
 	var res domain.Product
 
 	query := or.db.WithContext(ctx)
-	if err := query.Where("id = ?", id).First(&res).Error; err != nil && err != gorm.ErrRecordNotFound {
+	if err := query.Where("id = ?", id).Preload("Category").Preload("Images", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position")
+	}).First(&res).Error; err != nil && err != gorm.ErrRecordNotFound {
 		return nil, err
 	} else if err == gorm.ErrRecordNotFound {
 		return nil, nil
@@ -58,15 +99,49 @@ func (or *orderRepository) GetProductByID(ctx context.Context, id uint) (*domain
 	return &res, nil
 }
 
-// GetOrders
-func (or *orderRepository) GetOrdersByBuyerID(ctx context.Context, buyerId uint) ([]domain.Order, error) {
-	var res []domain.Order
+// GetOrderSummaries, gets the summaries of the orders matching filter following its cursor, one more order than
+// the limit is returned when there is a next page
+func (or *orderRepository) GetOrderSummaries(ctx context.Context, filter domain.OrderFilter) ([]domain.OrderSummary, error) {
+	var res []domain.OrderSummary
 
-	query := or.db.WithContext(ctx)
-	if err := query.Where("buyer_id = ?", buyerId).Preload("OrderDetails").Preload("OrderDetails.Product").Find(&res).Error; err != nil && err != gorm.ErrRecordNotFound {
-		return res, err
-	} else if err == gorm.ErrRecordNotFound {
-		return nil, nil
+	itemCount := or.db.Model(&domain.OrderDetail{}).Select("COALESCE(SUM(product_quantity), 0)").Where("order_details.order_id = orders.id")
+
+	query := or.db.WithContext(ctx).Model(&domain.Order{}).
+		Select("orders.id, orders.seller_id, orders.status, orders.amount_amount, orders.amount_currency, "+
+			"orders.refunded_amount_amount, orders.refunded_amount_currency, orders.invoice_no, orders.order_date, orders.created_at, (?) AS item_count", itemCount).
+		Where("buyer_id = ?", filter.BuyerID)
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.From != nil {
+		query = query.Where("order_date >= ?", datatypes.Date(*filter.From))
+	}
+	if filter.To != nil {
+		query = query.Where("order_date <= ?", datatypes.Date(*filter.To))
+	}
+
+	sort, ok := domain.OrderSortColumns[filter.Sort]
+	if !ok {
+		filter.Sort = domain.OrderSortNewest
+		sort = domain.OrderSortColumns[filter.Sort]
+	}
+
+	// keyset pagination, continue right after the last order of the previous page in the sort order
+	if after := filter.After; after != nil {
+		switch filter.Sort {
+		case domain.OrderSortNewest:
+			query = query.Where("orders.id < ?", after.ID)
+		case domain.OrderSortOldest:
+			query = query.Where("orders.id > ?", after.ID)
+		case domain.OrderSortAmountAsc:
+			query = query.Where("amount_amount > ? OR (amount_amount = ? AND orders.id > ?)", after.Amount, after.Amount, after.ID)
+		case domain.OrderSortAmountDesc:
+			query = query.Where("amount_amount < ? OR (amount_amount = ? AND orders.id > ?)", after.Amount, after.Amount, after.ID)
+		}
+	}
+
+	if err := query.Order(sort).Limit(filter.Limit + 1).Scan(&res).Error; err != nil {
+		return nil, err
 	}
 
 	for i := range res {
@@ -76,41 +151,108 @@ func (or *orderRepository) GetOrdersByBuyerID(ctx context.Context, buyerId uint)
 	return res, nil
 }
 
+// UpdateOrderById, updates the status of an order, cancelling an order releases its reserved stock and gives the
+// use of its voucher back
 func (or *orderRepository) UpdateOrderById(ctx context.Context, order domain.Order) (*domain.Order, error) {
-	if err := or.db.Model(&order).UpdateColumns(domain.Order{Status: order.Status}).Error; err != nil {
+	err := or.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// the status guard makes sure concurrent updates only release the stock once
+		if err := changeOrderStatus(tx, order); err != nil {
+			return err
+		}
+
+		if order.Status == domain.OrderStatusCancelled {
+			if err := releaseVoucher(tx, order); err != nil {
+				return err
+			}
+			return releaseStock(tx, order)
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 	order.OrderDateStr = time.Time(order.OrderDate).Format(domain.OrderDateFormat)
 	return &order, nil
 }
 
-// InsertOrder
+// changeOrderStatus, moves order to order.Status, fails with ErrInvalidOrderStatus when the order is no longer
+// in a status it can be moved from
+func changeOrderStatus(tx *gorm.DB, order domain.Order) error {
+	result := tx.Model(&order).Where("status IN ?", domain.PreviousOrderStatuses(order.Status)).UpdateColumns(domain.Order{Status: order.Status})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrInvalidOrderStatus
+	}
+	return nil
+}
+
+// InsertOrder, inserts the order with the next invoice number of its seller, reserves the stock of its products and
+// redeems its voucher in a single transaction
 func (or *orderRepository) InsertOrder(ctx context.Context, order domain.Order) (*domain.Order, error) {
-	if err := or.db.Create(&order).Error; err != nil {
+	err := or.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := reserveStock(tx, &order); err != nil {
+			return err
+		}
+		if err := redeemVoucher(tx, order); err != nil {
+			return err
+		}
+		if err := assignInvoiceNo(tx, &order); err != nil {
+			return err
+		}
+		return tx.Create(&order).Error
+	})
+	if err != nil {
 		return nil, err
 	}
 	order.OrderDateStr = time.Time(order.OrderDate).Format(domain.OrderDateFormat)
 	return &order, nil
 }
 
-// GetOngoingOrders
-func (or *orderRepository) GetOngoingOrders(ctx context.Context, buyerId uint) (bool, error) {
-	var res []domain.Order
-
-	query := or.db.WithContext(ctx)
-	if err := query.Where("status = ? AND buyer_id = ?", domain.OrderStatusNew, buyerId).Find(&res).Error; err != nil {
-		return false, err
-	}
+// RefundOrder, refunds the items of a completed order in a single transaction, the refunded quantities are put back
+// into stock and amount is added to the refunded amount of the order, order holds the status after the refund
+func (or *orderRepository) RefundOrder(ctx context.Context, order domain.Order, items []domain.RefundItem, amount money.Money) (*domain.Order, error) {
+	err := or.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&domain.Order{}).
+			Where("id = ?", order.ID).
+			Where("status IN ?", []string{domain.OrderStatusCompleted, domain.OrderStatusPartiallyRefunded}).
+			UpdateColumns(map[string]interface{}{
+				"status":                   order.Status,
+				"refunded_amount_amount":   gorm.Expr("refunded_amount_amount + ?", amount.Amount),
+				"refunded_amount_currency": amount.Currency,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrInvalidOrderStatus
+		}
 
-	if len(res) > 0 {
-		return true, nil
-	}
+		for _, v := range items {
+			// the quantity guard makes sure concurrent refunds never give back more than was ordered
+			result := tx.Model(&domain.OrderDetail{}).
+				Where("id = ?", v.OrderDetailID).
+				Where("refunded_quantity + ? <= product_quantity", v.Quantity).
+				UpdateColumn("refunded_quantity", gorm.Expr("refunded_quantity + ?", v.Quantity))
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return fmt.Errorf("%w: order detail %d", domain.ErrInvalidRefund, v.OrderDetailID)
+			}
 
-	for i := range res {
-		res[i].OrderDateStr = time.Time(res[i].OrderDate).Format(domain.OrderDateFormat)
+			if err := restock(tx, v.ProductID, v.Quantity); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-
-	return false, nil
+	order.OrderDateStr = time.Time(order.OrderDate).Format(domain.OrderDateFormat)
+	return &order, nil
 }
 
 // PublishOrderEvent
@@ -123,15 +265,79 @@ func (or *orderRepository) PublishOrderEvent(ctx context.Context, event domain.P
 	return nil
 }
 
+// PublishLowStockEvent
+func (or *orderRepository) PublishLowStockEvent(ctx context.Context, event domain.PayloadEventLowStock) error {
+	return or.stockPublisher.Publish(ctx, messagequeue.PublishConfig{}, event)
+}
+
+// PublishFunnelEvent
+func (or *orderRepository) PublishFunnelEvent(ctx context.Context, event domain.PayloadEventFunnel) error {
+	return or.funnelPublisher.Publish(ctx, messagequeue.PublishConfig{}, event)
+}
+
 // GetOrderByID
 func (or *orderRepository) GetOrderByID(ctx context.Context, id uint) (*domain.Order, error) {
 	var res domain.Order
 
 	query := or.db.WithContext(ctx)
-	if err := query.Where("id = ?", id).Preload("OrderDetails").Preload("OrderDetails.Product").First(&res).Error; err != nil {
+	if err := query.Where("id = ?", id).Preload("OrderDetails").Preload("OrderDetails.Product").Preload("Shipment").First(&res).Error; err != nil && err != gorm.ErrRecordNotFound {
 		return nil, err
+	} else if err == gorm.ErrRecordNotFound {
+		return nil, nil
 	}
 	res.OrderDateStr = time.Time(res.OrderDate).Format(domain.OrderDateFormat)
 
 	return &res, nil
 }
+
+// GetExpiredOrders, gets at most limit orders still in status new that were placed before createdBefore,
+// oldest first
+func (or *orderRepository) GetExpiredOrders(ctx context.Context, createdBefore time.Time, limit int) ([]domain.Order, error) {
+	var res []domain.Order
+
+	query := or.db.WithContext(ctx)
+	if err := query.Where("status = ? AND created_at < ?", domain.OrderStatusNew, createdBefore).
+		Order("created_at, id").Limit(limit).Preload("OrderDetails").Find(&res).Error; err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// reserveStock, deducts the ordered quantity from the stock of every product of the order, the remaining stock
+// is written back to the order details, fails with ErrInsufficientStock when a product does not have enough stock
+func reserveStock(tx *gorm.DB, order *domain.Order) error {
+	for i, v := range order.OrderDetails {
+		product := domain.Product{}
+		product.ID = v.ProductID
+
+		result := tx.Model(&product).Clauses(clause.Returning{Columns: []clause.Column{{Name: "stock"}}}).
+			Where("stock >= ?", v.ProductQuantity).
+			UpdateColumn("stock", gorm.Expr("stock - ?", v.ProductQuantity))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: product %d", domain.ErrInsufficientStock, v.ProductID)
+		}
+
+		order.OrderDetails[i].Product.Stock = product.Stock
+	}
+	return nil
+}
+
+// releaseStock, puts the ordered quantity of every product of the order back into stock
+func releaseStock(tx *gorm.DB, order domain.Order) error {
+	for _, v := range order.OrderDetails {
+		if err := restock(tx, v.ProductID, v.ProductQuantity); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restock, puts quantity of a product back into stock, deleted products are restocked as well in case they get restored
+func restock(tx *gorm.DB, productId uint, quantity int) error {
+	return tx.Unscoped().Model(&domain.Product{}).Where("id = ?", productId).
+		UpdateColumn("stock", gorm.Expr("stock + ?", quantity)).Error
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/messagequeue"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"gorm.io/gorm"
+)
+
+// PaymentRepository, interface for payment repository
+type PaymentRepository interface {
+	GetPendingPayment(ctx context.Context, orderId uint) (*domain.Payment, error)
+	GetPaymentByReference(ctx context.Context, provider string, reference string) (*domain.Payment, error)
+	CreatePayment(ctx context.Context, payment domain.Payment) (*domain.Payment, error)
+	SettlePayment(ctx context.Context, payment domain.Payment) (*domain.Order, error)
+	PublishPaymentEvent(ctx context.Context, event domain.PayloadEventPayment) error
+}
+
+// paymentRepository, concrete implementation of payment repository
+type paymentRepository struct {
+	db        *gorm.DB
+	publisher messagequeue.Publisher[domain.PayloadEventPayment]
+}
+
+// NewPaymentRepository, constructor function for payment repository
+func NewPaymentRepository(db *gorm.DB, publisher messagequeue.Publisher[domain.PayloadEventPayment]) PaymentRepository {
+	return &paymentRepository{
+		db:        db,
+		publisher: publisher,
+	}
+}
+
+// GetPendingPayment, gets the payment of an order waiting for its outcome, returns nil when there is none
+func (pr *paymentRepository) GetPendingPayment(ctx context.Context, orderId uint) (*domain.Payment, error) {
+	var res domain.Payment
+
+	query := pr.db.WithContext(ctx)
+	if err := query.Where("order_id = ? AND status = ?", orderId, domain.PaymentStatusPending).First(&res).Error; err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	} else if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+
+	return &res, nil
+}
+
+// GetPaymentByReference, gets a payment by the reference the payment provider issued, returns nil when it does not exist
+func (pr *paymentRepository) GetPaymentByReference(ctx context.Context, provider string, reference string) (*domain.Payment, error) {
+	var res domain.Payment
+
+	query := pr.db.WithContext(ctx)
+	if err := query.Where("provider = ? AND reference = ?", provider, reference).First(&res).Error; err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	} else if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+
+	return &res, nil
+}
+
+// CreatePayment
+func (pr *paymentRepository) CreatePayment(ctx context.Context, payment domain.Payment) (*domain.Payment, error) {
+	if err := pr.db.WithContext(ctx).Create(&payment).Error; err != nil {
+		return nil, err
+	}
+	return &payment, nil
+}
+
+// SettlePayment, records the outcome of a pending payment, payment.Status, in a single transaction, a successful
+// payment moves its order to paid. Fails with ErrPaymentSettled when the payment was settled concurrently.
+// Returns the order after the payment
+func (pr *paymentRepository) SettlePayment(ctx context.Context, payment domain.Payment) (*domain.Order, error) {
+	var res domain.Order
+
+	err := pr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&payment).Where("status = ?", domain.PaymentStatusPending).
+			UpdateColumns(domain.Payment{Status: payment.Status, SettledAt: payment.SettledAt})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrPaymentSettled
+		}
+
+		if payment.Status == domain.PaymentStatusSucceeded {
+			order := domain.Order{Status: domain.OrderStatusPaid}
+			order.ID = payment.OrderID
+			// an order cancelled while the buyer was paying stays cancelled, the payment is kept to be refunded
+			if err := changeOrderStatus(tx, order); err != nil && !errors.Is(err, domain.ErrInvalidOrderStatus) {
+				return err
+			}
+		}
+
+		return tx.First(&res, payment.OrderID).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.OrderDateStr = time.Time(res.OrderDate).Format(domain.OrderDateFormat)
+	return &res, nil
+}
+
+// PublishPaymentEvent
+func (pr *paymentRepository) PublishPaymentEvent(ctx context.Context, event domain.PayloadEventPayment) error {
+	return pr.publisher.Publish(ctx, messagequeue.PublishConfig{}, event)
+}
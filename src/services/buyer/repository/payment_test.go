@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+func Test_paymentRepository_SettlePayment(t *testing.T) {
+	settledAt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	payment := func(status string) domain.Payment {
+		return domain.Payment{Model: yugabyte.Model{ID: 3}, OrderID: 1, Status: status, SettledAt: &settledAt}
+	}
+	updatePayment := regexp.QuoteMeta(`UPDATE "payments" SET "status"=$1,"settled_at"=$2 WHERE status = $3 AND "payments"."deleted_at" IS NULL AND "id" = $4`)
+	updateOrder := regexp.QuoteMeta(`UPDATE "orders" SET "status"=$1 WHERE status IN ($2) AND "orders"."deleted_at" IS NULL AND "id" = $3`)
+	selectOrder := regexp.QuoteMeta(`SELECT * FROM "orders" WHERE "orders"."id" = $1 AND "orders"."deleted_at" IS NULL ORDER BY "orders"."id" LIMIT 1`)
+
+	tests := []struct {
+		name       string
+		payment    domain.Payment
+		wantStatus string
+		wantErr    error
+		mock       func()
+	}{
+		{
+			name:       "successful payment pays the order",
+			payment:    payment(domain.PaymentStatusSucceeded),
+			wantStatus: domain.OrderStatusPaid,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(updatePayment).WithArgs(domain.PaymentStatusSucceeded, settledAt, domain.PaymentStatusPending, 3).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(updateOrder).WithArgs(domain.OrderStatusPaid, domain.OrderStatusNew, 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(selectOrder).WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "status"}).AddRow(1, domain.OrderStatusPaid))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:       "successful payment of a cancelled order",
+			payment:    payment(domain.PaymentStatusSucceeded),
+			wantStatus: domain.OrderStatusCancelled,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(updatePayment).WithArgs(domain.PaymentStatusSucceeded, settledAt, domain.PaymentStatusPending, 3).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(updateOrder).WithArgs(domain.OrderStatusPaid, domain.OrderStatusNew, 1).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(selectOrder).WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "status"}).AddRow(1, domain.OrderStatusCancelled))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:       "failed payment leaves the order unpaid",
+			payment:    payment(domain.PaymentStatusFailed),
+			wantStatus: domain.OrderStatusNew,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(updatePayment).WithArgs(domain.PaymentStatusFailed, settledAt, domain.PaymentStatusPending, 3).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(selectOrder).WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "status"}).AddRow(1, domain.OrderStatusNew))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "payment settled concurrently",
+			payment: payment(domain.PaymentStatusSucceeded),
+			wantErr: domain.ErrPaymentSettled,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(updatePayment).WithArgs(domain.PaymentStatusSucceeded, settledAt, domain.PaymentStatusPending, 3).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			res, err := NewPaymentRepository(gormdb, nil).SettlePayment(context.TODO(), tt.payment)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantStatus, res.Status)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"gorm.io/gorm"
+)
+
+// ProductRepository, interface for product catalog repository
+type ProductRepository interface {
+	CreateProduct(ctx context.Context, product domain.Product) (*domain.Product, error)
+	UpdateProduct(ctx context.Context, product domain.Product) (*domain.Product, error)
+	DeleteProduct(ctx context.Context, id uint) error
+	GetProductBySKU(ctx context.Context, sellerId uint, sku string) (*domain.Product, error)
+	GetCategories(ctx context.Context) ([]domain.Category, error)
+	GetCategoryByID(ctx context.Context, id uint) (*domain.Category, error)
+	GetCategoryByName(ctx context.Context, name string) (*domain.Category, error)
+	CreateCategory(ctx context.Context, category domain.Category) (*domain.Category, error)
+}
+
+// productRepository, concrete implementation of product repository
+type productRepository struct {
+	db *gorm.DB
+}
+
+// NewProductRepository, constructor function for product repository
+func NewProductRepository(db *gorm.DB) ProductRepository {
+	return &productRepository{
+		db: db,
+	}
+}
+
+// CreateProduct, insert product entity along with its images into database
+func (pr *productRepository) CreateProduct(ctx context.Context, product domain.Product) (*domain.Product, error) {
+	if err := pr.db.WithContext(ctx).Omit("Category").Create(&product).Error; err != nil {
+		return nil, err
+	}
+	return &product, nil
+}
+
+// UpdateProduct, updates product attributes and replaces its images
+func (pr *productRepository) UpdateProduct(ctx context.Context, product domain.Product) (*domain.Product, error) {
+	err := pr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Select forces zero values such as an emptied description to be written as well
+		if err := tx.Model(&product).Select("SKU", "ProductName", "Description", "price_amount", "price_currency", "Stock", "CategoryID").Updates(&product).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Where("product_id = ?", product.ID).Delete(&domain.ProductImage{}).Error; err != nil {
+			return err
+		}
+
+		for i := range product.Images {
+			product.Images[i].ID = 0
+			product.Images[i].ProductID = product.ID
+		}
+		if len(product.Images) > 0 {
+			if err := tx.Create(&product.Images).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &product, nil
+}
+
+// DeleteProduct, soft deletes a product, order history keeps referring to it
+func (pr *productRepository) DeleteProduct(ctx context.Context, id uint) error {
+	return pr.db.WithContext(ctx).Delete(&domain.Product{}, id).Error
+}
+
+// GetProductBySKU, gets a product of a seller by its sku
+func (pr *productRepository) GetProductBySKU(ctx context.Context, sellerId uint, sku string) (*domain.Product, error) {
+	var res domain.Product
+
+	query := pr.db.WithContext(ctx)
+	if err := query.Where("seller_id = ? AND sku = ?", sellerId, sku).First(&res).Error; err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	} else if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+
+	return &res, nil
+}
+
+// GetCategories, gets every category ordered by name
+func (pr *productRepository) GetCategories(ctx context.Context) ([]domain.Category, error) {
+	var res []domain.Category
+
+	if err := pr.db.WithContext(ctx).Order("name").Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetCategoryByID
+func (pr *productRepository) GetCategoryByID(ctx context.Context, id uint) (*domain.Category, error) {
+	var res domain.Category
+
+	query := pr.db.WithContext(ctx)
+	if err := query.Where("id = ?", id).First(&res).Error; err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	} else if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+
+	return &res, nil
+}
+
+// GetCategoryByName
+func (pr *productRepository) GetCategoryByName(ctx context.Context, name string) (*domain.Category, error) {
+	var res domain.Category
+
+	query := pr.db.WithContext(ctx)
+	if err := query.Where("name = ?", name).First(&res).Error; err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	} else if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+
+	return &res, nil
+}
+
+// CreateCategory, insert category entity into database
+func (pr *productRepository) CreateCategory(ctx context.Context, category domain.Category) (*domain.Category, error) {
+	if err := pr.db.WithContext(ctx).Create(&category).Error; err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/messagequeue"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
 	"go.uber.org/fx"
 	"gorm.io/gorm"
@@ -14,15 +15,29 @@ var Module = fx.Options(
 	fx.Provide(yugabyte.NewDatabase),
 	fx.Provide(messagequeue.NewRabbitMQ),
 	fx.Provide(messagequeue.NewRabbitMQPublisher[domain.PayloadEventOrder]),
+	fx.Provide(fx.Annotate(messagequeue.NewRabbitMQPublisher[domain.PayloadEventLowStock], fx.ParamTags(`name:"stockPublisher"`))),
+	fx.Provide(fx.Annotate(messagequeue.NewRabbitMQPublisher[domain.PayloadEventShipment], fx.ParamTags(`name:"shipmentPublisher"`))),
+	fx.Provide(fx.Annotate(messagequeue.NewRabbitMQPublisher[domain.PayloadEventPayment], fx.ParamTags(`name:"paymentPublisher"`))),
+	fx.Provide(fx.Annotate(messagequeue.NewRabbitMQPublisher[domain.PayloadEventReview], fx.ParamTags(`name:"reviewPublisher"`))),
+	fx.Provide(fx.Annotate(messagequeue.NewRabbitMQPublisher[domain.PayloadEventFunnel], fx.ParamTags(`name:"funnelPublisher"`))),
 	fx.Provide(NewBuyerRepository),
+	fx.Provide(NewAddressRepository),
 	fx.Provide(NewOrderRepository),
+	fx.Provide(NewShipmentRepository),
+	fx.Provide(NewPaymentRepository),
+	fx.Provide(NewPaymentGateway),
+	fx.Provide(NewCartRepository),
+	fx.Provide(NewVoucherRepository),
+	fx.Provide(NewReviewRepository),
+	fx.Provide(NewProductRepository),
+	fx.Provide(NewSellerRepository),
 	fx.Invoke(AutoMigrateEntities),
 	fx.Invoke(PrepareProductData),
 )
 
 // AutoMigrateEntities, auto migrate database schema from domain models to database
 func AutoMigrateEntities(db *gorm.DB) error {
-	if err := db.AutoMigrate(&domain.Buyer{}, &domain.Order{}, &domain.OrderDetail{}, &domain.Product{}); err != nil {
+	if err := db.AutoMigrate(&domain.Buyer{}, &domain.Address{}, &domain.Seller{}, &domain.Order{}, &domain.OrderDetail{}, &domain.Shipment{}, &domain.Payment{}, &domain.Product{}, &domain.ProductImage{}, &domain.Category{}, &domain.Cart{}, &domain.CartItem{}, &domain.InvoiceSequence{}, &domain.Voucher{}, &domain.Review{}); err != nil {
 		return err
 	}
 	return nil
@@ -32,13 +47,21 @@ func PrepareProductData(db *gorm.DB) error {
 	//var products []domain.Product
 
 	for i := 0; i < 2; i++ {
+		seller := domain.Seller{
+			Name: "Seller " + strconv.Itoa(i+1),
+		}
+		if err := db.Where(seller).FirstOrCreate(&seller).Error; err != nil {
+			return err
+		}
+
 		product := domain.Product{
-			Price:       100,
+			SellerID:    seller.ID,
+			Price:       money.New(10000, money.DefaultCurrency),
 			ProductName: "Product " + strconv.Itoa(i+1),
 		}
 
 		//products = append(products, product)
-		if err := db.Where(product).FirstOrCreate(&product).Error; err != nil {
+		if err := db.Where(product).Attrs(domain.Product{Stock: 100}).FirstOrCreate(&product).Error; err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/messagequeue"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReviewRepository, interface for review repository
+type ReviewRepository interface {
+	CreateReview(ctx context.Context, review domain.Review) (*domain.Review, error)
+	GetProductReviews(ctx context.Context, productId uint) ([]domain.Review, error)
+	PublishReviewEvent(ctx context.Context, event domain.PayloadEventReview) error
+}
+
+// reviewRepository, concrete implementation of review repository
+type reviewRepository struct {
+	db        *gorm.DB
+	publisher messagequeue.Publisher[domain.PayloadEventReview]
+}
+
+// NewReviewRepository, constructor function for review repository
+func NewReviewRepository(db *gorm.DB, publisher messagequeue.Publisher[domain.PayloadEventReview]) ReviewRepository {
+	return &reviewRepository{
+		db:        db,
+		publisher: publisher,
+	}
+}
+
+// CreateReview, inserts a review, fails with ErrAlreadyReviewed when the product of the order was already reviewed
+func (rr *reviewRepository) CreateReview(ctx context.Context, review domain.Review) (*domain.Review, error) {
+	// the unique index on order and product makes sure concurrent reviews only count once
+	result := rr.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&review)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, domain.ErrAlreadyReviewed
+	}
+	return &review, nil
+}
+
+// GetProductReviews, gets the reviews of a product, the newest first
+func (rr *reviewRepository) GetProductReviews(ctx context.Context, productId uint) ([]domain.Review, error) {
+	var res []domain.Review
+
+	query := rr.db.WithContext(ctx)
+	if err := query.Where("product_id = ?", productId).Order("id DESC").Find(&res).Error; err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// PublishReviewEvent
+func (rr *reviewRepository) PublishReviewEvent(ctx context.Context, event domain.PayloadEventReview) error {
+	return rr.publisher.Publish(ctx, messagequeue.PublishConfig{}, event)
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+func Test_reviewRepository_CreateReview(t *testing.T) {
+	review := domain.Review{OrderID: 1, ProductID: 7, BuyerID: 1, SellerID: 3, Rating: 4, Comment: "good"}
+	query := `INSERT INTO "reviews" ("created_at","updated_at","deleted_at","order_id","product_id","buyer_id","seller_id","rating","comment") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) ON CONFLICT DO NOTHING RETURNING "id"`
+
+	tests := []struct {
+		name    string
+		wantErr error
+		mock    func()
+	}{
+		{
+			name: "success",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), uint(1), uint(7), uint(1), uint(3), 4, "good").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "already reviewed",
+			wantErr: domain.ErrAlreadyReviewed,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), uint(1), uint(7), uint(1), uint(3), 4, "good").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectCommit()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			rr := NewReviewRepository(gormdb, nil)
+			res, err := rr.CreateReview(context.TODO(), review)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, res)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, uint(1), res.ID)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
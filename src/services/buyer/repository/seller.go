@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"gorm.io/gorm"
+)
+
+// SellerRepository, interface for seller repository
+type SellerRepository interface {
+	Get(ctx context.Context, id uint) (*domain.Seller, error)
+	GetByName(ctx context.Context, name string) (*domain.Seller, error)
+	Create(ctx context.Context, seller domain.Seller) (*domain.Seller, error)
+}
+
+// sellerRepository, concrete implementation of seller repository
+type sellerRepository struct {
+	db *gorm.DB
+}
+
+// NewSellerRepository, constructor function for seller repository
+func NewSellerRepository(db *gorm.DB) SellerRepository {
+	return &sellerRepository{
+		db: db,
+	}
+}
+
+// Get, gets seller by primary key
+func (sr *sellerRepository) Get(ctx context.Context, id uint) (*domain.Seller, error) {
+	var res domain.Seller
+
+	query := sr.db.WithContext(ctx)
+	if err := query.First(&res, id).Error; err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	} else if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &res, nil
+}
+
+// GetByName, gets seller by name
+func (sr *sellerRepository) GetByName(ctx context.Context, name string) (*domain.Seller, error) {
+	var res domain.Seller
+
+	query := sr.db.WithContext(ctx)
+	if err := query.Where("name = ?", name).First(&res).Error; err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	} else if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &res, nil
+}
+
+// Create, insert seller entity into database
+func (sr *sellerRepository) Create(ctx context.Context, seller domain.Seller) (*domain.Seller, error) {
+	if err := sr.db.WithContext(ctx).Create(&seller).Error; err != nil {
+		return nil, err
+	}
+	return &seller, nil
+}
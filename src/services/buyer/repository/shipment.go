@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/messagequeue"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"gorm.io/gorm"
+)
+
+// ShipmentRepository, interface for shipment repository
+type ShipmentRepository interface {
+	ShipOrder(ctx context.Context, order domain.Order, shipment domain.Shipment) (*domain.Shipment, error)
+	DeliverOrder(ctx context.Context, order domain.Order, deliveredAt time.Time) (*domain.Shipment, error)
+	PublishShipmentEvent(ctx context.Context, event domain.PayloadEventShipment) error
+}
+
+// shipmentRepository, concrete implementation of shipment repository
+type shipmentRepository struct {
+	db        *gorm.DB
+	publisher messagequeue.Publisher[domain.PayloadEventShipment]
+}
+
+// NewShipmentRepository, constructor function for shipment repository
+func NewShipmentRepository(db *gorm.DB, publisher messagequeue.Publisher[domain.PayloadEventShipment]) ShipmentRepository {
+	return &shipmentRepository{
+		db:        db,
+		publisher: publisher,
+	}
+}
+
+// ShipOrder, marks a paid order as shipped and inserts its shipment in a single transaction
+func (sr *shipmentRepository) ShipOrder(ctx context.Context, order domain.Order, shipment domain.Shipment) (*domain.Shipment, error) {
+	order.Status = domain.OrderStatusShipped
+	err := sr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := changeOrderStatus(tx, order); err != nil {
+			return err
+		}
+		return tx.Create(&shipment).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &shipment, nil
+}
+
+// DeliverOrder, marks a shipped order as delivered at deliveredAt in a single transaction
+func (sr *shipmentRepository) DeliverOrder(ctx context.Context, order domain.Order, deliveredAt time.Time) (*domain.Shipment, error) {
+	var res domain.Shipment
+
+	order.Status = domain.OrderStatusDelivered
+	err := sr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := changeOrderStatus(tx, order); err != nil {
+			return err
+		}
+		if err := tx.Model(&domain.Shipment{}).Where("order_id = ?", order.ID).Update("delivered_at", deliveredAt).Error; err != nil {
+			return err
+		}
+		return tx.Where("order_id = ?", order.ID).First(&res).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// PublishShipmentEvent
+func (sr *shipmentRepository) PublishShipmentEvent(ctx context.Context, event domain.PayloadEventShipment) error {
+	return sr.publisher.Publish(ctx, messagequeue.PublishConfig{}, event)
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+func Test_shipmentRepository_ShipOrder(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr error
+		mock    func()
+	}{
+		{
+			name: "success",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "orders" SET "status"=$1 WHERE status IN ($2) AND "orders"."deleted_at" IS NULL AND "id" = $3`)).
+					WithArgs(domain.OrderStatusShipped, domain.OrderStatusPaid, 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "shipments"`)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "order is no longer paid",
+			wantErr: domain.ErrInvalidOrderStatus,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "orders" SET "status"=$1 WHERE status IN ($2) AND "orders"."deleted_at" IS NULL AND "id" = $3`)).
+					WithArgs(domain.OrderStatusShipped, domain.OrderStatusPaid, 1).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			res, err := NewShipmentRepository(gormdb, nil).ShipOrder(context.TODO(),
+				domain.Order{Model: yugabyte.Model{ID: 1}, Status: domain.OrderStatusPaid},
+				domain.Shipment{OrderID: 1, Courier: "JNE", TrackingNumber: "JNE123", ShippedAt: time.Now()})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, uint(1), res.ID)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"gorm.io/gorm"
+)
+
+// VoucherRepository, interface for voucher repository
+type VoucherRepository interface {
+	CreateVoucher(ctx context.Context, voucher domain.Voucher) (*domain.Voucher, error)
+	GetVouchers(ctx context.Context, sellerId uint) ([]domain.Voucher, error)
+	GetVoucherByCode(ctx context.Context, code string) (*domain.Voucher, error)
+}
+
+// voucherRepository, concrete implementation of voucher repository
+type voucherRepository struct {
+	db *gorm.DB
+}
+
+// NewVoucherRepository, constructor function for voucher repository
+func NewVoucherRepository(db *gorm.DB) VoucherRepository {
+	return &voucherRepository{
+		db: db,
+	}
+}
+
+// CreateVoucher, insert voucher entity into database
+func (vr *voucherRepository) CreateVoucher(ctx context.Context, voucher domain.Voucher) (*domain.Voucher, error) {
+	if err := vr.db.WithContext(ctx).Create(&voucher).Error; err != nil {
+		return nil, err
+	}
+	return &voucher, nil
+}
+
+// GetVouchers, gets the vouchers of a seller, the newest first
+func (vr *voucherRepository) GetVouchers(ctx context.Context, sellerId uint) ([]domain.Voucher, error) {
+	var res []domain.Voucher
+
+	query := vr.db.WithContext(ctx)
+	if err := query.Where("seller_id = ?", sellerId).Order("id DESC").Find(&res).Error; err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetVoucherByCode, gets a voucher by its code, returns nil when there is no such voucher
+func (vr *voucherRepository) GetVoucherByCode(ctx context.Context, code string) (*domain.Voucher, error) {
+	var res domain.Voucher
+
+	query := vr.db.WithContext(ctx)
+	if err := query.Where("code = ?", code).First(&res).Error; err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	} else if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+
+	return &res, nil
+}
+
+// redeemVoucher, counts a use of the voucher of the order, the usage guard makes sure concurrent orders never use
+// the voucher more often than its limit, fails with ErrVoucherUsedUp when the limit is reached
+func redeemVoucher(tx *gorm.DB, order domain.Order) error {
+	if order.VoucherID == nil {
+		return nil
+	}
+
+	result := tx.Model(&domain.Voucher{}).
+		Where("id = ?", *order.VoucherID).
+		Where("usage_limit = 0 OR used_count < usage_limit").
+		UpdateColumn("used_count", gorm.Expr("used_count + 1"))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: %s", domain.ErrVoucherUsedUp, order.VoucherCode)
+	}
+	return nil
+}
+
+// releaseVoucher, gives the use of the voucher of a cancelled order back
+func releaseVoucher(tx *gorm.DB, order domain.Order) error {
+	if order.VoucherID == nil {
+		return nil
+	}
+
+	return tx.Unscoped().Model(&domain.Voucher{}).
+		Where("id = ? AND used_count > 0", *order.VoucherID).
+		UpdateColumn("used_count", gorm.Expr("used_count - 1")).Error
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+func Test_redeemVoucher(t *testing.T) {
+	voucherId := uint(5)
+
+	tests := []struct {
+		name    string
+		order   domain.Order
+		wantErr error
+		mock    func()
+	}{
+		{
+			name:  "order without voucher",
+			order: domain.Order{},
+			mock:  func() {},
+		},
+		{
+			name:  "success",
+			order: domain.Order{VoucherID: &voucherId, VoucherCode: "PROMO"},
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "vouchers" SET "used_count"=used_count + 1 WHERE id = $1 AND (usage_limit = 0 OR used_count < usage_limit)`)).
+					WithArgs(voucherId).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "voucher used up",
+			order:   domain.Order{VoucherID: &voucherId, VoucherCode: "PROMO"},
+			wantErr: domain.ErrVoucherUsedUp,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "vouchers" SET "used_count"=used_count + 1 WHERE id = $1 AND (usage_limit = 0 OR used_count < usage_limit)`)).
+					WithArgs(voucherId).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			err := redeemVoucher(gormdb, tt.order)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
@@ -11,15 +12,23 @@ import (
 type BuyerUsecase interface {
 	IsUserAuthenticated(ctx context.Context, buyerId uint) (bool, error)
 	Login(ctx context.Context, username string) (*domain.Buyer, error)
+	Profile(ctx context.Context, buyerId uint) (*domain.Buyer, error)
+	UpdateProfile(ctx context.Context, buyer domain.Buyer) (*domain.Buyer, error)
+	Deactivate(ctx context.Context, buyerId uint) error
+	Addresses(ctx context.Context, buyerId uint) ([]domain.Address, error)
+	SaveAddress(ctx context.Context, address domain.Address) (*domain.Address, error)
+	DeleteAddress(ctx context.Context, buyerId uint, id uint) error
 }
 
 type buyerUsecase struct {
-	buyerRepo repository.BuyerRepository
+	buyerRepo   repository.BuyerRepository
+	addressRepo repository.AddressRepository
 }
 
-func NewBuyerUsecase(buyerRepo repository.BuyerRepository) BuyerUsecase {
+func NewBuyerUsecase(buyerRepo repository.BuyerRepository, addressRepo repository.AddressRepository) BuyerUsecase {
 	return &buyerUsecase{
-		buyerRepo: buyerRepo,
+		buyerRepo:   buyerRepo,
+		addressRepo: addressRepo,
 	}
 }
 
@@ -33,7 +42,7 @@ func (bu *buyerUsecase) IsUserAuthenticated(ctx context.Context, buyerId uint) (
 		return false, err
 	}
 
-	if res == nil {
+	if res == nil || res.IsDeactivated() {
 		return false, nil
 	}
 	return true, nil
@@ -54,5 +63,76 @@ func (bu *buyerUsecase) Login(ctx context.Context, username string) (*domain.Buy
 			return nil, err
 		}
 	}
+
+	if res.IsDeactivated() {
+		return nil, domain.ErrAccountDeactivated
+	}
+	return res, nil
+}
+
+// Profile, returns the profile of a buyer
+func (bu *buyerUsecase) Profile(ctx context.Context, buyerId uint) (*domain.Buyer, error) {
+	res, err := bu.buyerRepo.Get(ctx, domain.Buyer{Model: yugabyte.Model{ID: buyerId}})
+	if err != nil {
+		return nil, err
+	}
+
+	if res == nil {
+		return nil, domain.ErrNotFound
+	}
+	return res, nil
+}
+
+// UpdateProfile, overwrites the name, email and phone of a buyer
+func (bu *buyerUsecase) UpdateProfile(ctx context.Context, buyer domain.Buyer) (*domain.Buyer, error) {
+	res, err := bu.buyerRepo.UpdateProfile(ctx, buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	if res == nil {
+		return nil, domain.ErrNotFound
+	}
 	return res, nil
 }
+
+// Deactivate, closes the account of a buyer, the orders are kept for the sellers' records
+func (bu *buyerUsecase) Deactivate(ctx context.Context, buyerId uint) error {
+	return bu.buyerRepo.Deactivate(ctx, buyerId, time.Now())
+}
+
+// Addresses, returns the address book of a buyer, the default address first
+func (bu *buyerUsecase) Addresses(ctx context.Context, buyerId uint) ([]domain.Address, error) {
+	return bu.addressRepo.GetAddresses(ctx, buyerId)
+}
+
+// SaveAddress, adds an address to the buyer's address book or updates one when address.ID is set, the
+// first address of a buyer becomes the default
+func (bu *buyerUsecase) SaveAddress(ctx context.Context, address domain.Address) (*domain.Address, error) {
+	if address.ID != 0 {
+		existing, err := bu.addressRepo.GetAddress(ctx, address.BuyerID, address.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if existing == nil {
+			return nil, domain.ErrNotFound
+		}
+		address.Model = existing.Model
+	}
+
+	return bu.addressRepo.SaveAddress(ctx, address)
+}
+
+// DeleteAddress, removes an address from the buyer's address book, orders shipped to it keep their copy
+func (bu *buyerUsecase) DeleteAddress(ctx context.Context, buyerId uint, id uint) error {
+	address, err := bu.addressRepo.GetAddress(ctx, buyerId, id)
+	if err != nil {
+		return err
+	}
+
+	if address == nil {
+		return domain.ErrNotFound
+	}
+	return bu.addressRepo.DeleteAddress(ctx, *address)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -18,6 +19,8 @@ func Test_IsUserAuthenticated(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
+	deactivatedAt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
 	tests := []struct {
 		name    string
 		buyerId uint
@@ -54,6 +57,23 @@ func Test_IsUserAuthenticated(t *testing.T) {
 				return m
 			},
 		},
+		{
+			name:    "user deactivated",
+			buyerId: 1,
+			want:    false,
+			repo: func() repository.BuyerRepository {
+				m := mocks.NewMockBuyerRepository(ctrl)
+				m.EXPECT().Get(gomock.Any(), domain.Buyer{
+					Model: yugabyte.Model{
+						ID: 1,
+					},
+				}).Return(&domain.Buyer{
+					Username:      "some user",
+					DeactivatedAt: &deactivatedAt,
+				}, nil)
+				return m
+			},
+		},
 		{
 			name:    "user authenticated",
 			buyerId: 1,
@@ -74,7 +94,7 @@ func Test_IsUserAuthenticated(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			sut := NewBuyerUsecase(tt.repo())
+			sut := NewBuyerUsecase(tt.repo(), nil)
 
 			res, err := sut.IsUserAuthenticated(context.TODO(), tt.buyerId)
 			if tt.wantErr {
@@ -92,6 +112,8 @@ func Test_Login(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
+	deactivatedAt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
 	tests := []struct {
 		name     string
 		username string
@@ -137,6 +159,17 @@ func Test_Login(t *testing.T) {
 				return m
 			},
 		},
+		{
+			name:     "deactivated user",
+			username: "testuser",
+			wantErr:  true,
+			want:     nil,
+			repo: func() repository.BuyerRepository {
+				m := mocks.NewMockBuyerRepository(ctrl)
+				m.EXPECT().GetByUsername(gomock.Any(), "testuser").Return(&domain.Buyer{Username: "testuser", DeactivatedAt: &deactivatedAt}, nil)
+				return m
+			},
+		},
 		{
 			name:     "error creating new user",
 			username: "testuser",
@@ -155,7 +188,7 @@ func Test_Login(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			sut := NewBuyerUsecase(tt.repo())
+			sut := NewBuyerUsecase(tt.repo(), nil)
 
 			res, err := sut.Login(context.TODO(), tt.username)
 			if tt.wantErr {
@@ -168,3 +201,111 @@ func Test_Login(t *testing.T) {
 		})
 	}
 }
+
+func Test_buyerUsecase_SaveAddress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	createdAt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	address := domain.Address{
+		BuyerID:         1,
+		Label:           "home",
+		ShippingAddress: domain.ShippingAddress{RecipientName: "Budi", City: "Jakarta", Country: "ID"},
+	}
+
+	tests := []struct {
+		name    string
+		address func() domain.Address
+		wantErr error
+		repo    func() repository.AddressRepository
+	}{
+		{
+			name:    "new address",
+			address: func() domain.Address { return address },
+			repo: func() repository.AddressRepository {
+				m := mocks.NewMockAddressRepository(ctrl)
+				m.EXPECT().SaveAddress(gomock.Any(), address).Return(&address, nil)
+				return m
+			},
+		},
+		{
+			name: "update keeps the creation time",
+			address: func() domain.Address {
+				a := address
+				a.ID = 3
+				return a
+			},
+			repo: func() repository.AddressRepository {
+				m := mocks.NewMockAddressRepository(ctrl)
+				existing := address
+				existing.Model = yugabyte.Model{ID: 3, CreatedAt: createdAt}
+				m.EXPECT().GetAddress(gomock.Any(), uint(1), uint(3)).Return(&existing, nil)
+				m.EXPECT().SaveAddress(gomock.Any(), existing).Return(&existing, nil)
+				return m
+			},
+		},
+		{
+			name: "address of another buyer",
+			address: func() domain.Address {
+				a := address
+				a.ID = 4
+				return a
+			},
+			wantErr: domain.ErrNotFound,
+			repo: func() repository.AddressRepository {
+				m := mocks.NewMockAddressRepository(ctrl)
+				m.EXPECT().GetAddress(gomock.Any(), uint(1), uint(4)).Return(nil, nil)
+				return m
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sut := NewBuyerUsecase(nil, tt.repo())
+
+			_, err := sut.SaveAddress(context.TODO(), tt.address())
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func Test_buyerUsecase_DeleteAddress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name    string
+		wantErr error
+		repo    func() repository.AddressRepository
+	}{
+		{
+			name: "success",
+			repo: func() repository.AddressRepository {
+				m := mocks.NewMockAddressRepository(ctrl)
+				address := &domain.Address{Model: yugabyte.Model{ID: 3}, BuyerID: 1, IsDefault: true}
+				m.EXPECT().GetAddress(gomock.Any(), uint(1), uint(3)).Return(address, nil)
+				m.EXPECT().DeleteAddress(gomock.Any(), *address).Return(nil)
+				return m
+			},
+		},
+		{
+			name:    "address of another buyer",
+			wantErr: domain.ErrNotFound,
+			repo: func() repository.AddressRepository {
+				m := mocks.NewMockAddressRepository(ctrl)
+				m.EXPECT().GetAddress(gomock.Any(), uint(1), uint(3)).Return(nil, nil)
+				return m
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sut := NewBuyerUsecase(nil, tt.repo())
+
+			err := sut.DeleteAddress(context.TODO(), 1, 3)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
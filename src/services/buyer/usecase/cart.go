@@ -0,0 +1,249 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/validation"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/repository"
+	"gorm.io/datatypes"
+)
+
+type CartUsecase interface {
+	Cart(ctx context.Context, buyerId uint) (*domain.Cart, error)
+	AddItem(ctx context.Context, buyerId uint, productId uint, quantity int) (*domain.Cart, error)
+	UpdateItem(ctx context.Context, buyerId uint, productId uint, quantity int) (*domain.Cart, error)
+	RemoveItem(ctx context.Context, buyerId uint, productId uint) (*domain.Cart, error)
+	Checkout(ctx context.Context, buyerId uint, addressId *uint, voucherCode string) ([]domain.Order, error)
+}
+
+type cartUsecase struct {
+	cartRepo     repository.CartRepository
+	orderRepo    repository.OrderRepository
+	addressRepo  repository.AddressRepository
+	voucherRepo  repository.VoucherRepository
+	inventoryCfg domain.InventoryConfig
+}
+
+func NewCartUsecase(cartRepo repository.CartRepository, orderRepo repository.OrderRepository, addressRepo repository.AddressRepository, voucherRepo repository.VoucherRepository, inventoryCfg domain.InventoryConfig) CartUsecase {
+	return &cartUsecase{
+		cartRepo:     cartRepo,
+		orderRepo:    orderRepo,
+		addressRepo:  addressRepo,
+		voucherRepo:  voucherRepo,
+		inventoryCfg: inventoryCfg,
+	}
+}
+
+// Cart returns the cart of a buyer along with its totals, an empty cart is created on first access
+func (cu *cartUsecase) Cart(ctx context.Context, buyerId uint) (*domain.Cart, error) {
+	cart, err := cu.getOrCreateCart(ctx, buyerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return calculateCartTotals(cart)
+}
+
+// AddItem adds quantity of a product into the cart, adding a product already in the cart increases its quantity
+func (cu *cartUsecase) AddItem(ctx context.Context, buyerId uint, productId uint, quantity int) (*domain.Cart, error) {
+	product, err := cu.orderRepo.GetProductByID(ctx, productId)
+	if err != nil {
+		return nil, err
+	}
+
+	if product == nil {
+		return nil, validation.NewError("product_id", "product does not exist")
+	}
+
+	cart, err := cu.getOrCreateCart(ctx, buyerId)
+	if err != nil {
+		return nil, err
+	}
+
+	// a product deleted since it was put in the cart has no price left, its empty currency is not a mismatch
+	for _, item := range cart.Items {
+		if item.Product.ID == 0 {
+			return nil, domain.ErrNotFound
+		}
+	}
+
+	// the cart totals are paid in a single currency
+	if len(cart.Items) > 0 && cart.Items[0].Product.Price.Currency != product.Price.Currency {
+		return nil, validation.NewError("product_id", "price currency differs from the other products in the cart")
+	}
+
+	if item := findCartItem(cart, productId); item != nil {
+		quantity += item.Quantity
+	}
+
+	_, err = cu.cartRepo.SaveCartItem(ctx, domain.CartItem{
+		CartID:    cart.ID,
+		ProductID: productId,
+		Quantity:  quantity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	publishFunnelEvent(ctx, cu.orderRepo, domain.FunnelStepCart, product.SellerID, product.ID, time.Now())
+
+	return cu.Cart(ctx, buyerId)
+}
+
+// UpdateItem sets the quantity of a product already in the cart
+func (cu *cartUsecase) UpdateItem(ctx context.Context, buyerId uint, productId uint, quantity int) (*domain.Cart, error) {
+	cart, err := cu.getOrCreateCart(ctx, buyerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if findCartItem(cart, productId) == nil {
+		return nil, validation.NewError("product_id", "product is not in the cart")
+	}
+
+	_, err = cu.cartRepo.SaveCartItem(ctx, domain.CartItem{
+		CartID:    cart.ID,
+		ProductID: productId,
+		Quantity:  quantity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return cu.Cart(ctx, buyerId)
+}
+
+// RemoveItem removes a product from the cart
+func (cu *cartUsecase) RemoveItem(ctx context.Context, buyerId uint, productId uint) (*domain.Cart, error) {
+	cart, err := cu.getOrCreateCart(ctx, buyerId)
+	if err != nil {
+		return nil, err
+	}
+
+	found, err := cu.cartRepo.DeleteCartItem(ctx, cart.ID, productId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, validation.NewError("product_id", "product is not in the cart")
+	}
+
+	return cu.Cart(ctx, buyerId)
+}
+
+// Checkout converts the cart into orders, one order per seller, reserves their stock and empties the cart,
+// every order ships to the address addressId points to or to the buyer's default address when nil, the voucher
+// with voucherCode discounts the order of its seller
+func (cu *cartUsecase) Checkout(ctx context.Context, buyerId uint, addressId *uint, voucherCode string) ([]domain.Order, error) {
+	cart, err := cu.getOrCreateCart(ctx, buyerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cart.Items) == 0 {
+		return nil, validation.NewError("items", "cart is empty")
+	}
+
+	now := time.Now()
+	req := domain.Order{
+		OrderDate: datatypes.Date(now),
+		BuyerID:   buyerId,
+		Status:    domain.OrderStatusNew,
+		AddressID: addressId,
+	}
+	if err = applyShippingAddress(ctx, cu.addressRepo, &req); err != nil {
+		return nil, err
+	}
+
+	for _, v := range cart.Items {
+		req.OrderDetails = append(req.OrderDetails, domain.OrderDetail{
+			ProductID:       v.ProductID,
+			ProductQuantity: v.Quantity,
+		})
+	}
+
+	orders, err := buildSellerOrders(ctx, cu.orderRepo, req, "items[%d].product_id", "items[%d].quantity")
+	if err != nil {
+		return nil, err
+	}
+
+	// every attempt counts as a checkout, the ones failing on the voucher, the stock or the insert are the checkouts
+	// not placing an order in the checkout rate
+	for _, v := range orders {
+		publishFunnelEvent(ctx, cu.orderRepo, domain.FunnelStepCheckout, v.SellerID, 0, now)
+	}
+
+	if err = applyVoucher(ctx, cu.voucherRepo, orders, voucherCode, now); err != nil {
+		return nil, err
+	}
+
+	// the invoice numbers are issued when inserting the orders
+	res, err := cu.cartRepo.Checkout(ctx, cart.ID, orders)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range res {
+		if err = cu.orderRepo.PublishOrderEvent(ctx, newOrderEvent(v)); err != nil {
+			log.Println("error publishing order event", err)
+		}
+		publishLowStockEvents(ctx, cu.orderRepo, cu.inventoryCfg, v)
+	}
+
+	return res, nil
+}
+
+// getOrCreateCart, gets the buyer's cart and creates an empty one if the buyer has none yet
+func (cu *cartUsecase) getOrCreateCart(ctx context.Context, buyerId uint) (*domain.Cart, error) {
+	cart, err := cu.cartRepo.GetCartByBuyerID(ctx, buyerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if cart == nil {
+		cart, err = cu.cartRepo.CreateCart(ctx, domain.Cart{
+			BuyerID: buyerId,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return cart, nil
+}
+
+// findCartItem, finds the cart item of a product, returns nil when the product is not in the cart
+func findCartItem(cart *domain.Cart, productId uint) *domain.CartItem {
+	for i := range cart.Items {
+		if cart.Items[i].ProductID == productId {
+			return &cart.Items[i]
+		}
+	}
+	return nil
+}
+
+// calculateCartTotals, fills the subtotal of each item and the totals of the cart
+func calculateCartTotals(cart *domain.Cart) (*domain.Cart, error) {
+	cart.TotalQuantity = 0
+	cart.TotalAmount = money.Money{}
+
+	for i := range cart.Items {
+		item := &cart.Items[i]
+		item.Subtotal = item.Product.Price.Mul(int64(item.Quantity))
+
+		total, err := cart.TotalAmount.Add(item.Subtotal)
+		if err != nil {
+			return nil, err
+		}
+
+		cart.TotalQuantity += item.Quantity
+		cart.TotalAmount = total
+	}
+
+	return cart, nil
+}
@@ -0,0 +1,330 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/repository/mocks"
+)
+
+func Test_cartUsecase_Cart(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name    string
+		mock    func(cartRepo *mocks.MockCartRepository)
+		want    *domain.Cart
+		wantErr bool
+	}{
+		{
+			name: "error",
+			mock: func(cartRepo *mocks.MockCartRepository) {
+				cartRepo.EXPECT().GetCartByBuyerID(gomock.Any(), uint(1)).Return(nil, errors.New("mock error"))
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "create cart on first access",
+			mock: func(cartRepo *mocks.MockCartRepository) {
+				cartRepo.EXPECT().GetCartByBuyerID(gomock.Any(), uint(1)).Return(nil, nil)
+				cartRepo.EXPECT().CreateCart(gomock.Any(), domain.Cart{BuyerID: 1}).Return(&domain.Cart{
+					Model:   yugabyte.Model{ID: 1},
+					BuyerID: 1,
+				}, nil)
+			},
+			want: &domain.Cart{
+				Model:   yugabyte.Model{ID: 1},
+				BuyerID: 1,
+			},
+		},
+		{
+			name: "calculate totals",
+			mock: func(cartRepo *mocks.MockCartRepository) {
+				cartRepo.EXPECT().GetCartByBuyerID(gomock.Any(), uint(1)).Return(&domain.Cart{
+					Model:   yugabyte.Model{ID: 1},
+					BuyerID: 1,
+					Items: []domain.CartItem{
+						{ProductID: 1, Quantity: 2, Product: domain.Product{Price: money.New(100, "IDR")}},
+						{ProductID: 2, Quantity: 1, Product: domain.Product{Price: money.New(50, "IDR")}},
+					},
+				}, nil)
+			},
+			want: &domain.Cart{
+				Model:   yugabyte.Model{ID: 1},
+				BuyerID: 1,
+				Items: []domain.CartItem{
+					{ProductID: 1, Quantity: 2, Product: domain.Product{Price: money.New(100, "IDR")}, Subtotal: money.New(200, "IDR")},
+					{ProductID: 2, Quantity: 1, Product: domain.Product{Price: money.New(50, "IDR")}, Subtotal: money.New(50, "IDR")},
+				},
+				TotalQuantity: 3,
+				TotalAmount:   money.New(250, "IDR"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cartRepo := mocks.NewMockCartRepository(ctrl)
+			tt.mock(cartRepo)
+
+			sut := NewCartUsecase(cartRepo, mocks.NewMockOrderRepository(ctrl), nil, nil, domain.InventoryConfig{})
+			res, err := sut.Cart(context.TODO(), 1)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func Test_cartUsecase_AddItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cart := &domain.Cart{
+		Model:   yugabyte.Model{ID: 1},
+		BuyerID: 1,
+		Items: []domain.CartItem{
+			{CartID: 1, ProductID: 1, Quantity: 2, Product: domain.Product{Model: yugabyte.Model{ID: 1}, Price: money.New(100, "IDR")}},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		mock      func(cartRepo *mocks.MockCartRepository, orderRepo *mocks.MockOrderRepository)
+		wantErr   bool
+		wantErrIs error
+	}{
+		{
+			name: "product does not exist",
+			mock: func(cartRepo *mocks.MockCartRepository, orderRepo *mocks.MockOrderRepository) {
+				orderRepo.EXPECT().GetProductByID(gomock.Any(), uint(1)).Return(nil, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "product in the cart deleted",
+			mock: func(cartRepo *mocks.MockCartRepository, orderRepo *mocks.MockOrderRepository) {
+				orderRepo.EXPECT().GetProductByID(gomock.Any(), uint(1)).Return(&domain.Product{
+					Model: yugabyte.Model{ID: 1},
+					Price: money.New(100, "IDR"),
+				}, nil)
+				cartRepo.EXPECT().GetCartByBuyerID(gomock.Any(), uint(1)).Return(&domain.Cart{
+					Model:   yugabyte.Model{ID: 1},
+					BuyerID: 1,
+					Items: []domain.CartItem{
+						{CartID: 1, ProductID: 2, Quantity: 1},
+					},
+				}, nil)
+			},
+			wantErr:   true,
+			wantErrIs: domain.ErrNotFound,
+		},
+		{
+			name: "increase quantity of product in cart",
+			mock: func(cartRepo *mocks.MockCartRepository, orderRepo *mocks.MockOrderRepository) {
+				orderRepo.EXPECT().GetProductByID(gomock.Any(), uint(1)).Return(&domain.Product{
+					Model: yugabyte.Model{ID: 1},
+					Price: money.New(100, "IDR"),
+				}, nil)
+				cartRepo.EXPECT().GetCartByBuyerID(gomock.Any(), uint(1)).Return(cart, nil).Times(2)
+				cartRepo.EXPECT().SaveCartItem(gomock.Any(), domain.CartItem{
+					CartID:    1,
+					ProductID: 1,
+					Quantity:  5,
+				}).Return(&domain.CartItem{}, nil)
+				orderRepo.EXPECT().PublishFunnelEvent(gomock.Any(), funnelEventMatcher(domain.FunnelStepCart, 0, 1)).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cartRepo := mocks.NewMockCartRepository(ctrl)
+			orderRepo := mocks.NewMockOrderRepository(ctrl)
+			tt.mock(cartRepo, orderRepo)
+
+			sut := NewCartUsecase(cartRepo, orderRepo, nil, nil, domain.InventoryConfig{})
+			_, err := sut.AddItem(context.TODO(), 1, 1, 3)
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.wantErrIs != nil {
+					require.ErrorIs(t, err, tt.wantErrIs)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_cartUsecase_Checkout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	address := &domain.Address{
+		Model:           yugabyte.Model{ID: 3},
+		BuyerID:         1,
+		ShippingAddress: domain.ShippingAddress{RecipientName: "Budi", City: "Jakarta", Country: "ID"},
+		IsDefault:       true,
+	}
+	missingAddressId := uint(9)
+
+	tests := []struct {
+		name        string
+		mock        func(cartRepo *mocks.MockCartRepository, orderRepo *mocks.MockOrderRepository, addressRepo *mocks.MockAddressRepository, voucherRepo *mocks.MockVoucherRepository)
+		addressId   *uint
+		voucherCode string
+		wantSeller  []uint
+		wantAmount  []money.Money
+		wantErr     bool
+	}{
+		{
+			name: "empty cart",
+			mock: func(cartRepo *mocks.MockCartRepository, orderRepo *mocks.MockOrderRepository, addressRepo *mocks.MockAddressRepository, voucherRepo *mocks.MockVoucherRepository) {
+				cartRepo.EXPECT().GetCartByBuyerID(gomock.Any(), uint(1)).Return(&domain.Cart{
+					Model:   yugabyte.Model{ID: 1},
+					BuyerID: 1,
+				}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "split order per seller",
+			mock: func(cartRepo *mocks.MockCartRepository, orderRepo *mocks.MockOrderRepository, addressRepo *mocks.MockAddressRepository, voucherRepo *mocks.MockVoucherRepository) {
+				cartRepo.EXPECT().GetCartByBuyerID(gomock.Any(), uint(1)).Return(&domain.Cart{
+					Model:   yugabyte.Model{ID: 1},
+					BuyerID: 1,
+					Items: []domain.CartItem{
+						{ProductID: 1, Quantity: 1},
+						{ProductID: 2, Quantity: 2},
+						{ProductID: 3, Quantity: 1},
+					},
+				}, nil)
+				addressRepo.EXPECT().GetDefaultAddress(gomock.Any(), uint(1)).Return(address, nil)
+				orderRepo.EXPECT().GetProductByID(gomock.Any(), uint(1)).Return(&domain.Product{Model: yugabyte.Model{ID: 1}, SellerID: 1, Price: money.New(100, "IDR"), Stock: 10}, nil)
+				orderRepo.EXPECT().GetProductByID(gomock.Any(), uint(2)).Return(&domain.Product{Model: yugabyte.Model{ID: 2}, SellerID: 2, Price: money.New(100, "IDR"), Stock: 10}, nil)
+				orderRepo.EXPECT().GetProductByID(gomock.Any(), uint(3)).Return(&domain.Product{Model: yugabyte.Model{ID: 3}, SellerID: 1, Price: money.New(50, "IDR"), Stock: 10}, nil)
+				orderRepo.EXPECT().PublishFunnelEvent(gomock.Any(), funnelEventMatcher(domain.FunnelStepCheckout, 1, 0)).Return(nil)
+				orderRepo.EXPECT().PublishFunnelEvent(gomock.Any(), funnelEventMatcher(domain.FunnelStepCheckout, 2, 0)).Return(nil)
+				cartRepo.EXPECT().Checkout(gomock.Any(), uint(1), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, cartId uint, orders []domain.Order) ([]domain.Order, error) {
+						return orders, nil
+					})
+				orderRepo.EXPECT().PublishOrderEvent(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+			},
+			wantSeller: []uint{1, 2},
+			wantAmount: []money.Money{money.New(150, "IDR"), money.New(200, "IDR")},
+		},
+		{
+			name:        "voucher discounts the order of its seller",
+			voucherCode: "promo10",
+			mock: func(cartRepo *mocks.MockCartRepository, orderRepo *mocks.MockOrderRepository, addressRepo *mocks.MockAddressRepository, voucherRepo *mocks.MockVoucherRepository) {
+				cartRepo.EXPECT().GetCartByBuyerID(gomock.Any(), uint(1)).Return(&domain.Cart{
+					Model:   yugabyte.Model{ID: 1},
+					BuyerID: 1,
+					Items: []domain.CartItem{
+						{ProductID: 1, Quantity: 1},
+						{ProductID: 2, Quantity: 2},
+						{ProductID: 3, Quantity: 1},
+					},
+				}, nil)
+				addressRepo.EXPECT().GetDefaultAddress(gomock.Any(), uint(1)).Return(address, nil)
+				orderRepo.EXPECT().GetProductByID(gomock.Any(), uint(1)).Return(&domain.Product{Model: yugabyte.Model{ID: 1}, SellerID: 1, Price: money.New(100, "IDR"), Stock: 10}, nil)
+				orderRepo.EXPECT().GetProductByID(gomock.Any(), uint(2)).Return(&domain.Product{Model: yugabyte.Model{ID: 2}, SellerID: 2, Price: money.New(100, "IDR"), Stock: 10}, nil)
+				orderRepo.EXPECT().GetProductByID(gomock.Any(), uint(3)).Return(&domain.Product{Model: yugabyte.Model{ID: 3}, SellerID: 1, Price: money.New(50, "IDR"), Stock: 10}, nil)
+				orderRepo.EXPECT().PublishFunnelEvent(gomock.Any(), funnelEventMatcher(domain.FunnelStepCheckout, 1, 0)).Return(nil)
+				orderRepo.EXPECT().PublishFunnelEvent(gomock.Any(), funnelEventMatcher(domain.FunnelStepCheckout, 2, 0)).Return(nil)
+				voucherRepo.EXPECT().GetVoucherByCode(gomock.Any(), "PROMO10").Return(&domain.Voucher{
+					Model:      yugabyte.Model{ID: 5},
+					SellerID:   2,
+					Code:       "PROMO10",
+					Type:       domain.VoucherTypePercentage,
+					Percentage: 10,
+				}, nil)
+				cartRepo.EXPECT().Checkout(gomock.Any(), uint(1), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, cartId uint, orders []domain.Order) ([]domain.Order, error) {
+						return orders, nil
+					})
+				orderRepo.EXPECT().PublishOrderEvent(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+			},
+			wantSeller: []uint{1, 2},
+			wantAmount: []money.Money{money.New(150, "IDR"), money.New(180, "IDR")},
+		},
+		{
+			name:        "voucher does not exist",
+			voucherCode: "unknown",
+			mock: func(cartRepo *mocks.MockCartRepository, orderRepo *mocks.MockOrderRepository, addressRepo *mocks.MockAddressRepository, voucherRepo *mocks.MockVoucherRepository) {
+				cartRepo.EXPECT().GetCartByBuyerID(gomock.Any(), uint(1)).Return(&domain.Cart{
+					Model:   yugabyte.Model{ID: 1},
+					BuyerID: 1,
+					Items:   []domain.CartItem{{ProductID: 1, Quantity: 1}},
+				}, nil)
+				addressRepo.EXPECT().GetDefaultAddress(gomock.Any(), uint(1)).Return(nil, nil)
+				orderRepo.EXPECT().GetProductByID(gomock.Any(), uint(1)).Return(&domain.Product{Model: yugabyte.Model{ID: 1}, SellerID: 1, Price: money.New(100, "IDR"), Stock: 10}, nil)
+				orderRepo.EXPECT().PublishFunnelEvent(gomock.Any(), gomock.Any()).Return(nil)
+				voucherRepo.EXPECT().GetVoucherByCode(gomock.Any(), "UNKNOWN").Return(nil, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "address does not exist",
+			addressId: &missingAddressId,
+			mock: func(cartRepo *mocks.MockCartRepository, orderRepo *mocks.MockOrderRepository, addressRepo *mocks.MockAddressRepository, voucherRepo *mocks.MockVoucherRepository) {
+				cartRepo.EXPECT().GetCartByBuyerID(gomock.Any(), uint(1)).Return(&domain.Cart{
+					Model:   yugabyte.Model{ID: 1},
+					BuyerID: 1,
+					Items:   []domain.CartItem{{ProductID: 1, Quantity: 1}},
+				}, nil)
+				addressRepo.EXPECT().GetAddress(gomock.Any(), uint(1), missingAddressId).Return(nil, nil)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cartRepo := mocks.NewMockCartRepository(ctrl)
+			orderRepo := mocks.NewMockOrderRepository(ctrl)
+			addressRepo := mocks.NewMockAddressRepository(ctrl)
+			voucherRepo := mocks.NewMockVoucherRepository(ctrl)
+			tt.mock(cartRepo, orderRepo, addressRepo, voucherRepo)
+
+			sut := NewCartUsecase(cartRepo, orderRepo, addressRepo, voucherRepo, domain.InventoryConfig{})
+			res, err := sut.Checkout(context.TODO(), 1, tt.addressId, tt.voucherCode)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var (
+				sellers []uint
+				amounts []money.Money
+			)
+			for _, v := range res {
+				sellers = append(sellers, v.SellerID)
+				amounts = append(amounts, v.Amount)
+				assert.Equal(t, uint(1), v.BuyerID)
+				assert.Equal(t, domain.OrderStatusNew, v.Status)
+				assert.Equal(t, address.ShippingAddress, v.ShippingAddress)
+			}
+			assert.Equal(t, tt.wantSeller, sellers)
+			assert.Equal(t, tt.wantAmount, amounts)
+			// the price is snapshotted on each order detail
+			assert.Equal(t, money.New(50, "IDR"), res[0].OrderDetails[1].UnitPrice)
+		})
+	}
+}
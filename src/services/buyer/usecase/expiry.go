@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/repository"
+)
+
+// defaultExpiryBatchSize, how many expired orders are loaded at once when the config does not say
+const defaultExpiryBatchSize = 100
+
+// OrderExpiryUsecase, interface for order expiry usecase
+type OrderExpiryUsecase interface {
+	ExpireOrders(ctx context.Context, now time.Time) (int, error)
+}
+
+// orderExpiryUsecase, concrete implementation of order expiry usecase
+type orderExpiryUsecase struct {
+	orderRepo repository.OrderRepository
+	expiryCfg domain.OrderExpiryConfig
+}
+
+// NewOrderExpiryUsecase, constructor function for order expiry usecase
+func NewOrderExpiryUsecase(orderRepo repository.OrderRepository, expiryCfg domain.OrderExpiryConfig) OrderExpiryUsecase {
+	return &orderExpiryUsecase{
+		orderRepo: orderRepo,
+		expiryCfg: expiryCfg,
+	}
+}
+
+// ExpireOrders, cancels the new orders placed longer than the TTL before now, releasing their reserved stock and
+// publishing the same cancellation event as a buyer cancelling the order, returns how many orders were cancelled
+func (eu *orderExpiryUsecase) ExpireOrders(ctx context.Context, now time.Time) (int, error) {
+	if eu.expiryCfg.TTL <= 0 {
+		return 0, nil
+	}
+
+	limit := eu.expiryCfg.BatchSize
+	if limit <= 0 {
+		limit = defaultExpiryBatchSize
+	}
+
+	var expired int
+	for {
+		orders, err := eu.orderRepo.GetExpiredOrders(ctx, now.Add(-eu.expiryCfg.TTL), limit)
+		if err != nil {
+			return expired, err
+		}
+
+		for _, order := range orders {
+			order.Status = domain.OrderStatusCancelled
+
+			_, err := eu.orderRepo.UpdateOrderById(ctx, order)
+			if errors.Is(err, domain.ErrInvalidOrderStatus) {
+				// the buyer completed or cancelled the order in the meantime
+				continue
+			}
+			if err != nil {
+				return expired, err
+			}
+			expired++
+
+			if err := eu.orderRepo.PublishOrderEvent(ctx, newOrderStatusEvent(order)); err != nil {
+				log.Println("error publishing order event")
+			}
+		}
+
+		// a full batch means more orders may have expired
+		if len(orders) < limit {
+			return expired, nil
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/repository"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/repository/mocks"
+	"gorm.io/datatypes"
+)
+
+func Test_orderExpiryUsecase_ExpireOrders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2022, 1, 2, 12, 0, 0, 0, time.UTC)
+	createdBefore := now.Add(-24 * time.Hour)
+	order := func(id uint) domain.Order {
+		return domain.Order{
+			Model:        yugabyte.Model{ID: id},
+			SellerID:     2,
+			Status:       domain.OrderStatusNew,
+			Amount:       money.New(100, "IDR"),
+			OrderDate:    datatypes.Date(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+			OrderDetails: []domain.OrderDetail{{ProductQuantity: 2}},
+		}
+	}
+	cancelled := func(id uint) domain.Order {
+		o := order(id)
+		o.Status = domain.OrderStatusCancelled
+		return o
+	}
+	cancelEvent := func(id uint) domain.PayloadEventOrder {
+		return domain.PayloadEventOrder{
+			OrderID:          int64(id),
+			OrderDate:        "2022-01-01",
+			OrderStatus:      domain.OrderStatusCancelledInt,
+			SellerID:         2,
+			TotalRevenue:     money.New(100, "IDR"),
+			TotalProductSold: 2,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		cfg     domain.OrderExpiryConfig
+		want    int
+		wantErr bool
+		repo    func() repository.OrderRepository
+	}{
+		{
+			name: "expiry disabled",
+			repo: func() repository.OrderRepository {
+				return mocks.NewMockOrderRepository(ctrl)
+			},
+		},
+		{
+			name: "cancels expired orders batch by batch",
+			cfg:  domain.OrderExpiryConfig{TTL: 24 * time.Hour, BatchSize: 2},
+			want: 3,
+			repo: func() repository.OrderRepository {
+				m := mocks.NewMockOrderRepository(ctrl)
+				gomock.InOrder(
+					m.EXPECT().GetExpiredOrders(gomock.Any(), createdBefore, 2).Return([]domain.Order{order(1), order(2)}, nil),
+					m.EXPECT().GetExpiredOrders(gomock.Any(), createdBefore, 2).Return([]domain.Order{order(3)}, nil),
+				)
+				for _, id := range []uint{1, 2, 3} {
+					o := cancelled(id)
+					m.EXPECT().UpdateOrderById(gomock.Any(), o).Return(&o, nil)
+					m.EXPECT().PublishOrderEvent(gomock.Any(), cancelEvent(id)).Return(nil)
+				}
+				return m
+			},
+		},
+		{
+			name: "skips orders the buyer changed in the meantime",
+			cfg:  domain.OrderExpiryConfig{TTL: 24 * time.Hour},
+			want: 1,
+			repo: func() repository.OrderRepository {
+				m := mocks.NewMockOrderRepository(ctrl)
+				m.EXPECT().GetExpiredOrders(gomock.Any(), createdBefore, defaultExpiryBatchSize).Return([]domain.Order{order(1), order(2)}, nil)
+				m.EXPECT().UpdateOrderById(gomock.Any(), cancelled(1)).Return(nil, domain.ErrInvalidOrderStatus)
+				o := cancelled(2)
+				m.EXPECT().UpdateOrderById(gomock.Any(), o).Return(&o, nil)
+				m.EXPECT().PublishOrderEvent(gomock.Any(), cancelEvent(2)).Return(errors.New("mock error"))
+				return m
+			},
+		},
+		{
+			name:    "error cancelling order",
+			cfg:     domain.OrderExpiryConfig{TTL: 24 * time.Hour},
+			wantErr: true,
+			repo: func() repository.OrderRepository {
+				m := mocks.NewMockOrderRepository(ctrl)
+				m.EXPECT().GetExpiredOrders(gomock.Any(), createdBefore, defaultExpiryBatchSize).Return([]domain.Order{order(1)}, nil)
+				m.EXPECT().UpdateOrderById(gomock.Any(), cancelled(1)).Return(nil, errors.New("mock error"))
+				return m
+			},
+		},
+		{
+			name:    "error getting expired orders",
+			cfg:     domain.OrderExpiryConfig{TTL: 24 * time.Hour},
+			wantErr: true,
+			repo: func() repository.OrderRepository {
+				m := mocks.NewMockOrderRepository(ctrl)
+				m.EXPECT().GetExpiredOrders(gomock.Any(), createdBefore, defaultExpiryBatchSize).Return(nil, errors.New("mock error"))
+				return m
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sut := NewOrderExpiryUsecase(tt.repo(), tt.cfg)
+
+			got, err := sut.ExpireOrders(context.TODO(), now)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
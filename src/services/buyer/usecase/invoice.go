@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/repository"
+)
+
+// InvoiceUsecase, interface for invoice usecase
+type InvoiceUsecase interface {
+	Invoice(ctx context.Context, orderId uint) (*domain.Invoice, error)
+}
+
+// invoiceUsecase, concrete implementation of invoice usecase
+type invoiceUsecase struct {
+	orderRepo  repository.OrderRepository
+	sellerRepo repository.SellerRepository
+	buyerRepo  repository.BuyerRepository
+}
+
+// NewInvoiceUsecase, constructor function for invoice usecase
+func NewInvoiceUsecase(orderRepo repository.OrderRepository, sellerRepo repository.SellerRepository, buyerRepo repository.BuyerRepository) InvoiceUsecase {
+	return &invoiceUsecase{
+		orderRepo:  orderRepo,
+		sellerRepo: sellerRepo,
+		buyerRepo:  buyerRepo,
+	}
+}
+
+// Invoice, gathers the invoice of an order, only the buyer and the seller of the order may get it
+func (iu *invoiceUsecase) Invoice(ctx context.Context, orderId uint) (*domain.Invoice, error) {
+	order, err := iu.orderRepo.GetOrderByID(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if order == nil {
+		return nil, domain.ErrNotFound
+	}
+
+	buyerId, isBuyer := ctx.Value(domain.BuyerKey).(uint)
+	sellerId, isSeller := ctx.Value(domain.SellerKey).(uint)
+	if !(isBuyer && buyerId == order.BuyerID) && !(isSeller && sellerId == order.SellerID) {
+		return nil, domain.ErrForbidden
+	}
+
+	seller, err := iu.sellerRepo.Get(ctx, order.SellerID)
+	if err != nil {
+		return nil, err
+	}
+
+	buyer, err := iu.buyerRepo.Get(ctx, domain.Buyer{Model: yugabyte.Model{ID: order.BuyerID}})
+	if err != nil {
+		return nil, err
+	}
+
+	// the order keeps its invoice even if the seller or buyer account is gone
+	res := &domain.Invoice{Order: *order}
+	if seller != nil {
+		res.Seller = *seller
+	}
+	if buyer != nil {
+		res.Buyer = *buyer
+	}
+	return res, nil
+}
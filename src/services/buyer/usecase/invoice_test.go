@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/repository/mocks"
+)
+
+func Test_invoiceUsecase_Invoice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	orderRepo := mocks.NewMockOrderRepository(ctrl)
+	sellerRepo := mocks.NewMockSellerRepository(ctrl)
+	buyerRepo := mocks.NewMockBuyerRepository(ctrl)
+
+	order := &domain.Order{
+		Model:     yugabyte.Model{ID: 1},
+		BuyerID:   1,
+		SellerID:  2,
+		Status:    domain.OrderStatusCompleted,
+		Amount:    money.New(2000, "IDR"),
+		InvoiceNo: "INV/2/2022/000001",
+		OrderDetails: []domain.OrderDetail{
+			{ProductID: 1, ProductQuantity: 2, UnitPrice: money.New(1000, "IDR")},
+		},
+	}
+	seller := &domain.Seller{Model: yugabyte.Model{ID: 2}, Name: "seller"}
+	buyer := &domain.Buyer{Model: yugabyte.Model{ID: 1}, Username: "buyer"}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		want    *domain.Invoice
+		wantErr error
+		mock    func()
+	}{
+		{
+			name: "buyer of the order",
+			ctx:  context.WithValue(context.TODO(), domain.BuyerKey, uint(1)),
+			want: &domain.Invoice{Order: *order, Seller: *seller, Buyer: *buyer},
+			mock: func() {
+				orderRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(order, nil)
+				sellerRepo.EXPECT().Get(gomock.Any(), uint(2)).Return(seller, nil)
+				buyerRepo.EXPECT().Get(gomock.Any(), domain.Buyer{Model: yugabyte.Model{ID: 1}}).Return(buyer, nil)
+			},
+		},
+		{
+			name: "seller of the order, buyer account is gone",
+			ctx:  context.WithValue(context.TODO(), domain.SellerKey, uint(2)),
+			want: &domain.Invoice{Order: *order, Seller: *seller},
+			mock: func() {
+				orderRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(order, nil)
+				sellerRepo.EXPECT().Get(gomock.Any(), uint(2)).Return(seller, nil)
+				buyerRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+		},
+		{
+			name:    "another buyer",
+			ctx:     context.WithValue(context.TODO(), domain.BuyerKey, uint(3)),
+			wantErr: domain.ErrForbidden,
+			mock: func() {
+				orderRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(order, nil)
+			},
+		},
+		{
+			name:    "another seller",
+			ctx:     context.WithValue(context.TODO(), domain.SellerKey, uint(3)),
+			wantErr: domain.ErrForbidden,
+			mock: func() {
+				orderRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(order, nil)
+			},
+		},
+		{
+			name:    "order not found",
+			ctx:     context.WithValue(context.TODO(), domain.BuyerKey, uint(1)),
+			wantErr: domain.ErrNotFound,
+			mock: func() {
+				orderRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(nil, nil)
+			},
+		},
+		{
+			name:    "error get seller",
+			ctx:     context.WithValue(context.TODO(), domain.BuyerKey, uint(1)),
+			wantErr: errors.New("mock error"),
+			mock: func() {
+				orderRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(order, nil)
+				sellerRepo.EXPECT().Get(gomock.Any(), uint(2)).Return(nil, errors.New("mock error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			iu := NewInvoiceUsecase(orderRepo, sellerRepo, buyerRepo)
+			got, err := iu.Invoice(tt.ctx, 1)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
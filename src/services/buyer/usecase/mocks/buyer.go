@@ -1,7 +1,7 @@
 // Code generated by MockGen. DO NOT EDIT.
 // Source: buyer.go
 
-// Package mock_usecase is a generated GoMock package.
+// Package mocks is a generated GoMock package.
 package mocks
 
 import (
@@ -35,6 +35,49 @@ func (m *MockBuyerUsecase) EXPECT() *MockBuyerUsecaseMockRecorder {
 	return m.recorder
 }
 
+// Addresses mocks base method.
+func (m *MockBuyerUsecase) Addresses(ctx context.Context, buyerId uint) ([]domain.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Addresses", ctx, buyerId)
+	ret0, _ := ret[0].([]domain.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Addresses indicates an expected call of Addresses.
+func (mr *MockBuyerUsecaseMockRecorder) Addresses(ctx, buyerId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Addresses", reflect.TypeOf((*MockBuyerUsecase)(nil).Addresses), ctx, buyerId)
+}
+
+// Deactivate mocks base method.
+func (m *MockBuyerUsecase) Deactivate(ctx context.Context, buyerId uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deactivate", ctx, buyerId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Deactivate indicates an expected call of Deactivate.
+func (mr *MockBuyerUsecaseMockRecorder) Deactivate(ctx, buyerId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deactivate", reflect.TypeOf((*MockBuyerUsecase)(nil).Deactivate), ctx, buyerId)
+}
+
+// DeleteAddress mocks base method.
+func (m *MockBuyerUsecase) DeleteAddress(ctx context.Context, buyerId, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAddress", ctx, buyerId, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAddress indicates an expected call of DeleteAddress.
+func (mr *MockBuyerUsecaseMockRecorder) DeleteAddress(ctx, buyerId, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAddress", reflect.TypeOf((*MockBuyerUsecase)(nil).DeleteAddress), ctx, buyerId, id)
+}
+
 // IsUserAuthenticated mocks base method.
 func (m *MockBuyerUsecase) IsUserAuthenticated(ctx context.Context, buyerId uint) (bool, error) {
 	m.ctrl.T.Helper()
@@ -65,17 +108,47 @@ func (mr *MockBuyerUsecaseMockRecorder) Login(ctx, username interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockBuyerUsecase)(nil).Login), ctx, username)
 }
 
-// Orders mocks base method.
-func (m *MockBuyerUsecase) Orders(ctx context.Context, buyerId uint) ([]domain.Order, error) {
+// Profile mocks base method.
+func (m *MockBuyerUsecase) Profile(ctx context.Context, buyerId uint) (*domain.Buyer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Profile", ctx, buyerId)
+	ret0, _ := ret[0].(*domain.Buyer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Profile indicates an expected call of Profile.
+func (mr *MockBuyerUsecaseMockRecorder) Profile(ctx, buyerId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Profile", reflect.TypeOf((*MockBuyerUsecase)(nil).Profile), ctx, buyerId)
+}
+
+// SaveAddress mocks base method.
+func (m *MockBuyerUsecase) SaveAddress(ctx context.Context, address domain.Address) (*domain.Address, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Orders", ctx, buyerId)
-	ret0, _ := ret[0].([]domain.Order)
+	ret := m.ctrl.Call(m, "SaveAddress", ctx, address)
+	ret0, _ := ret[0].(*domain.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveAddress indicates an expected call of SaveAddress.
+func (mr *MockBuyerUsecaseMockRecorder) SaveAddress(ctx, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAddress", reflect.TypeOf((*MockBuyerUsecase)(nil).SaveAddress), ctx, address)
+}
+
+// UpdateProfile mocks base method.
+func (m *MockBuyerUsecase) UpdateProfile(ctx context.Context, buyer domain.Buyer) (*domain.Buyer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProfile", ctx, buyer)
+	ret0, _ := ret[0].(*domain.Buyer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Orders indicates an expected call of Orders.
-func (mr *MockBuyerUsecaseMockRecorder) Orders(ctx, buyerId interface{}) *gomock.Call {
+// UpdateProfile indicates an expected call of UpdateProfile.
+func (mr *MockBuyerUsecaseMockRecorder) UpdateProfile(ctx, buyer interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Orders", reflect.TypeOf((*MockBuyerUsecase)(nil).Orders), ctx, buyerId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockBuyerUsecase)(nil).UpdateProfile), ctx, buyer)
 }
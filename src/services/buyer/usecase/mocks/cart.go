@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: cart.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+// MockCartUsecase is a mock of CartUsecase interface.
+type MockCartUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCartUsecaseMockRecorder
+}
+
+// MockCartUsecaseMockRecorder is the mock recorder for MockCartUsecase.
+type MockCartUsecaseMockRecorder struct {
+	mock *MockCartUsecase
+}
+
+// NewMockCartUsecase creates a new mock instance.
+func NewMockCartUsecase(ctrl *gomock.Controller) *MockCartUsecase {
+	mock := &MockCartUsecase{ctrl: ctrl}
+	mock.recorder = &MockCartUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCartUsecase) EXPECT() *MockCartUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AddItem mocks base method.
+func (m *MockCartUsecase) AddItem(ctx context.Context, buyerId, productId uint, quantity int) (*domain.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddItem", ctx, buyerId, productId, quantity)
+	ret0, _ := ret[0].(*domain.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddItem indicates an expected call of AddItem.
+func (mr *MockCartUsecaseMockRecorder) AddItem(ctx, buyerId, productId, quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItem", reflect.TypeOf((*MockCartUsecase)(nil).AddItem), ctx, buyerId, productId, quantity)
+}
+
+// Cart mocks base method.
+func (m *MockCartUsecase) Cart(ctx context.Context, buyerId uint) (*domain.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cart", ctx, buyerId)
+	ret0, _ := ret[0].(*domain.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cart indicates an expected call of Cart.
+func (mr *MockCartUsecaseMockRecorder) Cart(ctx, buyerId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cart", reflect.TypeOf((*MockCartUsecase)(nil).Cart), ctx, buyerId)
+}
+
+// Checkout mocks base method.
+func (m *MockCartUsecase) Checkout(ctx context.Context, buyerId uint, addressId *uint, voucherCode string) ([]domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Checkout", ctx, buyerId, addressId, voucherCode)
+	ret0, _ := ret[0].([]domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Checkout indicates an expected call of Checkout.
+func (mr *MockCartUsecaseMockRecorder) Checkout(ctx, buyerId, addressId, voucherCode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkout", reflect.TypeOf((*MockCartUsecase)(nil).Checkout), ctx, buyerId, addressId, voucherCode)
+}
+
+// RemoveItem mocks base method.
+func (m *MockCartUsecase) RemoveItem(ctx context.Context, buyerId, productId uint) (*domain.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveItem", ctx, buyerId, productId)
+	ret0, _ := ret[0].(*domain.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveItem indicates an expected call of RemoveItem.
+func (mr *MockCartUsecaseMockRecorder) RemoveItem(ctx, buyerId, productId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveItem", reflect.TypeOf((*MockCartUsecase)(nil).RemoveItem), ctx, buyerId, productId)
+}
+
+// UpdateItem mocks base method.
+func (m *MockCartUsecase) UpdateItem(ctx context.Context, buyerId, productId uint, quantity int) (*domain.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateItem", ctx, buyerId, productId, quantity)
+	ret0, _ := ret[0].(*domain.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateItem indicates an expected call of UpdateItem.
+func (mr *MockCartUsecaseMockRecorder) UpdateItem(ctx, buyerId, productId, quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItem", reflect.TypeOf((*MockCartUsecase)(nil).UpdateItem), ctx, buyerId, productId, quantity)
+}
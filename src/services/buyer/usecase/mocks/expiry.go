@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: expiry.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOrderExpiryUsecase is a mock of OrderExpiryUsecase interface.
+type MockOrderExpiryUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrderExpiryUsecaseMockRecorder
+}
+
+// MockOrderExpiryUsecaseMockRecorder is the mock recorder for MockOrderExpiryUsecase.
+type MockOrderExpiryUsecaseMockRecorder struct {
+	mock *MockOrderExpiryUsecase
+}
+
+// NewMockOrderExpiryUsecase creates a new mock instance.
+func NewMockOrderExpiryUsecase(ctrl *gomock.Controller) *MockOrderExpiryUsecase {
+	mock := &MockOrderExpiryUsecase{ctrl: ctrl}
+	mock.recorder = &MockOrderExpiryUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrderExpiryUsecase) EXPECT() *MockOrderExpiryUsecaseMockRecorder {
+	return m.recorder
+}
+
+// ExpireOrders mocks base method.
+func (m *MockOrderExpiryUsecase) ExpireOrders(ctx context.Context, now time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireOrders", ctx, now)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireOrders indicates an expected call of ExpireOrders.
+func (mr *MockOrderExpiryUsecaseMockRecorder) ExpireOrders(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireOrders", reflect.TypeOf((*MockOrderExpiryUsecase)(nil).ExpireOrders), ctx, now)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: invoice.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+// MockInvoiceUsecase is a mock of InvoiceUsecase interface.
+type MockInvoiceUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvoiceUsecaseMockRecorder
+}
+
+// MockInvoiceUsecaseMockRecorder is the mock recorder for MockInvoiceUsecase.
+type MockInvoiceUsecaseMockRecorder struct {
+	mock *MockInvoiceUsecase
+}
+
+// NewMockInvoiceUsecase creates a new mock instance.
+func NewMockInvoiceUsecase(ctrl *gomock.Controller) *MockInvoiceUsecase {
+	mock := &MockInvoiceUsecase{ctrl: ctrl}
+	mock.recorder = &MockInvoiceUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvoiceUsecase) EXPECT() *MockInvoiceUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Invoice mocks base method.
+func (m *MockInvoiceUsecase) Invoice(ctx context.Context, orderId uint) (*domain.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Invoice", ctx, orderId)
+	ret0, _ := ret[0].(*domain.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Invoice indicates an expected call of Invoice.
+func (mr *MockInvoiceUsecaseMockRecorder) Invoice(ctx, orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invoice", reflect.TypeOf((*MockInvoiceUsecase)(nil).Invoice), ctx, orderId)
+}
@@ -66,18 +66,19 @@ func (mr *MockOrderUsecaseMockRecorder) OrderByID(ctx, id interface{}) *gomock.C
 }
 
 // OrdersByBuyer mocks base method.
-func (m *MockOrderUsecase) OrdersByBuyer(ctx context.Context, buyerId uint) ([]domain.Order, error) {
+func (m *MockOrderUsecase) OrdersByBuyer(ctx context.Context, filter domain.OrderFilter) ([]domain.OrderSummary, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "OrdersByBuyer", ctx, buyerId)
-	ret0, _ := ret[0].([]domain.Order)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "OrdersByBuyer", ctx, filter)
+	ret0, _ := ret[0].([]domain.OrderSummary)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // OrdersByBuyer indicates an expected call of OrdersByBuyer.
-func (mr *MockOrderUsecaseMockRecorder) OrdersByBuyer(ctx, buyerId interface{}) *gomock.Call {
+func (mr *MockOrderUsecaseMockRecorder) OrdersByBuyer(ctx, filter interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrdersByBuyer", reflect.TypeOf((*MockOrderUsecase)(nil).OrdersByBuyer), ctx, buyerId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrdersByBuyer", reflect.TypeOf((*MockOrderUsecase)(nil).OrdersByBuyer), ctx, filter)
 }
 
 // ProductByID mocks base method.
@@ -96,18 +97,34 @@ func (mr *MockOrderUsecaseMockRecorder) ProductByID(ctx, id interface{}) *gomock
 }
 
 // Products mocks base method.
-func (m *MockOrderUsecase) Products(ctx context.Context) ([]domain.Product, error) {
+func (m *MockOrderUsecase) Products(ctx context.Context, filter domain.ProductFilter) ([]domain.Product, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Products", ctx)
+	ret := m.ctrl.Call(m, "Products", ctx, filter)
 	ret0, _ := ret[0].([]domain.Product)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Products indicates an expected call of Products.
+func (mr *MockOrderUsecaseMockRecorder) Products(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Products", reflect.TypeOf((*MockOrderUsecase)(nil).Products), ctx, filter)
+}
+
+// RefundOrder mocks base method.
+func (m *MockOrderUsecase) RefundOrder(ctx context.Context, orderId uint, items []domain.RefundItem) (*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundOrder", ctx, orderId, items)
+	ret0, _ := ret[0].(*domain.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Products indicates an expected call of Products.
-func (mr *MockOrderUsecaseMockRecorder) Products(ctx interface{}) *gomock.Call {
+// RefundOrder indicates an expected call of RefundOrder.
+func (mr *MockOrderUsecaseMockRecorder) RefundOrder(ctx, orderId, items interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Products", reflect.TypeOf((*MockOrderUsecase)(nil).Products), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundOrder", reflect.TypeOf((*MockOrderUsecase)(nil).RefundOrder), ctx, orderId, items)
 }
 
 // UpdateOrderStatus mocks base method.
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: payment.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+// MockPaymentUsecase is a mock of PaymentUsecase interface.
+type MockPaymentUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentUsecaseMockRecorder
+}
+
+// MockPaymentUsecaseMockRecorder is the mock recorder for MockPaymentUsecase.
+type MockPaymentUsecaseMockRecorder struct {
+	mock *MockPaymentUsecase
+}
+
+// NewMockPaymentUsecase creates a new mock instance.
+func NewMockPaymentUsecase(ctrl *gomock.Controller) *MockPaymentUsecase {
+	mock := &MockPaymentUsecase{ctrl: ctrl}
+	mock.recorder = &MockPaymentUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentUsecase) EXPECT() *MockPaymentUsecaseMockRecorder {
+	return m.recorder
+}
+
+// HandleWebhook mocks base method.
+func (m *MockPaymentUsecase) HandleWebhook(ctx context.Context, payload []byte, signature string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleWebhook", ctx, payload, signature)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HandleWebhook indicates an expected call of HandleWebhook.
+func (mr *MockPaymentUsecaseMockRecorder) HandleWebhook(ctx, payload, signature interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleWebhook", reflect.TypeOf((*MockPaymentUsecase)(nil).HandleWebhook), ctx, payload, signature)
+}
+
+// PayOrder mocks base method.
+func (m *MockPaymentUsecase) PayOrder(ctx context.Context, buyerId, orderId uint) (*domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PayOrder", ctx, buyerId, orderId)
+	ret0, _ := ret[0].(*domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PayOrder indicates an expected call of PayOrder.
+func (mr *MockPaymentUsecaseMockRecorder) PayOrder(ctx, buyerId, orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayOrder", reflect.TypeOf((*MockPaymentUsecase)(nil).PayOrder), ctx, buyerId, orderId)
+}
@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: product.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+// MockProductUsecase is a mock of ProductUsecase interface.
+type MockProductUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductUsecaseMockRecorder
+}
+
+// MockProductUsecaseMockRecorder is the mock recorder for MockProductUsecase.
+type MockProductUsecaseMockRecorder struct {
+	mock *MockProductUsecase
+}
+
+// NewMockProductUsecase creates a new mock instance.
+func NewMockProductUsecase(ctrl *gomock.Controller) *MockProductUsecase {
+	mock := &MockProductUsecase{ctrl: ctrl}
+	mock.recorder = &MockProductUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductUsecase) EXPECT() *MockProductUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Categories mocks base method.
+func (m *MockProductUsecase) Categories(ctx context.Context) ([]domain.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Categories", ctx)
+	ret0, _ := ret[0].([]domain.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Categories indicates an expected call of Categories.
+func (mr *MockProductUsecaseMockRecorder) Categories(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Categories", reflect.TypeOf((*MockProductUsecase)(nil).Categories), ctx)
+}
+
+// CreateCategory mocks base method.
+func (m *MockProductUsecase) CreateCategory(ctx context.Context, name string) (*domain.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategory", ctx, name)
+	ret0, _ := ret[0].(*domain.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *MockProductUsecaseMockRecorder) CreateCategory(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockProductUsecase)(nil).CreateCategory), ctx, name)
+}
+
+// CreateProduct mocks base method.
+func (m *MockProductUsecase) CreateProduct(ctx context.Context, req domain.Product) (*domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProduct", ctx, req)
+	ret0, _ := ret[0].(*domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProduct indicates an expected call of CreateProduct.
+func (mr *MockProductUsecaseMockRecorder) CreateProduct(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProduct", reflect.TypeOf((*MockProductUsecase)(nil).CreateProduct), ctx, req)
+}
+
+// DeleteProduct mocks base method.
+func (m *MockProductUsecase) DeleteProduct(ctx context.Context, sellerId, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProduct", ctx, sellerId, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProduct indicates an expected call of DeleteProduct.
+func (mr *MockProductUsecaseMockRecorder) DeleteProduct(ctx, sellerId, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockProductUsecase)(nil).DeleteProduct), ctx, sellerId, id)
+}
+
+// UpdateProduct mocks base method.
+func (m *MockProductUsecase) UpdateProduct(ctx context.Context, req domain.Product) (*domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProduct", ctx, req)
+	ret0, _ := ret[0].(*domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProduct indicates an expected call of UpdateProduct.
+func (mr *MockProductUsecaseMockRecorder) UpdateProduct(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProduct", reflect.TypeOf((*MockProductUsecase)(nil).UpdateProduct), ctx, req)
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: review.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+// MockReviewUsecase is a mock of ReviewUsecase interface.
+type MockReviewUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewUsecaseMockRecorder
+}
+
+// MockReviewUsecaseMockRecorder is the mock recorder for MockReviewUsecase.
+type MockReviewUsecaseMockRecorder struct {
+	mock *MockReviewUsecase
+}
+
+// NewMockReviewUsecase creates a new mock instance.
+func NewMockReviewUsecase(ctrl *gomock.Controller) *MockReviewUsecase {
+	mock := &MockReviewUsecase{ctrl: ctrl}
+	mock.recorder = &MockReviewUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewUsecase) EXPECT() *MockReviewUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreateReview mocks base method.
+func (m *MockReviewUsecase) CreateReview(ctx context.Context, review domain.Review) (*domain.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReview", ctx, review)
+	ret0, _ := ret[0].(*domain.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReview indicates an expected call of CreateReview.
+func (mr *MockReviewUsecaseMockRecorder) CreateReview(ctx, review interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReview", reflect.TypeOf((*MockReviewUsecase)(nil).CreateReview), ctx, review)
+}
+
+// ProductReviews mocks base method.
+func (m *MockReviewUsecase) ProductReviews(ctx context.Context, productId uint) ([]domain.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProductReviews", ctx, productId)
+	ret0, _ := ret[0].([]domain.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProductReviews indicates an expected call of ProductReviews.
+func (mr *MockReviewUsecaseMockRecorder) ProductReviews(ctx, productId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProductReviews", reflect.TypeOf((*MockReviewUsecase)(nil).ProductReviews), ctx, productId)
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: seller.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+// MockSellerUsecase is a mock of SellerUsecase interface.
+type MockSellerUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSellerUsecaseMockRecorder
+}
+
+// MockSellerUsecaseMockRecorder is the mock recorder for MockSellerUsecase.
+type MockSellerUsecaseMockRecorder struct {
+	mock *MockSellerUsecase
+}
+
+// NewMockSellerUsecase creates a new mock instance.
+func NewMockSellerUsecase(ctrl *gomock.Controller) *MockSellerUsecase {
+	mock := &MockSellerUsecase{ctrl: ctrl}
+	mock.recorder = &MockSellerUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSellerUsecase) EXPECT() *MockSellerUsecaseMockRecorder {
+	return m.recorder
+}
+
+// IsSellerAuthenticated mocks base method.
+func (m *MockSellerUsecase) IsSellerAuthenticated(ctx context.Context, sellerId uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSellerAuthenticated", ctx, sellerId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSellerAuthenticated indicates an expected call of IsSellerAuthenticated.
+func (mr *MockSellerUsecaseMockRecorder) IsSellerAuthenticated(ctx, sellerId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSellerAuthenticated", reflect.TypeOf((*MockSellerUsecase)(nil).IsSellerAuthenticated), ctx, sellerId)
+}
+
+// Login mocks base method.
+func (m *MockSellerUsecase) Login(ctx context.Context, name string) (*domain.Seller, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", ctx, name)
+	ret0, _ := ret[0].(*domain.Seller)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockSellerUsecaseMockRecorder) Login(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockSellerUsecase)(nil).Login), ctx, name)
+}
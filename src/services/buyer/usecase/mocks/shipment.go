@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: shipment.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+// MockShipmentUsecase is a mock of ShipmentUsecase interface.
+type MockShipmentUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockShipmentUsecaseMockRecorder
+}
+
+// MockShipmentUsecaseMockRecorder is the mock recorder for MockShipmentUsecase.
+type MockShipmentUsecaseMockRecorder struct {
+	mock *MockShipmentUsecase
+}
+
+// NewMockShipmentUsecase creates a new mock instance.
+func NewMockShipmentUsecase(ctrl *gomock.Controller) *MockShipmentUsecase {
+	mock := &MockShipmentUsecase{ctrl: ctrl}
+	mock.recorder = &MockShipmentUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShipmentUsecase) EXPECT() *MockShipmentUsecaseMockRecorder {
+	return m.recorder
+}
+
+// DeliverOrder mocks base method.
+func (m *MockShipmentUsecase) DeliverOrder(ctx context.Context, sellerId, orderId uint) (*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeliverOrder", ctx, sellerId, orderId)
+	ret0, _ := ret[0].(*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeliverOrder indicates an expected call of DeliverOrder.
+func (mr *MockShipmentUsecaseMockRecorder) DeliverOrder(ctx, sellerId, orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverOrder", reflect.TypeOf((*MockShipmentUsecase)(nil).DeliverOrder), ctx, sellerId, orderId)
+}
+
+// ShipOrder mocks base method.
+func (m *MockShipmentUsecase) ShipOrder(ctx context.Context, sellerId, orderId uint, courier, trackingNumber string) (*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShipOrder", ctx, sellerId, orderId, courier, trackingNumber)
+	ret0, _ := ret[0].(*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShipOrder indicates an expected call of ShipOrder.
+func (mr *MockShipmentUsecaseMockRecorder) ShipOrder(ctx, sellerId, orderId, courier, trackingNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShipOrder", reflect.TypeOf((*MockShipmentUsecase)(nil).ShipOrder), ctx, sellerId, orderId, courier, trackingNumber)
+}
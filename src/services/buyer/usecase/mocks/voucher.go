@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: voucher.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+)
+
+// MockVoucherUsecase is a mock of VoucherUsecase interface.
+type MockVoucherUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockVoucherUsecaseMockRecorder
+}
+
+// MockVoucherUsecaseMockRecorder is the mock recorder for MockVoucherUsecase.
+type MockVoucherUsecaseMockRecorder struct {
+	mock *MockVoucherUsecase
+}
+
+// NewMockVoucherUsecase creates a new mock instance.
+func NewMockVoucherUsecase(ctrl *gomock.Controller) *MockVoucherUsecase {
+	mock := &MockVoucherUsecase{ctrl: ctrl}
+	mock.recorder = &MockVoucherUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVoucherUsecase) EXPECT() *MockVoucherUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreateVoucher mocks base method.
+func (m *MockVoucherUsecase) CreateVoucher(ctx context.Context, req domain.Voucher) (*domain.Voucher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVoucher", ctx, req)
+	ret0, _ := ret[0].(*domain.Voucher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVoucher indicates an expected call of CreateVoucher.
+func (mr *MockVoucherUsecaseMockRecorder) CreateVoucher(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVoucher", reflect.TypeOf((*MockVoucherUsecase)(nil).CreateVoucher), ctx, req)
+}
+
+// Vouchers mocks base method.
+func (m *MockVoucherUsecase) Vouchers(ctx context.Context, sellerId uint) ([]domain.Voucher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Vouchers", ctx, sellerId)
+	ret0, _ := ret[0].([]domain.Voucher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Vouchers indicates an expected call of Vouchers.
+func (mr *MockVoucherUsecaseMockRecorder) Vouchers(ctx, sellerId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Vouchers", reflect.TypeOf((*MockVoucherUsecase)(nil).Vouchers), ctx, sellerId)
+}
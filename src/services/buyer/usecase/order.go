@@ -2,43 +2,50 @@ package usecase
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"log"
 	"time"
 
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/validation"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/repository"
 )
 
 type OrderUsecase interface {
-	Products(ctx context.Context) ([]domain.Product, error)
+	Products(ctx context.Context, filter domain.ProductFilter) ([]domain.Product, int64, error)
 	ProductByID(ctx context.Context, id uint) (*domain.Product, error)
 	UpdateOrderStatus(ctx context.Context, orderId uint, status string) (*domain.Order, error)
+	RefundOrder(ctx context.Context, orderId uint, items []domain.RefundItem) (*domain.Order, error)
 	CreateOrder(ctx context.Context, req domain.Order) (*domain.Order, error)
 	OrderByID(ctx context.Context, id uint) (*domain.Order, error)
-	OrdersByBuyer(ctx context.Context, buyerId uint) ([]domain.Order, error)
+	OrdersByBuyer(ctx context.Context, filter domain.OrderFilter) ([]domain.OrderSummary, string, error)
 }
 
 type orderUsecase struct {
-	orderRepo repository.OrderRepository
+	orderRepo    repository.OrderRepository
+	addressRepo  repository.AddressRepository
+	voucherRepo  repository.VoucherRepository
+	inventoryCfg domain.InventoryConfig
 }
 
-func NewOrderUsecase(orderRepo repository.OrderRepository) OrderUsecase {
+func NewOrderUsecase(orderRepo repository.OrderRepository, addressRepo repository.AddressRepository, voucherRepo repository.VoucherRepository, inventoryCfg domain.InventoryConfig) OrderUsecase {
 	return &orderUsecase{
-		orderRepo: orderRepo,
+		orderRepo:    orderRepo,
+		addressRepo:  addressRepo,
+		voucherRepo:  voucherRepo,
+		inventoryCfg: inventoryCfg,
 	}
 }
 
-// Products are method to return list of products
-func (ou *orderUsecase) Products(ctx context.Context) ([]domain.Product, error) {
-	var res []domain.Product
-
-	res, err := ou.orderRepo.GetProducts(ctx)
+// Products are method to return a page of products matching filter along with the total matching products
+func (ou *orderUsecase) Products(ctx context.Context, filter domain.ProductFilter) ([]domain.Product, int64, error) {
+	res, total, err := ou.orderRepo.GetProducts(ctx, filter.WithDefaults())
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	return res, nil
+	return res, total, nil
 }
 
 // ProductByID are method to return a product
@@ -48,6 +55,10 @@ func (ou *orderUsecase) ProductByID(ctx context.Context, id uint) (*domain.Produ
 		return res, err
 	}
 
+	if res != nil {
+		publishFunnelEvent(ctx, ou.orderRepo, domain.FunnelStepView, res.SellerID, res.ID, time.Now())
+	}
+
 	return res, nil
 }
 
@@ -61,43 +72,107 @@ func (ou *orderUsecase) UpdateOrderStatus(ctx context.Context, orderId uint, sta
 
 	// we don't want to update if it does not exist
 	if order == nil {
-		return nil, errors.New("not found")
+		return nil, domain.ErrNotFound
 	}
 
 	if order.BuyerID != ctx.Value(domain.BuyerKey).(uint) {
-		return nil, errors.New("unauthorized")
+		return nil, domain.ErrForbidden
 	}
 
-	// only new status can be update
-	if order.Status != domain.OrderStatusNew {
-		return nil, errors.New("only new order status eligibile to be update")
+	// only unpaid orders can be cancelled and only paid ones completed
+	if !domain.CanChangeOrderStatus(order.Status, status) {
+		return nil, domain.ErrInvalidOrderStatus
 	}
 
 	order.Status = status
 
-	// update the order
+	// update the order, cancelling releases the reserved stock
 	res, err := ou.orderRepo.UpdateOrderById(ctx, *order)
 	if err != nil {
 		return nil, err
 	}
 
-	var totalProductSold int64
+	err = ou.orderRepo.PublishOrderEvent(ctx, newOrderStatusEvent(*order))
+	if err != nil {
+		log.Println("error publishing order event")
+	}
+
+	return res, nil
+}
+
+// RefundOrder, refunds items of a completed order, every item left to refund is refunded when items is empty
+func (ou *orderUsecase) RefundOrder(ctx context.Context, orderId uint, items []domain.RefundItem) (*domain.Order, error) {
+	order, err := ou.orderRepo.GetOrderByID(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if order == nil {
+		return nil, domain.ErrNotFound
+	}
 
+	if order.BuyerID != ctx.Value(domain.BuyerKey).(uint) {
+		return nil, domain.ErrForbidden
+	}
+
+	// only paid orders can be refunded, new orders are cancelled instead
+	if order.Status != domain.OrderStatusCompleted && order.Status != domain.OrderStatusPartiallyRefunded {
+		return nil, domain.ErrInvalidOrderStatus
+	}
+
+	if len(items) == 0 {
+		for _, v := range order.OrderDetails {
+			if v.RefundableQuantity() > 0 {
+				items = append(items, domain.RefundItem{OrderDetailID: v.ID, Quantity: v.RefundableQuantity()})
+			}
+		}
+		if len(items) == 0 {
+			return nil, domain.ErrInvalidOrderStatus
+		}
+	}
+
+	// an earlier refund may have given nothing back e.g. of free items, only the refunded quantities tell
+	firstRefund := true
 	for _, v := range order.OrderDetails {
-		totalProductSold += int64(v.ProductQuantity)
+		if v.RefundedQuantity > 0 {
+			firstRefund = false
+		}
 	}
 
-	orderStatus := domain.OrderStatusCompletedInt
-	if status == domain.OrderStatusCancelled {
-		orderStatus = domain.OrderStatusCancelledInt
+	refundItems, amount, err := buildRefund(order, items)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.RefundedAmount, err = order.RefundedAmount.Add(amount); err != nil {
+		return nil, err
+	}
+
+	var refundedQuantity int64
+	for _, v := range refundItems {
+		refundedQuantity += int64(v.Quantity)
+	}
+
+	order.Status = domain.OrderStatusRefunded
+	if !isFullyRefunded(order) {
+		order.Status = domain.OrderStatusPartiallyRefunded
+	}
+
+	// refund the order, putting the refunded products back into stock
+	res, err := ou.orderRepo.RefundOrder(ctx, *order, refundItems, amount)
+	if err != nil {
+		return nil, err
 	}
 
 	evt := domain.PayloadEventOrder{
 		OrderID:          int64(orderId),
+		SellerID:         int64(order.SellerID),
+		BuyerID:          int64(order.BuyerID),
 		OrderDate:        time.Time(order.OrderDate).Format("2006-01-02"),
-		OrderStatus:      int64(orderStatus),
-		TotalRevenue:     float64(order.Amount),
-		TotalProductSold: totalProductSold,
+		OrderStatus:      domain.OrderStatusRefundedInt,
+		RefundedAmount:   amount,
+		RefundedQuantity: refundedQuantity,
+		FirstRefund:      firstRefund,
 	}
 
 	err = ou.orderRepo.PublishOrderEvent(ctx, evt)
@@ -110,46 +185,42 @@ func (ou *orderUsecase) UpdateOrderStatus(ctx context.Context, orderId uint, sta
 
 // CreateOrder is an update method for order
 func (ou *orderUsecase) CreateOrder(ctx context.Context, req domain.Order) (*domain.Order, error) {
-	var res *domain.Order
-	//Get ongoing order, if there are ongoing then err
-	hasOngoingOrders, err := ou.orderRepo.GetOngoingOrders(ctx, req.BuyerID)
+	if err := applyShippingAddress(ctx, ou.addressRepo, &req); err != nil {
+		return nil, err
+	}
+
+	orders, err := buildSellerOrders(ctx, ou.orderRepo, req, "products[%d].product_id", "products[%d].product_qty")
 	if err != nil {
 		return nil, err
 	}
 
-	if hasOngoingOrders {
-		return res, errors.New("has ongoing orders")
+	if len(orders) == 0 {
+		return nil, validation.NewError("products", "must contain at least 1 item(s)")
 	}
 
-	// check each product
-	for k, v := range req.OrderDetails {
+	// a direct order belongs to a single seller, several sellers go through the cart checkout
+	if len(orders) > 1 {
+		return nil, validation.NewError("products", "must belong to the same seller, use the cart to order from several sellers")
+	}
 
-		// get product by id
-		resProduct, err := ou.orderRepo.GetProductByID(ctx, v.ProductID)
-		if err != nil {
-			return nil, err
-		}
+	now := time.Now()
+	// every attempt counts as a checkout, the ones failing on the voucher, the stock or the insert are the checkouts
+	// not placing an order in the checkout rate
+	publishFunnelEvent(ctx, ou.orderRepo, domain.FunnelStepCheckout, orders[0].SellerID, 0, now)
 
-		//update order details
-		req.OrderDetails[k].Product.ProductName = resProduct.ProductName
-		req.OrderDetails[k].Product.Price = resProduct.Price
-		//sum the amount by
-		req.Amount += float64(resProduct.Price) * float64(v.ProductQuantity)
+	if err = applyVoucher(ctx, ou.voucherRepo, orders, req.VoucherCode, now); err != nil {
+		return nil, err
 	}
 
-	// insert to table order
-	res, err = ou.orderRepo.InsertOrder(ctx, req)
+	// insert to table order, reserving the stock and redeeming the voucher
+	res, err := ou.orderRepo.InsertOrder(ctx, orders[0])
 	if err != nil {
 		return nil, err
 	}
 
-	evt := domain.PayloadEventOrder{
-		OrderID:      int64(req.ID),
-		OrderDate:    res.CreatedAt.Format("2006-01-02"),
-		OrderStatus:  domain.OrderStatusNewInt,
-		TotalRevenue: float64(req.Amount),
-	}
-	err = ou.orderRepo.PublishOrderEvent(ctx, evt)
+	publishLowStockEvents(ctx, ou.orderRepo, ou.inventoryCfg, *res)
+
+	err = ou.orderRepo.PublishOrderEvent(ctx, newOrderEvent(*res))
 	if err != nil {
 		return nil, err
 	}
@@ -157,24 +228,372 @@ func (ou *orderUsecase) CreateOrder(ctx context.Context, req domain.Order) (*dom
 	return res, nil
 }
 
-// OrderByID are method to return list of orders
+// OrderByID, the order with its shipping address, only the buyer of the order may get it
 func (ou *orderUsecase) OrderByID(ctx context.Context, id uint) (*domain.Order, error) {
 	res, err := ou.orderRepo.GetOrderByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
+	if res == nil {
+		return nil, domain.ErrNotFound
+	}
+
+	if buyerId, ok := ctx.Value(domain.BuyerKey).(uint); !ok || res.BuyerID != buyerId {
+		return nil, domain.ErrForbidden
+	}
+
 	return res, nil
 }
 
-// Orders are method to return list of orders
-func (ou *orderUsecase) OrdersByBuyer(ctx context.Context, buyerId uint) ([]domain.Order, error) {
-	var res []domain.Order
+// OrdersByBuyer, returns a page of the summaries of the buyer's orders matching filter along with the cursor
+// of the next page, empty on the last page
+func (ou *orderUsecase) OrdersByBuyer(ctx context.Context, filter domain.OrderFilter) ([]domain.OrderSummary, string, error) {
+	filter = filter.WithDefaults()
 
-	res, err := ou.orderRepo.GetOrdersByBuyerID(ctx, buyerId)
+	res, err := ou.orderRepo.GetOrderSummaries(ctx, filter)
 	if err != nil {
-		return res, err
+		return nil, "", err
 	}
 
-	return res, nil
+	// the repository returns one extra order when there is a next page
+	if len(res) <= filter.Limit {
+		return res, "", nil
+	}
+
+	res = res[:filter.Limit]
+	return res, domain.NewOrderCursor(res[len(res)-1]).Encode(), nil
+}
+
+// buildSellerOrders, prices every order detail of req and splits them into one order per seller
+// in order of appearance, unknown products and products without enough stock are reported as
+// validation error using productField and quantityField
+func buildSellerOrders(ctx context.Context, orderRepo repository.OrderRepository, req domain.Order, productField, quantityField string) ([]domain.Order, error) {
+	var (
+		orders  []domain.Order
+		verr    *validation.Error
+		sellers = map[uint]int{}
+	)
+
+	// check each product
+	for k, v := range req.OrderDetails {
+
+		// get product by id
+		resProduct, err := orderRepo.GetProductByID(ctx, v.ProductID)
+		if err != nil {
+			return nil, err
+		}
+
+		// collect every invalid product so the caller can fix them at once
+		if resProduct == nil {
+			verr = addFieldError(verr, fmt.Sprintf(productField, k), "product does not exist")
+			continue
+		}
+
+		// the stock is reserved again when inserting the order, this only gives a friendlier error
+		if v.ProductQuantity > resProduct.Stock {
+			verr = addFieldError(verr, fmt.Sprintf(quantityField, k), fmt.Sprintf("insufficient stock, %d left", resProduct.Stock))
+			continue
+		}
+
+		idx, ok := sellers[resProduct.SellerID]
+		if !ok {
+			order := req
+			order.SellerID = resProduct.SellerID
+			order.Amount = money.Money{}
+			order.OrderDetails = nil
+
+			orders = append(orders, order)
+			idx = len(orders) - 1
+			sellers[resProduct.SellerID] = idx
+		}
+
+		// sum the amount, an order is paid in a single currency
+		amount, err := orders[idx].Amount.Add(resProduct.Price.Mul(int64(v.ProductQuantity)))
+		if err != nil {
+			verr = addFieldError(verr, fmt.Sprintf(productField, k), "price currency differs from the other products of the seller")
+			continue
+		}
+		orders[idx].Amount = amount
+
+		//update order details, snapshotting the current price
+		v.Product = *resProduct
+		v.UnitPrice = resProduct.Price
+		orders[idx].OrderDetails = append(orders[idx].OrderDetails, v)
+	}
+
+	if verr != nil {
+		return nil, verr
+	}
+
+	return orders, nil
+}
+
+// applyShippingAddress, copies the address order.AddressID points to onto the order, the buyer's default
+// address is used when no address was chosen, an order of a buyer without any address has no shipping address
+func applyShippingAddress(ctx context.Context, addressRepo repository.AddressRepository, order *domain.Order) error {
+	var (
+		address *domain.Address
+		err     error
+	)
+
+	if order.AddressID != nil {
+		address, err = addressRepo.GetAddress(ctx, order.BuyerID, *order.AddressID)
+		if err != nil {
+			return err
+		}
+
+		if address == nil {
+			return validation.NewError("address_id", "address does not exist")
+		}
+	} else {
+		address, err = addressRepo.GetDefaultAddress(ctx, order.BuyerID)
+		if err != nil {
+			return err
+		}
+
+		if address == nil {
+			return nil
+		}
+	}
+
+	order.AddressID = &address.ID
+	order.ShippingAddress = address.ShippingAddress
+	return nil
+}
+
+// applyVoucher, takes the discount of the voucher with code off the order of the voucher's seller, an empty code
+// applies no voucher. The voucher is checked against the order here and redeemed when inserting the order, which
+// fails with ErrVoucherUsedUp when concurrent orders used it up in the meantime
+func applyVoucher(ctx context.Context, voucherRepo repository.VoucherRepository, orders []domain.Order, code string, now time.Time) error {
+	for i := range orders {
+		orders[i].VoucherCode = ""
+	}
+
+	code = domain.NormalizeVoucherCode(code)
+	if code == "" {
+		return nil
+	}
+
+	voucher, err := voucherRepo.GetVoucherByCode(ctx, code)
+	if err != nil {
+		return err
+	}
+
+	if voucher == nil {
+		return validation.NewError("voucher_code", "voucher does not exist")
+	}
+
+	if !voucher.IsActive(now) {
+		return validation.NewError("voucher_code", "voucher is not valid at this time")
+	}
+
+	if voucher.IsUsedUp() {
+		return validation.NewError("voucher_code", "voucher usage limit reached")
+	}
+
+	var order *domain.Order
+	for i := range orders {
+		if orders[i].SellerID == voucher.SellerID {
+			order = &orders[i]
+			break
+		}
+	}
+
+	if order == nil {
+		return validation.NewError("voucher_code", "voucher does not apply to any product of the order")
+	}
+
+	// the minimum spend and a fixed discount only make sense in the currency of the order
+	if !voucher.MinSpend.IsZero() && (voucher.MinSpend.Currency != order.Amount.Currency || order.Amount.Amount < voucher.MinSpend.Amount) {
+		return validation.NewError("voucher_code", fmt.Sprintf("minimum spend of %s not reached", voucher.MinSpend))
+	}
+
+	if voucher.Type == domain.VoucherTypeFixed && voucher.Amount.Currency != order.Amount.Currency {
+		return validation.NewError("voucher_code", "voucher currency differs from the order")
+	}
+
+	discount := voucher.Discount(order.Amount)
+	if order.Amount, err = order.Amount.Sub(discount); err != nil {
+		return err
+	}
+
+	order.Discount = discount
+	order.VoucherID = &voucher.ID
+	order.VoucherCode = voucher.Code
+	return nil
+}
+
+// buildRefund, validates the refunded items against the order and returns them along with the refunded amount,
+// the refunded quantities are added to the order details of order
+func buildRefund(order *domain.Order, items []domain.RefundItem) ([]domain.RefundItem, money.Money, error) {
+	var (
+		verr    *validation.Error
+		amount  money.Money
+		details = map[uint]int{}
+	)
+
+	for i, v := range order.OrderDetails {
+		details[v.ID] = i
+	}
+
+	refundItems := make([]domain.RefundItem, 0, len(items))
+	for k, v := range items {
+		idx, ok := details[v.OrderDetailID]
+		if !ok {
+			verr = addFieldError(verr, fmt.Sprintf("items[%d].order_detail_id", k), "order item does not exist")
+			continue
+		}
+
+		detail := &order.OrderDetails[idx]
+		if v.Quantity > detail.RefundableQuantity() {
+			verr = addFieldError(verr, fmt.Sprintf("items[%d].quantity", k), fmt.Sprintf("exceeds the quantity left to refund, %d left", detail.RefundableQuantity()))
+			continue
+		}
+
+		// refund the price the buyer paid, not the current one
+		var err error
+		if amount, err = amount.Add(detail.UnitPrice.Mul(int64(v.Quantity))); err != nil {
+			return nil, money.Money{}, err
+		}
+
+		detail.RefundedQuantity += v.Quantity
+		refundItems = append(refundItems, domain.RefundItem{
+			OrderDetailID: detail.ID,
+			ProductID:     detail.ProductID,
+			Quantity:      v.Quantity,
+		})
+	}
+
+	if verr != nil {
+		return nil, money.Money{}, verr
+	}
+
+	// a discounted order refunds the items minus their share of the discount, the last refund gives back
+	// whatever is left of what the buyer paid so the rounding of earlier refunds is never lost
+	if !order.Discount.IsZero() {
+		if isFullyRefunded(order) {
+			var err error
+			if amount, err = order.Amount.Sub(order.RefundedAmount); err != nil {
+				return nil, money.Money{}, err
+			}
+		} else {
+			amount = amount.MulDiv(order.Amount.Amount, order.GrossAmount().Amount)
+		}
+	}
+
+	return refundItems, amount, nil
+}
+
+// isFullyRefunded, whether every ordered product of the order is refunded
+func isFullyRefunded(order *domain.Order) bool {
+	for _, v := range order.OrderDetails {
+		if v.RefundableQuantity() > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// addFieldError, adds an invalid field to verr, creating it when it is still nil
+func addFieldError(verr *validation.Error, field, message string) *validation.Error {
+	if verr == nil {
+		return validation.NewError(field, message)
+	}
+	verr.Add(field, message)
+	return verr
+}
+
+// publishLowStockEvents, publishes a low stock event for every product of the order whose stock
+// dropped to or below the threshold because of this order, so each drop is only reported once
+func publishLowStockEvents(ctx context.Context, orderRepo repository.OrderRepository, cfg domain.InventoryConfig, order domain.Order) {
+	for _, v := range order.OrderDetails {
+		stock := v.Product.Stock
+		if stock > cfg.LowStockThreshold || stock+v.ProductQuantity <= cfg.LowStockThreshold {
+			continue
+		}
+
+		evt := domain.PayloadEventLowStock{
+			ProductID: int64(v.ProductID),
+			SellerID:  int64(order.SellerID),
+			Stock:     int64(stock),
+			Date:      order.CreatedAt.Format("2006-01-02"),
+		}
+		if err := orderRepo.PublishLowStockEvent(ctx, evt); err != nil {
+			log.Println("error publishing low stock event", err)
+		}
+	}
+}
+
+// publishFunnelEvent, publishes a step of a buyer towards an order of the seller, a product id of 0 leaves the
+// product out, failing to publish only loses the step hence it is logged
+func publishFunnelEvent(ctx context.Context, orderRepo repository.OrderRepository, step string, sellerId, productId uint, at time.Time) {
+	evt := domain.PayloadEventFunnel{
+		Step:      step,
+		SellerID:  int64(sellerId),
+		ProductID: int64(productId),
+		Date:      at.Format(domain.OrderDateFormat),
+	}
+	if err := orderRepo.PublishFunnelEvent(ctx, evt); err != nil {
+		log.Println("error publishing funnel event", err)
+	}
+}
+
+// newOrderStatusEvent, the event of an order that was completed or cancelled
+func newOrderStatusEvent(order domain.Order) domain.PayloadEventOrder {
+	var totalProductSold int64
+
+	for _, v := range order.OrderDetails {
+		totalProductSold += int64(v.ProductQuantity)
+	}
+
+	orderStatus := domain.OrderStatusCompletedInt
+	if order.Status == domain.OrderStatusCancelled {
+		orderStatus = domain.OrderStatusCancelledInt
+	}
+
+	return domain.PayloadEventOrder{
+		OrderID:          int64(order.ID),
+		OrderDate:        time.Time(order.OrderDate).Format("2006-01-02"),
+		OrderStatus:      int64(orderStatus),
+		SellerID:         int64(order.SellerID),
+		BuyerID:          int64(order.BuyerID),
+		TotalRevenue:     order.Amount,
+		TotalProductSold: totalProductSold,
+		Discount:         order.Discount,
+		VoucherCode:      order.VoucherCode,
+	}
+}
+
+// newOrderEvent, builds the event published when an order is created
+func newOrderEvent(order domain.Order) domain.PayloadEventOrder {
+	return domain.PayloadEventOrder{
+		OrderID:      int64(order.ID),
+		SellerID:     int64(order.SellerID),
+		BuyerID:      int64(order.BuyerID),
+		OrderDate:    order.CreatedAt.Format("2006-01-02"),
+		OrderStatus:  domain.OrderStatusNewInt,
+		TotalRevenue: order.Amount,
+		Discount:     order.Discount,
+		VoucherCode:  order.VoucherCode,
+		CategoryID:   orderCategory(order),
+	}
+}
+
+// orderCategory, the category of the most ordered products of the order, the lowest category on a tie
+func orderCategory(order domain.Order) int64 {
+	quantities := make(map[uint]int)
+	for _, v := range order.OrderDetails {
+		if v.Product.CategoryID != nil {
+			quantities[*v.Product.CategoryID] += v.ProductQuantity
+		}
+	}
+
+	var result uint
+	for category, quantity := range quantities {
+		if quantity > quantities[result] || (quantity == quantities[result] && category < result) {
+			result = category
+		}
+	}
+	return int64(result)
 }
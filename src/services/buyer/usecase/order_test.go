@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
 	"reflect"
 	"testing"
 	"time"
@@ -10,6 +11,8 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/validation"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/repository"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/repository/mocks"
@@ -50,20 +53,23 @@ func Test_orderUsecase_Products(t *testing.T) {
 						ID: 1,
 					},
 					ProductName: "Product 1",
-					Price:       100,
+					Price:       money.New(100, "IDR"),
 				},
 			},
 			wantErr: false,
 			mock: func() {
-				mockRepo.EXPECT().GetProducts(gomock.Any()).Return([]domain.Product{
+				mockRepo.EXPECT().GetProducts(gomock.Any(), domain.ProductFilter{
+					Page:     1,
+					PageSize: domain.DefaultProductPageSize,
+				}).Return([]domain.Product{
 					{
 						Model: yugabyte.Model{
 							ID: 1,
 						},
 						ProductName: "Product 1",
-						Price:       100,
+						Price:       money.New(100, "IDR"),
 					},
-				}, nil).Times(1)
+				}, int64(1), nil).Times(1)
 			},
 		},
 		{
@@ -77,7 +83,7 @@ func Test_orderUsecase_Products(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 			mock: func() {
-				mockRepo.EXPECT().GetProducts(gomock.Any()).Return(nil, errors.New("expected error")).Times(1)
+				mockRepo.EXPECT().GetProducts(gomock.Any(), gomock.Any()).Return(nil, int64(0), errors.New("expected error")).Times(1)
 			},
 		},
 	}
@@ -87,7 +93,7 @@ func Test_orderUsecase_Products(t *testing.T) {
 			ou := &orderUsecase{
 				orderRepo: tt.fields.orderRepo,
 			}
-			got, err := ou.Products(tt.args.ctx)
+			got, _, err := ou.Products(tt.args.ctx, domain.ProductFilter{})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("orderUsecase.Products() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -133,7 +139,7 @@ func Test_orderUsecase_ProductByID(t *testing.T) {
 					ID: 1,
 				},
 				ProductName: "Product 1",
-				Price:       100,
+				Price:       money.New(100, "IDR"),
 			},
 			wantErr: false,
 			mock: func() {
@@ -143,8 +149,9 @@ func Test_orderUsecase_ProductByID(t *testing.T) {
 						ID: 1,
 					},
 					ProductName: "Product 1",
-					Price:       100,
+					Price:       money.New(100, "IDR"),
 				}, nil).Times(1)
+				mockRepo.EXPECT().PublishFunnelEvent(gomock.Any(), funnelEventMatcher(domain.FunnelStepView, 0, 1)).Return(nil).Times(1)
 			},
 		},
 		{
@@ -186,70 +193,65 @@ func Test_orderUsecase_OrdersByBuyer(t *testing.T) {
 
 	mockRepo := mocks.NewMockOrderRepository(ctrl)
 
-	orderDate := datatypes.Date(time.Now())
+	summaries := []domain.OrderSummary{
+		{ID: 3, Status: "new", Amount: money.New(3000, "IDR"), ItemCount: 1},
+		{ID: 2, Status: "completed", Amount: money.New(2000, "IDR"), ItemCount: 2},
+		{ID: 1, Status: "new", Amount: money.New(1000, "IDR"), ItemCount: 1},
+	}
 
 	type fields struct {
 		orderRepo repository.OrderRepository
 	}
 	type args struct {
-		ctx context.Context
+		ctx    context.Context
+		filter domain.OrderFilter
 	}
 	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		want    []domain.Order
-		wantErr bool
-		mock    func()
+		name     string
+		fields   fields
+		args     args
+		want     []domain.OrderSummary
+		wantNext string
+		wantErr  bool
+		mock     func()
 	}{
 		{
-			name: "success",
+			name: "last page",
 			fields: fields{
 				orderRepo: mockRepo,
 			},
 			args: args{
-				ctx: context.TODO(),
-			},
-			want: []domain.Order{
-				{
-					Model: yugabyte.Model{
-						ID: 1,
-					},
-					OrderDate: orderDate,
-					BuyerID:   1,
-					OrderDetails: []domain.OrderDetail{
-						{
-							ProductID:       1,
-							ProductQuantity: 1,
-							OrderID:         1,
-						},
-					},
-					Status:    "new",
-					InvoiceNo: "INV01",
-					Amount:    1000,
-				},
+				ctx:    context.TODO(),
+				filter: domain.OrderFilter{BuyerID: 1},
 			},
+			want:    summaries,
 			wantErr: false,
 			mock: func() {
-				mockRepo.EXPECT().GetOrdersByBuyerID(gomock.Any(), gomock.Any()).Return([]domain.Order{
-					{
-						Model: yugabyte.Model{
-							ID: 1,
-						},
-						OrderDate: orderDate,
-						BuyerID:   1,
-						OrderDetails: []domain.OrderDetail{
-							{
-								ProductID:       1,
-								ProductQuantity: 1,
-								OrderID:         1,
-							},
-						},
-						Status:    "new",
-						InvoiceNo: "INV01",
-						Amount:    1000,
-					},
-				}, nil).Times(1)
+				mockRepo.EXPECT().GetOrderSummaries(gomock.Any(), domain.OrderFilter{
+					BuyerID: 1,
+					Sort:    domain.OrderSortNewest,
+					Limit:   domain.DefaultOrderPageSize,
+				}).Return(summaries, nil).Times(1)
+			},
+		},
+		{
+			name: "next page",
+			fields: fields{
+				orderRepo: mockRepo,
+			},
+			args: args{
+				ctx:    context.TODO(),
+				filter: domain.OrderFilter{BuyerID: 1, Sort: domain.OrderSortAmountDesc, Limit: 2},
+			},
+			want:     summaries[:2],
+			wantNext: domain.OrderCursor{ID: 2, Amount: 2000}.Encode(),
+			wantErr:  false,
+			mock: func() {
+				mockRepo.EXPECT().GetOrderSummaries(gomock.Any(), domain.OrderFilter{
+					BuyerID: 1,
+					Sort:    domain.OrderSortAmountDesc,
+					Limit:   2,
+				}).Return(summaries, nil).Times(1)
 			},
 		},
 		{
@@ -258,12 +260,13 @@ func Test_orderUsecase_OrdersByBuyer(t *testing.T) {
 				orderRepo: mockRepo,
 			},
 			args: args{
-				ctx: context.TODO(),
+				ctx:    context.TODO(),
+				filter: domain.OrderFilter{BuyerID: 1},
 			},
-			want:    []domain.Order{},
+			want:    nil,
 			wantErr: true,
 			mock: func() {
-				mockRepo.EXPECT().GetOrdersByBuyerID(gomock.Any(), gomock.Any()).Return([]domain.Order{}, errors.New("expected error")).Times(1)
+				mockRepo.EXPECT().GetOrderSummaries(gomock.Any(), gomock.Any()).Return(nil, errors.New("expected error")).Times(1)
 			},
 		},
 	}
@@ -273,13 +276,16 @@ func Test_orderUsecase_OrdersByBuyer(t *testing.T) {
 			ou := &orderUsecase{
 				orderRepo: tt.fields.orderRepo,
 			}
-			got, err := ou.OrdersByBuyer(tt.args.ctx, 1)
+			got, next, err := ou.OrdersByBuyer(tt.args.ctx, tt.args.filter)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("orderUsecase.Orders() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("orderUsecase.OrdersByBuyer() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("orderUsecase.Orders() = %v, want %v", got, tt.want)
+				t.Errorf("orderUsecase.OrdersByBuyer() = %v, want %v", got, tt.want)
+			}
+			if next != tt.wantNext {
+				t.Errorf("orderUsecase.OrdersByBuyer() next = %v, want %v", next, tt.wantNext)
 			}
 		})
 	}
@@ -334,7 +340,7 @@ func Test_orderUsecase_UpdateOrder(t *testing.T) {
 						ID: 1,
 					},
 					BuyerID:   1,
-					Status:    "new",
+					Status:    "paid",
 					OrderDate: orderDate,
 					OrderDetails: []domain.OrderDetail{
 						{
@@ -386,7 +392,7 @@ func Test_orderUsecase_UpdateOrder(t *testing.T) {
 						ID: 1,
 					},
 					BuyerID:   1,
-					Status:    "new",
+					Status:    "paid",
 					OrderDate: orderDate,
 					OrderDetails: []domain.OrderDetail{
 						{
@@ -400,6 +406,29 @@ func Test_orderUsecase_UpdateOrder(t *testing.T) {
 				mockRepo.EXPECT().UpdateOrderById(gomock.Any(), gomock.Any()).Return(nil, errors.New("expected error")).Times(1)
 			},
 		},
+		{
+			name: "error order not new",
+			fields: fields{
+				orderRepo: mockRepo,
+			},
+			args: args{
+				ctx:     ctx,
+				orderId: 1,
+				status:  "cancelled",
+			},
+			want:    nil,
+			wantErr: true,
+			mock: func() {
+				mockRepo.EXPECT().GetOrderByID(gomock.Any(), gomock.Any()).Return(&domain.Order{
+					Model: yugabyte.Model{
+						ID: 1,
+					},
+					BuyerID:   1,
+					Status:    "completed",
+					OrderDate: orderDate,
+				}, nil).Times(1)
+			},
+		},
 		{
 			name: "error publish mq",
 			fields: fields{
@@ -481,6 +510,223 @@ func Test_orderUsecase_UpdateOrder(t *testing.T) {
 	}
 }
 
+func Test_orderUsecase_RefundOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockOrderRepository(ctrl)
+
+	orderDate := datatypes.Date(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	ctx := context.WithValue(context.TODO(), domain.BuyerKey, uint(1))
+
+	completedOrder := func() *domain.Order {
+		return &domain.Order{
+			Model:     yugabyte.Model{ID: 1},
+			BuyerID:   1,
+			SellerID:  2,
+			Status:    domain.OrderStatusCompleted,
+			Amount:    money.New(5000, "IDR"),
+			OrderDate: orderDate,
+			OrderDetails: []domain.OrderDetail{
+				{
+					Model:           yugabyte.Model{ID: 10},
+					ProductID:       1,
+					ProductQuantity: 2,
+					OrderID:         1,
+					UnitPrice:       money.New(1000, "IDR"),
+				},
+				{
+					Model:           yugabyte.Model{ID: 11},
+					ProductID:       2,
+					ProductQuantity: 1,
+					OrderID:         1,
+					UnitPrice:       money.New(3000, "IDR"),
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		items      []domain.RefundItem
+		wantStatus string
+		wantErr    error
+		mock       func()
+	}{
+		{
+			name:       "partial refund",
+			items:      []domain.RefundItem{{OrderDetailID: 10, Quantity: 1}},
+			wantStatus: domain.OrderStatusPartiallyRefunded,
+			mock: func() {
+				mockRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(completedOrder(), nil)
+				mockRepo.EXPECT().RefundOrder(gomock.Any(), gomock.Any(), []domain.RefundItem{
+					{OrderDetailID: 10, ProductID: 1, Quantity: 1},
+				}, money.New(1000, "IDR")).DoAndReturn(func(ctx context.Context, order domain.Order, items []domain.RefundItem, amount money.Money) (*domain.Order, error) {
+					return &order, nil
+				})
+				mockRepo.EXPECT().PublishOrderEvent(gomock.Any(), domain.PayloadEventOrder{
+					OrderID:          1,
+					SellerID:         2,
+					BuyerID:          1,
+					OrderDate:        "2022-01-01",
+					OrderStatus:      domain.OrderStatusRefundedInt,
+					RefundedAmount:   money.New(1000, "IDR"),
+					RefundedQuantity: 1,
+					FirstRefund:      true,
+				}).Return(nil)
+			},
+		},
+		{
+			name:       "full refund",
+			wantStatus: domain.OrderStatusRefunded,
+			mock: func() {
+				mockRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(completedOrder(), nil)
+				mockRepo.EXPECT().RefundOrder(gomock.Any(), gomock.Any(), []domain.RefundItem{
+					{OrderDetailID: 10, ProductID: 1, Quantity: 2},
+					{OrderDetailID: 11, ProductID: 2, Quantity: 1},
+				}, money.New(5000, "IDR")).DoAndReturn(func(ctx context.Context, order domain.Order, items []domain.RefundItem, amount money.Money) (*domain.Order, error) {
+					return &order, nil
+				})
+				mockRepo.EXPECT().PublishOrderEvent(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:       "partial refund of a discounted order",
+			items:      []domain.RefundItem{{OrderDetailID: 10, Quantity: 1}},
+			wantStatus: domain.OrderStatusPartiallyRefunded,
+			mock: func() {
+				order := completedOrder()
+				order.Amount = money.New(4500, "IDR")
+				order.Discount = money.New(500, "IDR")
+				mockRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(order, nil)
+				mockRepo.EXPECT().RefundOrder(gomock.Any(), gomock.Any(), gomock.Any(), money.New(900, "IDR")).DoAndReturn(func(ctx context.Context, order domain.Order, items []domain.RefundItem, amount money.Money) (*domain.Order, error) {
+					return &order, nil
+				})
+				mockRepo.EXPECT().PublishOrderEvent(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:       "partial refund of a large discounted order",
+			items:      []domain.RefundItem{{OrderDetailID: 10, Quantity: 1}},
+			wantStatus: domain.OrderStatusPartiallyRefunded,
+			mock: func() {
+				// 31M IDR items, pro-rating in int64 would overflow
+				order := completedOrder()
+				order.OrderDetails = order.OrderDetails[:1]
+				order.OrderDetails[0].UnitPrice = money.New(3100000000, "IDR")
+				order.Amount = money.New(5999999999, "IDR")
+				order.Discount = money.New(200000001, "IDR")
+				mockRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(order, nil)
+				mockRepo.EXPECT().RefundOrder(gomock.Any(), gomock.Any(), gomock.Any(), money.New(3000000000, "IDR")).DoAndReturn(func(ctx context.Context, order domain.Order, items []domain.RefundItem, amount money.Money) (*domain.Order, error) {
+					return &order, nil
+				})
+				mockRepo.EXPECT().PublishOrderEvent(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:       "last refund of a discounted order refunds the rest",
+			wantStatus: domain.OrderStatusRefunded,
+			mock: func() {
+				order := completedOrder()
+				order.Status = domain.OrderStatusPartiallyRefunded
+				order.Amount = money.New(4500, "IDR")
+				order.Discount = money.New(500, "IDR")
+				order.RefundedAmount = money.New(900, "IDR")
+				order.OrderDetails[0].RefundedQuantity = 1
+				mockRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(order, nil)
+				mockRepo.EXPECT().RefundOrder(gomock.Any(), gomock.Any(), []domain.RefundItem{
+					{OrderDetailID: 10, ProductID: 1, Quantity: 1},
+					{OrderDetailID: 11, ProductID: 2, Quantity: 1},
+				}, money.New(3600, "IDR")).DoAndReturn(func(ctx context.Context, order domain.Order, items []domain.RefundItem, amount money.Money) (*domain.Order, error) {
+					return &order, nil
+				})
+				mockRepo.EXPECT().PublishOrderEvent(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:       "refund after a refund of free items",
+			items:      []domain.RefundItem{{OrderDetailID: 11, Quantity: 1}},
+			wantStatus: domain.OrderStatusRefunded,
+			mock: func() {
+				order := completedOrder()
+				order.Status = domain.OrderStatusPartiallyRefunded
+				order.Amount = money.New(3000, "IDR")
+				order.OrderDetails[0].UnitPrice = money.New(0, "IDR")
+				order.OrderDetails[0].RefundedQuantity = 2
+				mockRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(order, nil)
+				mockRepo.EXPECT().RefundOrder(gomock.Any(), gomock.Any(), gomock.Any(), money.New(3000, "IDR")).DoAndReturn(func(ctx context.Context, order domain.Order, items []domain.RefundItem, amount money.Money) (*domain.Order, error) {
+					return &order, nil
+				})
+				// the order was counted as refunded on the refund of the free items
+				mockRepo.EXPECT().PublishOrderEvent(gomock.Any(), domain.PayloadEventOrder{
+					OrderID:          1,
+					SellerID:         2,
+					BuyerID:          1,
+					OrderDate:        "2022-01-01",
+					OrderStatus:      domain.OrderStatusRefundedInt,
+					RefundedAmount:   money.New(3000, "IDR"),
+					RefundedQuantity: 1,
+				}).Return(nil)
+			},
+		},
+		{
+			name:    "refund exceeds quantity",
+			items:   []domain.RefundItem{{OrderDetailID: 10, Quantity: 3}},
+			wantErr: &validation.Error{},
+			mock: func() {
+				mockRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(completedOrder(), nil)
+			},
+		},
+		{
+			name:    "unknown order item",
+			items:   []domain.RefundItem{{OrderDetailID: 99, Quantity: 1}},
+			wantErr: &validation.Error{},
+			mock: func() {
+				mockRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(completedOrder(), nil)
+			},
+		},
+		{
+			name:    "order not completed",
+			wantErr: domain.ErrInvalidOrderStatus,
+			mock: func() {
+				order := completedOrder()
+				order.Status = domain.OrderStatusNew
+				mockRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(order, nil)
+			},
+		},
+		{
+			name:    "order of another buyer",
+			wantErr: domain.ErrForbidden,
+			mock: func() {
+				order := completedOrder()
+				order.BuyerID = 2
+				mockRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(order, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt.mock()
+		t.Run(tt.name, func(t *testing.T) {
+			ou := &orderUsecase{
+				orderRepo: mockRepo,
+			}
+			res, err := ou.RefundOrder(ctx, 1, tt.items)
+			if tt.wantErr != nil {
+				if verr, ok := tt.wantErr.(*validation.Error); ok {
+					assert.ErrorAs(t, err, &verr)
+				} else {
+					assert.ErrorIs(t, err, tt.wantErr)
+				}
+				assert.Nil(t, res)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantStatus, res.Status)
+		})
+	}
+}
+
 func Test_orderUsecase_CreateOrder(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -528,16 +774,16 @@ func Test_orderUsecase_CreateOrder(t *testing.T) {
 			},
 			wantErr: false,
 			mock: func() {
-				mockRepo.EXPECT().GetOngoingOrders(gomock.Any(), gomock.Any()).Return(false, nil).Times(1)
-
 				mockRepo.EXPECT().GetProductByID(gomock.Any(), gomock.Any()).Return(&domain.Product{
 					Model: yugabyte.Model{
 						ID: 1,
 					},
 					ProductName: "Product 1",
-					Price:       100,
+					Price:       money.New(100, "IDR"),
+					Stock:       10,
 				}, nil).Times(1)
 
+				mockRepo.EXPECT().PublishFunnelEvent(gomock.Any(), funnelEventMatcher(domain.FunnelStepCheckout, 0, 0)).Return(nil).Times(1)
 				mockRepo.EXPECT().InsertOrder(gomock.Any(), gomock.Any()).Return(&domain.Order{
 					Model: yugabyte.Model{
 						ID: 1,
@@ -549,45 +795,218 @@ func Test_orderUsecase_CreateOrder(t *testing.T) {
 							ProductID:       1,
 							ProductQuantity: 1,
 							OrderID:         1,
+							Product:         domain.Product{Stock: 9},
+						},
+					},
+				}, nil).Times(1)
+				mockRepo.EXPECT().PublishOrderEvent(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+		},
+		{
+			name: "success publish low stock",
+			fields: fields{
+				orderRepo: mockRepo,
+			},
+			args: args{
+				ctx: context.TODO(),
+				req: domain.Order{
+					Status:    "new",
+					OrderDate: orderDate,
+					OrderDetails: []domain.OrderDetail{
+						{
+							ProductID:       1,
+							ProductQuantity: 2,
+						},
+					},
+				},
+			},
+			wantErr: false,
+			mock: func() {
+				mockRepo.EXPECT().GetProductByID(gomock.Any(), uint(1)).Return(&domain.Product{
+					Model: yugabyte.Model{
+						ID: 1,
+					},
+					SellerID: 1,
+					Price:    money.New(100, "IDR"),
+					Stock:    2,
+				}, nil).Times(1)
+
+				mockRepo.EXPECT().PublishFunnelEvent(gomock.Any(), funnelEventMatcher(domain.FunnelStepCheckout, 1, 0)).Return(nil).Times(1)
+				mockRepo.EXPECT().InsertOrder(gomock.Any(), gomock.Any()).Return(&domain.Order{
+					Model: yugabyte.Model{
+						ID: 1,
+					},
+					SellerID:  1,
+					Status:    "new",
+					OrderDate: orderDate,
+					OrderDetails: []domain.OrderDetail{
+						{
+							ProductID:       1,
+							ProductQuantity: 2,
+							OrderID:         1,
+							Product:         domain.Product{Stock: 0},
 						},
 					},
 				}, nil).Times(1)
+				mockRepo.EXPECT().PublishLowStockEvent(gomock.Any(), domain.PayloadEventLowStock{
+					ProductID: 1,
+					SellerID:  1,
+					Stock:     0,
+					Date:      "0001-01-01",
+				}).Return(nil).Times(1)
 				mockRepo.EXPECT().PublishOrderEvent(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 			},
 		},
 		{
-			name: "error has ongoing orders",
+			name: "error insufficient stock",
 			fields: fields{
 				orderRepo: mockRepo,
 			},
 			args: args{
 				ctx: context.TODO(),
 				req: domain.Order{
+					Status:    "new",
+					OrderDate: orderDate,
+					OrderDetails: []domain.OrderDetail{
+						{
+							ProductID:       1,
+							ProductQuantity: 3,
+						},
+					},
+				},
+			},
+			wantErr: true,
+			mock: func() {
+				mockRepo.EXPECT().GetProductByID(gomock.Any(), uint(1)).Return(&domain.Product{
 					Model: yugabyte.Model{
 						ID: 1,
 					},
+					Price: money.New(100, "IDR"),
+					Stock: 2,
+				}, nil).Times(1)
+			},
+		},
+		{
+			name: "error stock reserved concurrently",
+			fields: fields{
+				orderRepo: mockRepo,
+			},
+			args: args{
+				ctx: context.TODO(),
+				req: domain.Order{
 					Status:    "new",
 					OrderDate: orderDate,
 					OrderDetails: []domain.OrderDetail{
 						{
 							ProductID:       1,
 							ProductQuantity: 1,
-							OrderID:         1,
 						},
 					},
 				},
 			},
 			wantErr: true,
 			mock: func() {
-				mockRepo.EXPECT().GetOngoingOrders(gomock.Any(), gomock.Any()).Return(true, nil).Times(1)
+				mockRepo.EXPECT().GetProductByID(gomock.Any(), uint(1)).Return(&domain.Product{
+					Model: yugabyte.Model{
+						ID: 1,
+					},
+					Price: money.New(100, "IDR"),
+					Stock: 1,
+				}, nil).Times(1)
+				mockRepo.EXPECT().PublishFunnelEvent(gomock.Any(), funnelEventMatcher(domain.FunnelStepCheckout, 0, 0)).Return(nil).Times(1)
+				mockRepo.EXPECT().InsertOrder(gomock.Any(), gomock.Any()).Return(nil, domain.ErrInsufficientStock).Times(1)
+			},
+		},
+		{
+			name: "error product not found",
+			fields: fields{
+				orderRepo: mockRepo,
+			},
+			args: args{
+				ctx: context.TODO(),
+				req: domain.Order{
+					Status:    "new",
+					OrderDate: orderDate,
+					OrderDetails: []domain.OrderDetail{
+						{
+							ProductID:       1,
+							ProductQuantity: 1,
+						},
+						{
+							ProductID:       99,
+							ProductQuantity: 1,
+						},
+					},
+				},
+			},
+			wantErr: true,
+			mock: func() {
+				mockRepo.EXPECT().GetProductByID(gomock.Any(), uint(1)).Return(&domain.Product{
+					Model: yugabyte.Model{
+						ID: 1,
+					},
+					ProductName: "Product 1",
+					Price:       money.New(100, "IDR"),
+					Stock:       10,
+				}, nil).Times(1)
+				mockRepo.EXPECT().GetProductByID(gomock.Any(), uint(99)).Return(nil, nil).Times(1)
+			},
+		},
+		{
+			name: "error products from several sellers",
+			fields: fields{
+				orderRepo: mockRepo,
+			},
+			args: args{
+				ctx: context.TODO(),
+				req: domain.Order{
+					Status:    "new",
+					OrderDate: orderDate,
+					OrderDetails: []domain.OrderDetail{
+						{
+							ProductID:       1,
+							ProductQuantity: 1,
+						},
+						{
+							ProductID:       2,
+							ProductQuantity: 1,
+						},
+					},
+				},
+			},
+			wantErr: true,
+			mock: func() {
+				mockRepo.EXPECT().GetProductByID(gomock.Any(), uint(1)).Return(&domain.Product{
+					Model: yugabyte.Model{
+						ID: 1,
+					},
+					SellerID:    1,
+					ProductName: "Product 1",
+					Price:       money.New(100, "IDR"),
+					Stock:       10,
+				}, nil).Times(1)
+				mockRepo.EXPECT().GetProductByID(gomock.Any(), uint(2)).Return(&domain.Product{
+					Model: yugabyte.Model{
+						ID: 2,
+					},
+					SellerID:    2,
+					ProductName: "Product 2",
+					Price:       money.New(100, "IDR"),
+					Stock:       10,
+				}, nil).Times(1)
 			},
 		},
 	}
+	// the buyer has no address, orders are placed without a shipping address
+	mockAddressRepo := mocks.NewMockAddressRepository(ctrl)
+	mockAddressRepo.EXPECT().GetDefaultAddress(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
 	for _, tt := range tests {
 		tt.mock()
 		t.Run(tt.name, func(t *testing.T) {
 			ou := &orderUsecase{
-				orderRepo: tt.fields.orderRepo,
+				orderRepo:   tt.fields.orderRepo,
+				addressRepo: mockAddressRepo,
 			}
 			if _, err := ou.CreateOrder(tt.args.ctx, tt.args.req); (err != nil) != tt.wantErr {
 				t.Errorf("orderUsecase.CreateOrder() error = %v, wantErr %v", err, tt.wantErr)
@@ -603,6 +1022,8 @@ func Test_orderUsecase_OrderByID(t *testing.T) {
 	mockRepo := mocks.NewMockOrderRepository(ctrl)
 
 	orderDate := datatypes.Date(time.Now())
+	ctx := context.WithValue(context.TODO(), domain.BuyerKey, uint(1))
+	errExpected := errors.New("expected error")
 
 	type fields struct {
 		orderRepo repository.OrderRepository
@@ -616,7 +1037,7 @@ func Test_orderUsecase_OrderByID(t *testing.T) {
 		fields  fields
 		args    args
 		want    *domain.Order
-		wantErr bool
+		wantErr error
 		mock    func()
 	}{
 		{
@@ -625,7 +1046,7 @@ func Test_orderUsecase_OrderByID(t *testing.T) {
 				orderRepo: mockRepo,
 			},
 			args: args{
-				ctx: context.TODO(),
+				ctx: ctx,
 			},
 			want: &domain.Order{
 				Model: yugabyte.Model{
@@ -641,10 +1062,10 @@ func Test_orderUsecase_OrderByID(t *testing.T) {
 				},
 				Status:    "new",
 				InvoiceNo: "INV01",
-				Amount:    1000,
+				Amount:    money.New(1000, "IDR"),
 				OrderDate: orderDate,
 			},
-			wantErr: false,
+			wantErr: nil,
 			mock: func() {
 				mockRepo.EXPECT().GetOrderByID(gomock.Any(), gomock.Any()).Return(&domain.Order{
 					Model: yugabyte.Model{
@@ -660,7 +1081,7 @@ func Test_orderUsecase_OrderByID(t *testing.T) {
 					},
 					Status:    "new",
 					InvoiceNo: "INV01",
-					Amount:    1000,
+					Amount:    money.New(1000, "IDR"),
 					OrderDate: orderDate,
 				}, nil).Times(1)
 			},
@@ -674,9 +1095,40 @@ func Test_orderUsecase_OrderByID(t *testing.T) {
 				ctx: context.TODO(),
 			},
 			want:    nil,
-			wantErr: true,
+			wantErr: errExpected,
 			mock: func() {
-				mockRepo.EXPECT().GetOrderByID(gomock.Any(), gomock.Any()).Return(nil, errors.New("expected error")).Times(1)
+				mockRepo.EXPECT().GetOrderByID(gomock.Any(), gomock.Any()).Return(nil, errExpected).Times(1)
+			},
+		},
+		{
+			name: "not found",
+			fields: fields{
+				orderRepo: mockRepo,
+			},
+			args: args{
+				ctx: ctx,
+			},
+			want:    nil,
+			wantErr: domain.ErrNotFound,
+			mock: func() {
+				mockRepo.EXPECT().GetOrderByID(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+			},
+		},
+		{
+			name: "order of another buyer",
+			fields: fields{
+				orderRepo: mockRepo,
+			},
+			args: args{
+				ctx: ctx,
+			},
+			want:    nil,
+			wantErr: domain.ErrForbidden,
+			mock: func() {
+				mockRepo.EXPECT().GetOrderByID(gomock.Any(), gomock.Any()).Return(&domain.Order{
+					Model:   yugabyte.Model{ID: 1},
+					BuyerID: 2,
+				}, nil).Times(1)
 			},
 		},
 	}
@@ -687,7 +1139,7 @@ func Test_orderUsecase_OrderByID(t *testing.T) {
 				orderRepo: tt.fields.orderRepo,
 			}
 			got, err := ou.OrderByID(tt.args.ctx, tt.args.id)
-			if (err != nil) != tt.wantErr {
+			if !errors.Is(err, tt.wantErr) {
 				t.Errorf("orderUsecase.OrderByID() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
@@ -718,15 +1170,289 @@ func TestNewOrderUsecase(t *testing.T) {
 				orderRepo: mockRepo,
 			},
 			want: &orderUsecase{
-				orderRepo: mockRepo,
+				orderRepo:    mockRepo,
+				inventoryCfg: domain.InventoryConfig{LowStockThreshold: 5},
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := NewOrderUsecase(tt.args.orderRepo); !reflect.DeepEqual(got, tt.want) {
+			if got := NewOrderUsecase(tt.args.orderRepo, nil, nil, domain.InventoryConfig{LowStockThreshold: 5}); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("NewOrderUsecase() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
+
+func Test_applyShippingAddress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	addressRepo := mocks.NewMockAddressRepository(ctrl)
+
+	home := &domain.Address{
+		Model:   yugabyte.Model{ID: 3},
+		BuyerID: 1,
+		ShippingAddress: domain.ShippingAddress{
+			RecipientName: "Budi",
+			Street:        "Jl. Sudirman 1",
+			City:          "Jakarta",
+			PostalCode:    "10220",
+			Country:       "ID",
+		},
+		IsDefault: true,
+	}
+	office := &domain.Address{
+		Model:   yugabyte.Model{ID: 4},
+		BuyerID: 1,
+		ShippingAddress: domain.ShippingAddress{
+			RecipientName: "Budi",
+			Street:        "Jl. Thamrin 9",
+			City:          "Jakarta",
+			PostalCode:    "10350",
+			Country:       "ID",
+		},
+	}
+	addressId := func(id uint) *uint { return &id }
+
+	tests := []struct {
+		name      string
+		addressId *uint
+		want      domain.Order
+		wantErr   error
+		mock      func()
+	}{
+		{
+			name: "default address",
+			want: domain.Order{BuyerID: 1, AddressID: addressId(3), ShippingAddress: home.ShippingAddress},
+			mock: func() {
+				addressRepo.EXPECT().GetDefaultAddress(gomock.Any(), uint(1)).Return(home, nil)
+			},
+		},
+		{
+			name:      "chosen address",
+			addressId: addressId(4),
+			want:      domain.Order{BuyerID: 1, AddressID: addressId(4), ShippingAddress: office.ShippingAddress},
+			mock: func() {
+				addressRepo.EXPECT().GetAddress(gomock.Any(), uint(1), uint(4)).Return(office, nil)
+			},
+		},
+		{
+			name: "buyer without address",
+			want: domain.Order{BuyerID: 1},
+			mock: func() {
+				addressRepo.EXPECT().GetDefaultAddress(gomock.Any(), uint(1)).Return(nil, nil)
+			},
+		},
+		{
+			name:      "address of another buyer",
+			addressId: addressId(5),
+			wantErr:   validation.NewError("address_id", "address does not exist"),
+			mock: func() {
+				addressRepo.EXPECT().GetAddress(gomock.Any(), uint(1), uint(5)).Return(nil, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			order := domain.Order{BuyerID: 1, AddressID: tt.addressId}
+			err := applyShippingAddress(context.TODO(), addressRepo, &order)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, order)
+		})
+	}
+}
+
+func Test_orderCategory(t *testing.T) {
+	categoryId := func(id uint) *uint { return &id }
+	detail := func(category *uint, quantity int) domain.OrderDetail {
+		return domain.OrderDetail{Product: domain.Product{CategoryID: category}, ProductQuantity: quantity}
+	}
+
+	tests := []struct {
+		name    string
+		details []domain.OrderDetail
+		want    int64
+	}{
+		{
+			name:    "most ordered category",
+			details: []domain.OrderDetail{detail(categoryId(1), 1), detail(categoryId(2), 3), detail(nil, 5)},
+			want:    2,
+		},
+		{
+			name:    "tie",
+			details: []domain.OrderDetail{detail(categoryId(3), 2), detail(categoryId(2), 2)},
+			want:    2,
+		},
+		{
+			name:    "no category",
+			details: []domain.OrderDetail{detail(nil, 1)},
+			want:    0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, orderCategory(domain.Order{OrderDetails: tt.details}))
+		})
+	}
+}
+
+func Test_applyVoucher(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	voucherRepo := mocks.NewMockVoucherRepository(ctrl)
+
+	now := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	yesterday := now.Add(-24 * time.Hour)
+	voucherId := uint(5)
+	orders := func() []domain.Order {
+		return []domain.Order{
+			{SellerID: 1, Amount: money.New(10000, "IDR")},
+			{SellerID: 2, Amount: money.New(20000, "IDR")},
+		}
+	}
+	voucher := func(modify func(v *domain.Voucher)) *domain.Voucher {
+		v := &domain.Voucher{
+			Model:      yugabyte.Model{ID: voucherId},
+			SellerID:   2,
+			Code:       "PROMO",
+			Type:       domain.VoucherTypePercentage,
+			Percentage: 10,
+		}
+		if modify != nil {
+			modify(v)
+		}
+		return v
+	}
+
+	tests := []struct {
+		name    string
+		code    string
+		want    []domain.Order
+		wantErr error
+		mock    func()
+	}{
+		{
+			name: "no voucher",
+			want: orders(),
+			mock: func() {},
+		},
+		{
+			name: "percentage voucher",
+			code: " promo ",
+			want: []domain.Order{
+				{SellerID: 1, Amount: money.New(10000, "IDR")},
+				{SellerID: 2, Amount: money.New(18000, "IDR"), Discount: money.New(2000, "IDR"), VoucherID: &voucherId, VoucherCode: "PROMO"},
+			},
+			mock: func() {
+				voucherRepo.EXPECT().GetVoucherByCode(gomock.Any(), "PROMO").Return(voucher(nil), nil)
+			},
+		},
+		{
+			name: "fixed voucher never exceeds the order",
+			code: "PROMO",
+			want: []domain.Order{
+				{SellerID: 1, Amount: money.New(10000, "IDR")},
+				{SellerID: 2, Amount: money.New(0, "IDR"), Discount: money.New(20000, "IDR"), VoucherID: &voucherId, VoucherCode: "PROMO"},
+			},
+			mock: func() {
+				voucherRepo.EXPECT().GetVoucherByCode(gomock.Any(), "PROMO").Return(voucher(func(v *domain.Voucher) {
+					v.Type = domain.VoucherTypeFixed
+					v.Amount = money.New(50000, "IDR")
+				}), nil)
+			},
+		},
+		{
+			name:    "minimum spend not reached",
+			code:    "PROMO",
+			wantErr: validation.NewError("voucher_code", "minimum spend of IDR 250.00 not reached"),
+			mock: func() {
+				voucherRepo.EXPECT().GetVoucherByCode(gomock.Any(), "PROMO").Return(voucher(func(v *domain.Voucher) {
+					v.MinSpend = money.New(25000, "IDR")
+				}), nil)
+			},
+		},
+		{
+			name:    "expired voucher",
+			code:    "PROMO",
+			wantErr: validation.NewError("voucher_code", "voucher is not valid at this time"),
+			mock: func() {
+				voucherRepo.EXPECT().GetVoucherByCode(gomock.Any(), "PROMO").Return(voucher(func(v *domain.Voucher) {
+					v.EndsAt = &yesterday
+				}), nil)
+			},
+		},
+		{
+			name:    "used up voucher",
+			code:    "PROMO",
+			wantErr: validation.NewError("voucher_code", "voucher usage limit reached"),
+			mock: func() {
+				voucherRepo.EXPECT().GetVoucherByCode(gomock.Any(), "PROMO").Return(voucher(func(v *domain.Voucher) {
+					v.UsageLimit = 3
+					v.UsedCount = 3
+				}), nil)
+			},
+		},
+		{
+			name:    "voucher of another seller",
+			code:    "PROMO",
+			wantErr: validation.NewError("voucher_code", "voucher does not apply to any product of the order"),
+			mock: func() {
+				voucherRepo.EXPECT().GetVoucherByCode(gomock.Any(), "PROMO").Return(voucher(func(v *domain.Voucher) {
+					v.SellerID = 3
+				}), nil)
+			},
+		},
+		{
+			name:    "unknown voucher",
+			code:    "PROMO",
+			wantErr: validation.NewError("voucher_code", "voucher does not exist"),
+			mock: func() {
+				voucherRepo.EXPECT().GetVoucherByCode(gomock.Any(), "PROMO").Return(nil, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			got := orders()
+			err := applyVoucher(context.TODO(), voucherRepo, got, tt.code, now)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// funnelEvent, matches a funnel event regardless of its date
+type funnelEvent struct {
+	step      string
+	sellerId  int64
+	productId int64
+}
+
+func funnelEventMatcher(step string, sellerId, productId int64) gomock.Matcher {
+	return funnelEvent{step: step, sellerId: sellerId, productId: productId}
+}
+
+func (m funnelEvent) Matches(x interface{}) bool {
+	evt, ok := x.(domain.PayloadEventFunnel)
+	return ok && evt.Step == m.step && evt.SellerID == m.sellerId && evt.ProductID == m.productId && evt.Date != ""
+}
+
+func (m funnelEvent) String() string {
+	return fmt.Sprintf("is a %s funnel event of seller %d and product %d", m.step, m.sellerId, m.productId)
+}
@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/repository"
+)
+
+// PaymentUsecase, interface for payment usecase
+type PaymentUsecase interface {
+	PayOrder(ctx context.Context, buyerId uint, orderId uint) (*domain.Payment, error)
+	HandleWebhook(ctx context.Context, payload []byte, signature string) error
+}
+
+// paymentUsecase, concrete implementation of payment usecase
+type paymentUsecase struct {
+	orderRepo   repository.OrderRepository
+	paymentRepo repository.PaymentRepository
+	gateway     repository.PaymentGateway
+}
+
+// NewPaymentUsecase, constructor function for payment usecase
+func NewPaymentUsecase(orderRepo repository.OrderRepository, paymentRepo repository.PaymentRepository, gateway repository.PaymentGateway) PaymentUsecase {
+	return &paymentUsecase{
+		orderRepo:   orderRepo,
+		paymentRepo: paymentRepo,
+		gateway:     gateway,
+	}
+}
+
+// PayOrder, creates a payment of a new order of the buyer at the payment provider, the pending payment is
+// returned again while the provider has not reported its outcome yet
+func (pu *paymentUsecase) PayOrder(ctx context.Context, buyerId uint, orderId uint) (*domain.Payment, error) {
+	order, err := pu.orderRepo.GetOrderByID(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if order == nil {
+		return nil, domain.ErrNotFound
+	}
+
+	if order.BuyerID != buyerId {
+		return nil, domain.ErrForbidden
+	}
+
+	if !domain.CanChangeOrderStatus(order.Status, domain.OrderStatusPaid) {
+		return nil, domain.ErrInvalidOrderStatus
+	}
+
+	pending, err := pu.paymentRepo.GetPendingPayment(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+	if pending != nil {
+		return pending, nil
+	}
+
+	payment := domain.Payment{
+		OrderID:  order.ID,
+		Provider: pu.gateway.Provider(),
+		Amount:   order.Amount,
+		Status:   domain.PaymentStatusPending,
+	}
+
+	intent, err := pu.gateway.CreateIntent(ctx, payment)
+	if err != nil {
+		return nil, err
+	}
+	payment.Reference = intent.Reference
+
+	return pu.paymentRepo.CreatePayment(ctx, payment)
+}
+
+// HandleWebhook, settles the payment the payment provider reports the outcome of, notifications of settled
+// payments are ignored as providers deliver them more than once
+func (pu *paymentUsecase) HandleWebhook(ctx context.Context, payload []byte, signature string) error {
+	notification, err := pu.gateway.ParseWebhook(payload, signature)
+	if err != nil {
+		return err
+	}
+
+	payment, err := pu.paymentRepo.GetPaymentByReference(ctx, pu.gateway.Provider(), notification.Reference)
+	if err != nil {
+		return err
+	}
+
+	if payment == nil {
+		return domain.ErrNotFound
+	}
+
+	if payment.Status != domain.PaymentStatusPending {
+		return nil
+	}
+
+	settledAt := time.Now()
+	payment.Status = notification.Status
+	payment.SettledAt = &settledAt
+
+	order, err := pu.paymentRepo.SettlePayment(ctx, *payment)
+	if errors.Is(err, domain.ErrPaymentSettled) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if payment.Status == domain.PaymentStatusSucceeded && order.Status != domain.OrderStatusPaid {
+		log.Printf("payment %s of order %d succeeded but the order is %s, the payment needs to be refunded", payment.Reference, order.ID, order.Status)
+	}
+
+	err = pu.paymentRepo.PublishPaymentEvent(ctx, domain.PayloadEventPayment{
+		OrderID:   int64(order.ID),
+		SellerID:  int64(order.SellerID),
+		Status:    payment.Status,
+		Amount:    payment.Amount,
+		OrderDate: order.OrderDateStr,
+	})
+	if err != nil {
+		log.Println("error publishing payment event", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,213 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/repository/mocks"
+)
+
+func Test_paymentUsecase_PayOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	orderRepo := mocks.NewMockOrderRepository(ctrl)
+	paymentRepo := mocks.NewMockPaymentRepository(ctrl)
+	gateway := mocks.NewMockPaymentGateway(ctrl)
+
+	order := func(status string) *domain.Order {
+		return &domain.Order{
+			Model:   yugabyte.Model{ID: 1},
+			BuyerID: 1,
+			Status:  status,
+			Amount:  money.New(1000, "IDR"),
+		}
+	}
+	pending := &domain.Payment{
+		OrderID:   1,
+		Provider:  domain.PaymentProviderFake,
+		Reference: "fake_1",
+		Amount:    money.New(1000, "IDR"),
+		Status:    domain.PaymentStatusPending,
+	}
+
+	tests := []struct {
+		name    string
+		buyerId uint
+		want    *domain.Payment
+		wantErr error
+		mock    func()
+	}{
+		{
+			name:    "new payment",
+			buyerId: 1,
+			want:    pending,
+			mock: func() {
+				orderRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(order(domain.OrderStatusNew), nil)
+				paymentRepo.EXPECT().GetPendingPayment(gomock.Any(), uint(1)).Return(nil, nil)
+				gateway.EXPECT().Provider().Return(domain.PaymentProviderFake)
+				gateway.EXPECT().CreateIntent(gomock.Any(), gomock.Any()).Return(&domain.PaymentIntent{Reference: "fake_1"}, nil)
+				paymentRepo.EXPECT().CreatePayment(gomock.Any(), *pending).Return(pending, nil)
+			},
+		},
+		{
+			name:    "pending payment is returned again",
+			buyerId: 1,
+			want:    pending,
+			mock: func() {
+				orderRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(order(domain.OrderStatusNew), nil)
+				paymentRepo.EXPECT().GetPendingPayment(gomock.Any(), uint(1)).Return(pending, nil)
+			},
+		},
+		{
+			name:    "order already paid",
+			buyerId: 1,
+			wantErr: domain.ErrInvalidOrderStatus,
+			mock: func() {
+				orderRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(order(domain.OrderStatusPaid), nil)
+			},
+		},
+		{
+			name:    "order of another buyer",
+			buyerId: 2,
+			wantErr: domain.ErrForbidden,
+			mock: func() {
+				orderRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(order(domain.OrderStatusNew), nil)
+			},
+		},
+		{
+			name:    "order not found",
+			buyerId: 1,
+			wantErr: domain.ErrNotFound,
+			mock: func() {
+				orderRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(nil, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			pu := NewPaymentUsecase(orderRepo, paymentRepo, gateway)
+			got, err := pu.PayOrder(context.TODO(), tt.buyerId, 1)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_paymentUsecase_HandleWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	paymentRepo := mocks.NewMockPaymentRepository(ctrl)
+	gateway := mocks.NewMockPaymentGateway(ctrl)
+
+	payment := func(status string) *domain.Payment {
+		return &domain.Payment{
+			Model:     yugabyte.Model{ID: 3},
+			OrderID:   1,
+			Provider:  domain.PaymentProviderFake,
+			Reference: "fake_1",
+			Amount:    money.New(1000, "IDR"),
+			Status:    status,
+		}
+	}
+	order := func(status string) *domain.Order {
+		return &domain.Order{
+			Model:        yugabyte.Model{ID: 1},
+			SellerID:     2,
+			Status:       status,
+			OrderDateStr: "2022-01-01",
+		}
+	}
+	notification := func(status string) {
+		gateway.EXPECT().ParseWebhook([]byte("payload"), "signature").Return(&domain.PaymentNotification{Reference: "fake_1", Status: status}, nil)
+		gateway.EXPECT().Provider().Return(domain.PaymentProviderFake)
+	}
+
+	tests := []struct {
+		name    string
+		wantErr error
+		mock    func()
+	}{
+		{
+			name: "payment succeeded",
+			mock: func() {
+				notification(domain.PaymentStatusSucceeded)
+				paymentRepo.EXPECT().GetPaymentByReference(gomock.Any(), domain.PaymentProviderFake, "fake_1").Return(payment(domain.PaymentStatusPending), nil)
+				paymentRepo.EXPECT().SettlePayment(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, p domain.Payment) (*domain.Order, error) {
+						assert.Equal(t, domain.PaymentStatusSucceeded, p.Status)
+						assert.NotNil(t, p.SettledAt)
+						return order(domain.OrderStatusPaid), nil
+					})
+				paymentRepo.EXPECT().PublishPaymentEvent(gomock.Any(), domain.PayloadEventPayment{
+					OrderID:   1,
+					SellerID:  2,
+					Status:    domain.PaymentStatusSucceeded,
+					Amount:    money.New(1000, "IDR"),
+					OrderDate: "2022-01-01",
+				}).Return(errors.New("publishing failures are only logged"))
+			},
+		},
+		{
+			name: "payment failed",
+			mock: func() {
+				notification(domain.PaymentStatusFailed)
+				paymentRepo.EXPECT().GetPaymentByReference(gomock.Any(), domain.PaymentProviderFake, "fake_1").Return(payment(domain.PaymentStatusPending), nil)
+				paymentRepo.EXPECT().SettlePayment(gomock.Any(), gomock.Any()).Return(order(domain.OrderStatusNew), nil)
+				paymentRepo.EXPECT().PublishPaymentEvent(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, evt domain.PayloadEventPayment) error {
+						assert.Equal(t, domain.PaymentStatusFailed, evt.Status)
+						return nil
+					})
+			},
+		},
+		{
+			name: "notification delivered twice",
+			mock: func() {
+				notification(domain.PaymentStatusSucceeded)
+				paymentRepo.EXPECT().GetPaymentByReference(gomock.Any(), domain.PaymentProviderFake, "fake_1").Return(payment(domain.PaymentStatusSucceeded), nil)
+			},
+		},
+		{
+			name: "payment settled concurrently",
+			mock: func() {
+				notification(domain.PaymentStatusSucceeded)
+				paymentRepo.EXPECT().GetPaymentByReference(gomock.Any(), domain.PaymentProviderFake, "fake_1").Return(payment(domain.PaymentStatusPending), nil)
+				paymentRepo.EXPECT().SettlePayment(gomock.Any(), gomock.Any()).Return(nil, domain.ErrPaymentSettled)
+			},
+		},
+		{
+			name:    "unknown payment",
+			wantErr: domain.ErrNotFound,
+			mock: func() {
+				notification(domain.PaymentStatusSucceeded)
+				paymentRepo.EXPECT().GetPaymentByReference(gomock.Any(), domain.PaymentProviderFake, "fake_1").Return(nil, nil)
+			},
+		},
+		{
+			name:    "invalid signature",
+			wantErr: domain.ErrInvalidSignature,
+			mock: func() {
+				gateway.EXPECT().ParseWebhook([]byte("payload"), "signature").Return(nil, domain.ErrInvalidSignature)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			pu := NewPaymentUsecase(nil, paymentRepo, gateway)
+			err := pu.HandleWebhook(context.TODO(), []byte("payload"), "signature")
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
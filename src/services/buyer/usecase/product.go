@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/validation"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/repository"
+)
+
+type ProductUsecase interface {
+	CreateProduct(ctx context.Context, req domain.Product) (*domain.Product, error)
+	UpdateProduct(ctx context.Context, req domain.Product) (*domain.Product, error)
+	DeleteProduct(ctx context.Context, sellerId uint, id uint) error
+	Categories(ctx context.Context) ([]domain.Category, error)
+	CreateCategory(ctx context.Context, name string) (*domain.Category, error)
+}
+
+type productUsecase struct {
+	productRepo repository.ProductRepository
+	orderRepo   repository.OrderRepository
+}
+
+func NewProductUsecase(productRepo repository.ProductRepository, orderRepo repository.OrderRepository) ProductUsecase {
+	return &productUsecase{
+		productRepo: productRepo,
+		orderRepo:   orderRepo,
+	}
+}
+
+// CreateProduct adds a product to the catalog of req.SellerID
+func (pu *productUsecase) CreateProduct(ctx context.Context, req domain.Product) (*domain.Product, error) {
+	if err := pu.validateProduct(ctx, req); err != nil {
+		return nil, err
+	}
+
+	res, err := pu.productRepo.CreateProduct(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return pu.orderRepo.GetProductByID(ctx, res.ID)
+}
+
+// UpdateProduct replaces the attributes and images of a product owned by req.SellerID
+func (pu *productUsecase) UpdateProduct(ctx context.Context, req domain.Product) (*domain.Product, error) {
+	product, err := pu.orderRepo.GetProductByID(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if product == nil {
+		return nil, domain.ErrNotFound
+	}
+
+	if product.SellerID != req.SellerID {
+		return nil, domain.ErrForbidden
+	}
+
+	if err := pu.validateProduct(ctx, req); err != nil {
+		return nil, err
+	}
+
+	_, err = pu.productRepo.UpdateProduct(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return pu.orderRepo.GetProductByID(ctx, req.ID)
+}
+
+// DeleteProduct soft deletes a product owned by sellerId
+func (pu *productUsecase) DeleteProduct(ctx context.Context, sellerId uint, id uint) error {
+	product, err := pu.orderRepo.GetProductByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if product == nil {
+		return domain.ErrNotFound
+	}
+
+	if product.SellerID != sellerId {
+		return domain.ErrForbidden
+	}
+
+	return pu.productRepo.DeleteProduct(ctx, id)
+}
+
+// Categories returns every product category
+func (pu *productUsecase) Categories(ctx context.Context) ([]domain.Category, error) {
+	return pu.productRepo.GetCategories(ctx)
+}
+
+// CreateCategory adds a product category, category names are unique
+func (pu *productUsecase) CreateCategory(ctx context.Context, name string) (*domain.Category, error) {
+	category, err := pu.productRepo.GetCategoryByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if category != nil {
+		return nil, validation.NewError("name", "category already exists")
+	}
+
+	return pu.productRepo.CreateCategory(ctx, domain.Category{
+		Name: name,
+	})
+}
+
+// validateProduct, checks the references of a product, the sku has to be unique within the seller's catalog
+func (pu *productUsecase) validateProduct(ctx context.Context, req domain.Product) error {
+	var verr *validation.Error
+	add := func(field, message string) {
+		if verr == nil {
+			verr = validation.NewError(field, message)
+		} else {
+			verr.Add(field, message)
+		}
+	}
+
+	sameSKU, err := pu.productRepo.GetProductBySKU(ctx, req.SellerID, req.SKU)
+	if err != nil {
+		return err
+	}
+
+	if sameSKU != nil && sameSKU.ID != req.ID {
+		add("sku", "already used by another product")
+	}
+
+	if req.CategoryID != nil {
+		category, err := pu.productRepo.GetCategoryByID(ctx, *req.CategoryID)
+		if err != nil {
+			return err
+		}
+
+		if category == nil {
+			add("category_id", "category does not exist")
+		}
+	}
+
+	if verr != nil {
+		return verr
+	}
+	return nil
+}
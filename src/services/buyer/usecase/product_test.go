@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/validation"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/repository/mocks"
+)
+
+func Test_productUsecase_CreateProduct(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	categoryId := uint(9)
+	req := domain.Product{
+		SellerID:    1,
+		SKU:         "SKU-1",
+		ProductName: "Product 1",
+		Price:       money.New(100, "IDR"),
+		CategoryID:  &categoryId,
+	}
+
+	tests := []struct {
+		name       string
+		mock       func(productRepo *mocks.MockProductRepository, orderRepo *mocks.MockOrderRepository)
+		want       *domain.Product
+		wantFields []validation.FieldError
+		wantErr    bool
+	}{
+		{
+			name: "duplicate sku and unknown category",
+			mock: func(productRepo *mocks.MockProductRepository, orderRepo *mocks.MockOrderRepository) {
+				productRepo.EXPECT().GetProductBySKU(gomock.Any(), uint(1), "SKU-1").Return(&domain.Product{
+					Model: yugabyte.Model{ID: 2},
+				}, nil)
+				productRepo.EXPECT().GetCategoryByID(gomock.Any(), categoryId).Return(nil, nil)
+			},
+			wantFields: []validation.FieldError{
+				{Field: "sku", Message: "already used by another product"},
+				{Field: "category_id", Message: "category does not exist"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "success",
+			mock: func(productRepo *mocks.MockProductRepository, orderRepo *mocks.MockOrderRepository) {
+				productRepo.EXPECT().GetProductBySKU(gomock.Any(), uint(1), "SKU-1").Return(nil, nil)
+				productRepo.EXPECT().GetCategoryByID(gomock.Any(), categoryId).Return(&domain.Category{
+					Model: yugabyte.Model{ID: categoryId},
+				}, nil)
+				productRepo.EXPECT().CreateProduct(gomock.Any(), req).Return(&domain.Product{
+					Model: yugabyte.Model{ID: 3},
+				}, nil)
+				orderRepo.EXPECT().GetProductByID(gomock.Any(), uint(3)).Return(&domain.Product{
+					Model:    yugabyte.Model{ID: 3},
+					SellerID: 1,
+					SKU:      "SKU-1",
+				}, nil)
+			},
+			want: &domain.Product{
+				Model:    yugabyte.Model{ID: 3},
+				SellerID: 1,
+				SKU:      "SKU-1",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			productRepo := mocks.NewMockProductRepository(ctrl)
+			orderRepo := mocks.NewMockOrderRepository(ctrl)
+			tt.mock(productRepo, orderRepo)
+
+			sut := NewProductUsecase(productRepo, orderRepo)
+			res, err := sut.CreateProduct(context.TODO(), req)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tt.wantFields != nil {
+				verr, ok := validation.AsError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.wantFields, verr.Fields)
+			}
+			assert.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func Test_productUsecase_DeleteProduct(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name    string
+		mock    func(productRepo *mocks.MockProductRepository, orderRepo *mocks.MockOrderRepository)
+		wantErr error
+	}{
+		{
+			name: "not found",
+			mock: func(productRepo *mocks.MockProductRepository, orderRepo *mocks.MockOrderRepository) {
+				orderRepo.EXPECT().GetProductByID(gomock.Any(), uint(1)).Return(nil, nil)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name: "owned by another seller",
+			mock: func(productRepo *mocks.MockProductRepository, orderRepo *mocks.MockOrderRepository) {
+				orderRepo.EXPECT().GetProductByID(gomock.Any(), uint(1)).Return(&domain.Product{
+					Model:    yugabyte.Model{ID: 1},
+					SellerID: 2,
+				}, nil)
+			},
+			wantErr: domain.ErrForbidden,
+		},
+		{
+			name: "delete error",
+			mock: func(productRepo *mocks.MockProductRepository, orderRepo *mocks.MockOrderRepository) {
+				orderRepo.EXPECT().GetProductByID(gomock.Any(), uint(1)).Return(&domain.Product{
+					Model:    yugabyte.Model{ID: 1},
+					SellerID: 1,
+				}, nil)
+				productRepo.EXPECT().DeleteProduct(gomock.Any(), uint(1)).Return(errors.New("mock error"))
+			},
+			wantErr: errors.New("mock error"),
+		},
+		{
+			name: "success",
+			mock: func(productRepo *mocks.MockProductRepository, orderRepo *mocks.MockOrderRepository) {
+				orderRepo.EXPECT().GetProductByID(gomock.Any(), uint(1)).Return(&domain.Product{
+					Model:    yugabyte.Model{ID: 1},
+					SellerID: 1,
+				}, nil)
+				productRepo.EXPECT().DeleteProduct(gomock.Any(), uint(1)).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			productRepo := mocks.NewMockProductRepository(ctrl)
+			orderRepo := mocks.NewMockOrderRepository(ctrl)
+			tt.mock(productRepo, orderRepo)
+
+			sut := NewProductUsecase(productRepo, orderRepo)
+			err := sut.DeleteProduct(context.TODO(), 1, 1)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"log"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/validation"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/repository"
+)
+
+// ReviewUsecase, interface for review usecase
+type ReviewUsecase interface {
+	CreateReview(ctx context.Context, review domain.Review) (*domain.Review, error)
+	ProductReviews(ctx context.Context, productId uint) ([]domain.Review, error)
+}
+
+// reviewUsecase, concrete implementation of review usecase
+type reviewUsecase struct {
+	orderRepo  repository.OrderRepository
+	reviewRepo repository.ReviewRepository
+}
+
+// NewReviewUsecase, constructor function for review usecase
+func NewReviewUsecase(orderRepo repository.OrderRepository, reviewRepo repository.ReviewRepository) ReviewUsecase {
+	return &reviewUsecase{
+		orderRepo:  orderRepo,
+		reviewRepo: reviewRepo,
+	}
+}
+
+// CreateReview, reviews a product of a completed order of review.BuyerID, publishes the review event
+func (ru *reviewUsecase) CreateReview(ctx context.Context, review domain.Review) (*domain.Review, error) {
+	order, err := ru.orderRepo.GetOrderByID(ctx, review.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if order == nil {
+		return nil, domain.ErrNotFound
+	}
+
+	if order.BuyerID != review.BuyerID {
+		return nil, domain.ErrForbidden
+	}
+
+	if order.Status != domain.OrderStatusCompleted {
+		return nil, domain.ErrInvalidOrderStatus
+	}
+
+	if !hasProduct(order, review.ProductID) {
+		return nil, validation.NewError("product_id", "product is not part of the order")
+	}
+
+	review.SellerID = order.SellerID
+
+	res, err := ru.reviewRepo.CreateReview(ctx, review)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ru.reviewRepo.PublishReviewEvent(ctx, domain.PayloadEventReview{
+		ReviewID:  int64(res.ID),
+		OrderID:   int64(res.OrderID),
+		ProductID: int64(res.ProductID),
+		SellerID:  int64(res.SellerID),
+		Rating:    int64(res.Rating),
+		Date:      res.CreatedAt.Format(domain.OrderDateFormat),
+	})
+	if err != nil {
+		log.Println("error publishing review event", err)
+	}
+
+	return res, nil
+}
+
+// ProductReviews, returns the reviews of a product, the newest first
+func (ru *reviewUsecase) ProductReviews(ctx context.Context, productId uint) ([]domain.Review, error) {
+	return ru.reviewRepo.GetProductReviews(ctx, productId)
+}
+
+// hasProduct, whether the product was ordered in the order
+func hasProduct(order *domain.Order, productId uint) bool {
+	for _, detail := range order.OrderDetails {
+		if detail.ProductID == productId {
+			return true
+		}
+	}
+	return false
+}
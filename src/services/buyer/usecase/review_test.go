@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/validation"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/repository/mocks"
+)
+
+func Test_reviewUsecase_CreateReview(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	orderRepo := mocks.NewMockOrderRepository(ctrl)
+	reviewRepo := mocks.NewMockReviewRepository(ctrl)
+
+	createdAt := time.Date(2022, 1, 2, 10, 0, 0, 0, time.UTC)
+	completedOrder := func(status string) *domain.Order {
+		return &domain.Order{
+			Model:        yugabyte.Model{ID: 1},
+			BuyerID:      1,
+			SellerID:     3,
+			Status:       status,
+			OrderDetails: []domain.OrderDetail{{ProductID: 7}},
+		}
+	}
+	review := domain.Review{OrderID: 1, ProductID: 7, BuyerID: 1, Rating: 4, Comment: "good"}
+
+	tests := []struct {
+		name    string
+		req     domain.Review
+		wantErr error
+		mock    func()
+	}{
+		{
+			name: "success",
+			req:  review,
+			mock: func() {
+				orderRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(completedOrder(domain.OrderStatusCompleted), nil)
+				reviewRepo.EXPECT().CreateReview(gomock.Any(), domain.Review{OrderID: 1, ProductID: 7, BuyerID: 1, SellerID: 3, Rating: 4, Comment: "good"}).
+					Return(&domain.Review{Model: yugabyte.Model{ID: 9, CreatedAt: createdAt}, OrderID: 1, ProductID: 7, BuyerID: 1, SellerID: 3, Rating: 4}, nil)
+				reviewRepo.EXPECT().PublishReviewEvent(gomock.Any(), domain.PayloadEventReview{
+					ReviewID:  9,
+					OrderID:   1,
+					ProductID: 7,
+					SellerID:  3,
+					Rating:    4,
+					Date:      "2022-01-02",
+				}).Return(nil)
+			},
+		},
+		{
+			name: "publishing fails",
+			req:  review,
+			mock: func() {
+				orderRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(completedOrder(domain.OrderStatusCompleted), nil)
+				reviewRepo.EXPECT().CreateReview(gomock.Any(), gomock.Any()).Return(&domain.Review{Model: yugabyte.Model{ID: 9}}, nil)
+				reviewRepo.EXPECT().PublishReviewEvent(gomock.Any(), gomock.Any()).Return(errors.New("mock error"))
+			},
+		},
+		{
+			name:    "order not found",
+			req:     review,
+			wantErr: domain.ErrNotFound,
+			mock: func() {
+				orderRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(nil, nil)
+			},
+		},
+		{
+			name:    "order of another buyer",
+			req:     domain.Review{OrderID: 1, ProductID: 7, BuyerID: 2, Rating: 4},
+			wantErr: domain.ErrForbidden,
+			mock: func() {
+				orderRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(completedOrder(domain.OrderStatusCompleted), nil)
+			},
+		},
+		{
+			name:    "order not completed",
+			req:     review,
+			wantErr: domain.ErrInvalidOrderStatus,
+			mock: func() {
+				orderRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(completedOrder(domain.OrderStatusDelivered), nil)
+			},
+		},
+		{
+			name:    "product not ordered",
+			req:     domain.Review{OrderID: 1, ProductID: 8, BuyerID: 1, Rating: 4},
+			wantErr: validation.NewError("product_id", "product is not part of the order"),
+			mock: func() {
+				orderRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(completedOrder(domain.OrderStatusCompleted), nil)
+			},
+		},
+		{
+			name:    "already reviewed",
+			req:     review,
+			wantErr: domain.ErrAlreadyReviewed,
+			mock: func() {
+				orderRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(completedOrder(domain.OrderStatusCompleted), nil)
+				reviewRepo.EXPECT().CreateReview(gomock.Any(), gomock.Any()).Return(nil, domain.ErrAlreadyReviewed)
+			},
+		},
+		{
+			name:    "error getting order",
+			req:     review,
+			wantErr: errors.New("mock error"),
+			mock: func() {
+				orderRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(nil, errors.New("mock error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			ru := NewReviewUsecase(orderRepo, reviewRepo)
+			res, err := ru.CreateReview(context.TODO(), tt.req)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, res)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, res)
+		})
+	}
+}
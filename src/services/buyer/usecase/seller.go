@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/repository"
+)
+
+type SellerUsecase interface {
+	IsSellerAuthenticated(ctx context.Context, sellerId uint) (bool, error)
+	Login(ctx context.Context, name string) (*domain.Seller, error)
+}
+
+type sellerUsecase struct {
+	sellerRepo repository.SellerRepository
+}
+
+func NewSellerUsecase(sellerRepo repository.SellerRepository) SellerUsecase {
+	return &sellerUsecase{
+		sellerRepo: sellerRepo,
+	}
+}
+
+func (su *sellerUsecase) IsSellerAuthenticated(ctx context.Context, sellerId uint) (bool, error) {
+	res, err := su.sellerRepo.Get(ctx, sellerId)
+	if err != nil {
+		return false, err
+	}
+
+	if res == nil {
+		return false, nil
+	}
+	return true, nil
+}
+
+func (su *sellerUsecase) Login(ctx context.Context, name string) (*domain.Seller, error) {
+	res, err := su.sellerRepo.GetByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if res == nil {
+		// seller does not exist yet, create
+		res, err = su.sellerRepo.Create(ctx, domain.Seller{
+			Name: name,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
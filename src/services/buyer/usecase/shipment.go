@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/repository"
+)
+
+// ShipmentUsecase, interface for shipment usecase
+type ShipmentUsecase interface {
+	ShipOrder(ctx context.Context, sellerId uint, orderId uint, courier string, trackingNumber string) (*domain.Order, error)
+	DeliverOrder(ctx context.Context, sellerId uint, orderId uint) (*domain.Order, error)
+}
+
+// shipmentUsecase, concrete implementation of shipment usecase
+type shipmentUsecase struct {
+	orderRepo    repository.OrderRepository
+	shipmentRepo repository.ShipmentRepository
+	shippingCfg  domain.ShippingConfig
+}
+
+// NewShipmentUsecase, constructor function for shipment usecase
+func NewShipmentUsecase(orderRepo repository.OrderRepository, shipmentRepo repository.ShipmentRepository, shippingCfg domain.ShippingConfig) ShipmentUsecase {
+	return &shipmentUsecase{
+		orderRepo:    orderRepo,
+		shipmentRepo: shipmentRepo,
+		shippingCfg:  shippingCfg,
+	}
+}
+
+// ShipOrder, hands a paid order of the seller over to a courier
+func (su *shipmentUsecase) ShipOrder(ctx context.Context, sellerId uint, orderId uint, courier string, trackingNumber string) (*domain.Order, error) {
+	order, err := su.sellerOrder(ctx, sellerId, orderId, domain.OrderStatusShipped)
+	if err != nil {
+		return nil, err
+	}
+
+	shipment, err := su.shipmentRepo.ShipOrder(ctx, *order, domain.Shipment{
+		OrderID:        order.ID,
+		Courier:        courier,
+		TrackingNumber: trackingNumber,
+		ShippedAt:      time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	order.Status = domain.OrderStatusShipped
+	order.Shipment = shipment
+
+	leadTime := shipment.ShippedAt.Sub(order.CreatedAt)
+	su.publish(ctx, domain.PayloadEventShipment{
+		OrderID:         int64(order.ID),
+		SellerID:        int64(order.SellerID),
+		Status:          domain.ShipmentStatusShipped,
+		LeadTimeSeconds: int64(leadTime.Seconds()),
+		OnTime:          su.shippingCfg.ShippingSLA <= 0 || leadTime <= su.shippingCfg.ShippingSLA,
+		Date:            shipment.ShippedAt.Format(domain.OrderDateFormat),
+	})
+
+	return order, nil
+}
+
+// DeliverOrder, records the delivery of a shipped order of the seller
+func (su *shipmentUsecase) DeliverOrder(ctx context.Context, sellerId uint, orderId uint) (*domain.Order, error) {
+	order, err := su.sellerOrder(ctx, sellerId, orderId, domain.OrderStatusDelivered)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveredAt := time.Now()
+	shipment, err := su.shipmentRepo.DeliverOrder(ctx, *order, deliveredAt)
+	if err != nil {
+		return nil, err
+	}
+
+	order.Status = domain.OrderStatusDelivered
+	order.Shipment = shipment
+
+	su.publish(ctx, domain.PayloadEventShipment{
+		OrderID:         int64(order.ID),
+		SellerID:        int64(order.SellerID),
+		Status:          domain.ShipmentStatusDelivered,
+		LeadTimeSeconds: int64(deliveredAt.Sub(order.CreatedAt).Seconds()),
+		Date:            deliveredAt.Format(domain.OrderDateFormat),
+	})
+
+	return order, nil
+}
+
+// sellerOrder, gets an order of the seller that can be moved to status
+func (su *shipmentUsecase) sellerOrder(ctx context.Context, sellerId uint, orderId uint, status string) (*domain.Order, error) {
+	order, err := su.orderRepo.GetOrderByID(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if order == nil {
+		return nil, domain.ErrNotFound
+	}
+
+	if order.SellerID != sellerId {
+		return nil, domain.ErrForbidden
+	}
+
+	if !domain.CanChangeOrderStatus(order.Status, status) {
+		return nil, domain.ErrInvalidOrderStatus
+	}
+	return order, nil
+}
+
+// publish, publishes a shipment event, the shipment is already saved so a failure is only logged
+func (su *shipmentUsecase) publish(ctx context.Context, evt domain.PayloadEventShipment) {
+	if err := su.shipmentRepo.PublishShipmentEvent(ctx, evt); err != nil {
+		log.Println("error publishing shipment event", err)
+	}
+}
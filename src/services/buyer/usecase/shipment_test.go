@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/repository/mocks"
+)
+
+func Test_shipmentUsecase_ShipOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	orderRepo := mocks.NewMockOrderRepository(ctrl)
+	shipmentRepo := mocks.NewMockShipmentRepository(ctrl)
+
+	order := func(status string, placed time.Duration) *domain.Order {
+		return &domain.Order{
+			Model:    yugabyte.Model{ID: 1, CreatedAt: time.Now().Add(-placed)},
+			BuyerID:  1,
+			SellerID: 2,
+			Status:   status,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		sellerId   uint
+		wantOnTime bool
+		wantErr    error
+		mock       func()
+	}{
+		{
+			name:       "shipped within the sla",
+			sellerId:   2,
+			wantOnTime: true,
+			mock: func() {
+				orderRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(order(domain.OrderStatusPaid, time.Hour), nil)
+			},
+		},
+		{
+			name:     "shipped late",
+			sellerId: 2,
+			mock: func() {
+				orderRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(order(domain.OrderStatusPaid, 72*time.Hour), nil)
+			},
+		},
+		{
+			name:     "order of another seller",
+			sellerId: 3,
+			wantErr:  domain.ErrForbidden,
+			mock: func() {
+				orderRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(order(domain.OrderStatusPaid, time.Hour), nil)
+			},
+		},
+		{
+			name:     "cancelled order",
+			sellerId: 2,
+			wantErr:  domain.ErrInvalidOrderStatus,
+			mock: func() {
+				orderRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(order(domain.OrderStatusCancelled, time.Hour), nil)
+			},
+		},
+		{
+			name:     "order not found",
+			sellerId: 2,
+			wantErr:  domain.ErrNotFound,
+			mock: func() {
+				orderRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(nil, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			if tt.wantErr == nil {
+				shipmentRepo.EXPECT().ShipOrder(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, order domain.Order, shipment domain.Shipment) (*domain.Shipment, error) {
+						assert.Equal(t, "JNE", shipment.Courier)
+						assert.Equal(t, "JNE123", shipment.TrackingNumber)
+						return &shipment, nil
+					})
+				shipmentRepo.EXPECT().PublishShipmentEvent(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, evt domain.PayloadEventShipment) error {
+						assert.Equal(t, domain.ShipmentStatusShipped, evt.Status)
+						assert.Equal(t, int64(2), evt.SellerID)
+						assert.Equal(t, tt.wantOnTime, evt.OnTime)
+						return errors.New("publishing failures are only logged")
+					})
+			}
+
+			su := NewShipmentUsecase(orderRepo, shipmentRepo, domain.ShippingConfig{ShippingSLA: 48 * time.Hour})
+			res, err := su.ShipOrder(context.TODO(), tt.sellerId, 1, "JNE", "JNE123")
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, domain.OrderStatusShipped, res.Status)
+			assert.Equal(t, "JNE123", res.Shipment.TrackingNumber)
+		})
+	}
+}
+
+func Test_shipmentUsecase_DeliverOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	orderRepo := mocks.NewMockOrderRepository(ctrl)
+	shipmentRepo := mocks.NewMockShipmentRepository(ctrl)
+
+	tests := []struct {
+		name    string
+		status  string
+		wantErr error
+		mock    func()
+	}{
+		{
+			name:   "shipped order",
+			status: domain.OrderStatusShipped,
+			mock: func() {
+				shipmentRepo.EXPECT().DeliverOrder(gomock.Any(), gomock.Any(), gomock.Any()).Return(&domain.Shipment{OrderID: 1}, nil)
+				shipmentRepo.EXPECT().PublishShipmentEvent(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, evt domain.PayloadEventShipment) error {
+						assert.Equal(t, domain.ShipmentStatusDelivered, evt.Status)
+						return nil
+					})
+			},
+		},
+		{
+			name:    "order not shipped yet",
+			status:  domain.OrderStatusNew,
+			wantErr: domain.ErrInvalidOrderStatus,
+			mock:    func() {},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orderRepo.EXPECT().GetOrderByID(gomock.Any(), uint(1)).Return(&domain.Order{
+				Model:    yugabyte.Model{ID: 1, CreatedAt: time.Now().Add(-time.Hour)},
+				SellerID: 2,
+				Status:   tt.status,
+			}, nil)
+			tt.mock()
+
+			su := NewShipmentUsecase(orderRepo, shipmentRepo, domain.ShippingConfig{})
+			res, err := su.DeliverOrder(context.TODO(), 2, 1)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, domain.OrderStatusDelivered, res.Status)
+		})
+	}
+}
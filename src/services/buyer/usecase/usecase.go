@@ -3,6 +3,15 @@ package usecase
 import "go.uber.org/fx"
 
 var Module = fx.Options(
-	fx.Provide(NewBuyerUsecase), 
-	fx.Provide(NewOrderUsecase), 
+	fx.Provide(NewBuyerUsecase),
+	fx.Provide(NewOrderUsecase),
+	fx.Provide(NewCartUsecase),
+	fx.Provide(NewProductUsecase),
+	fx.Provide(NewSellerUsecase),
+	fx.Provide(NewInvoiceUsecase),
+	fx.Provide(NewShipmentUsecase),
+	fx.Provide(NewOrderExpiryUsecase),
+	fx.Provide(NewPaymentUsecase),
+	fx.Provide(NewVoucherUsecase),
+	fx.Provide(NewReviewUsecase),
 )
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/validation"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/repository"
+)
+
+// VoucherUsecase, interface for voucher usecase
+type VoucherUsecase interface {
+	CreateVoucher(ctx context.Context, req domain.Voucher) (*domain.Voucher, error)
+	Vouchers(ctx context.Context, sellerId uint) ([]domain.Voucher, error)
+}
+
+// voucherUsecase, concrete implementation of voucher usecase
+type voucherUsecase struct {
+	voucherRepo repository.VoucherRepository
+}
+
+// NewVoucherUsecase, constructor function for voucher usecase
+func NewVoucherUsecase(voucherRepo repository.VoucherRepository) VoucherUsecase {
+	return &voucherUsecase{
+		voucherRepo: voucherRepo,
+	}
+}
+
+// CreateVoucher, adds a voucher of req.SellerID, voucher codes are unique across sellers
+func (vu *voucherUsecase) CreateVoucher(ctx context.Context, req domain.Voucher) (*domain.Voucher, error) {
+	req.Code = domain.NormalizeVoucherCode(req.Code)
+	req.UsedCount = 0
+
+	var verr *validation.Error
+
+	switch req.Type {
+	case domain.VoucherTypePercentage:
+		if req.Percentage < 1 || req.Percentage > 100 {
+			verr = addFieldError(verr, "percentage", "must be between 1 and 100 for a percentage voucher")
+		}
+	case domain.VoucherTypeFixed:
+		if req.Amount.Amount <= 0 {
+			verr = addFieldError(verr, "amount", "must be greater than 0 for a fixed voucher")
+		}
+	}
+
+	if req.StartsAt != nil && req.EndsAt != nil && !req.EndsAt.After(*req.StartsAt) {
+		verr = addFieldError(verr, "ends_at", "must be after starts_at")
+	}
+
+	sameCode, err := vu.voucherRepo.GetVoucherByCode(ctx, req.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	if sameCode != nil {
+		verr = addFieldError(verr, "code", "already used by another voucher")
+	}
+
+	if verr != nil {
+		return nil, verr
+	}
+
+	return vu.voucherRepo.CreateVoucher(ctx, req)
+}
+
+// Vouchers, returns the vouchers of a seller along with how often they were used
+func (vu *voucherUsecase) Vouchers(ctx context.Context, sellerId uint) ([]domain.Voucher, error) {
+	return vu.voucherRepo.GetVouchers(ctx, sellerId)
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/validation"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/repository/mocks"
+)
+
+func Test_voucherUsecase_CreateVoucher(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	voucherRepo := mocks.NewMockVoucherRepository(ctrl)
+
+	startsAt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	endsAt := startsAt.Add(-time.Hour)
+
+	tests := []struct {
+		name    string
+		req     domain.Voucher
+		wantErr error
+		mock    func()
+	}{
+		{
+			name: "success",
+			req:  domain.Voucher{SellerID: 1, Code: "promo10", Type: domain.VoucherTypePercentage, Percentage: 10, UsedCount: 7},
+			mock: func() {
+				voucherRepo.EXPECT().GetVoucherByCode(gomock.Any(), "PROMO10").Return(nil, nil)
+				voucherRepo.EXPECT().CreateVoucher(gomock.Any(), domain.Voucher{SellerID: 1, Code: "PROMO10", Type: domain.VoucherTypePercentage, Percentage: 10}).
+					Return(&domain.Voucher{Model: yugabyte.Model{ID: 1}}, nil)
+			},
+		},
+		{
+			name: "invalid voucher",
+			req: domain.Voucher{
+				SellerID: 1,
+				Code:     "PROMO",
+				Type:     domain.VoucherTypeFixed,
+				Amount:   money.New(0, "IDR"),
+				StartsAt: &startsAt,
+				EndsAt:   &endsAt,
+			},
+			wantErr: func() error {
+				verr := validation.NewError("amount", "must be greater than 0 for a fixed voucher")
+				verr.Add("ends_at", "must be after starts_at")
+				verr.Add("code", "already used by another voucher")
+				return verr
+			}(),
+			mock: func() {
+				voucherRepo.EXPECT().GetVoucherByCode(gomock.Any(), "PROMO").Return(&domain.Voucher{Model: yugabyte.Model{ID: 2}}, nil)
+			},
+		},
+		{
+			name:    "error getting voucher",
+			req:     domain.Voucher{SellerID: 1, Code: "PROMO", Type: domain.VoucherTypePercentage, Percentage: 10},
+			wantErr: errors.New("mock error"),
+			mock: func() {
+				voucherRepo.EXPECT().GetVoucherByCode(gomock.Any(), "PROMO").Return(nil, errors.New("mock error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			vu := NewVoucherUsecase(voucherRepo)
+			res, err := vu.CreateVoucher(context.TODO(), tt.req)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, res)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, res)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
 	mhttp "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/http/domain"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/messagequeue"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/statistic/domain"
 	"go.uber.org/fx"
 )
 
@@ -15,6 +16,13 @@ var Module = fx.Provide(
 	NewRabbitMQCfg,
 	NewPublisherCfg,
 	NewSubscriberCfg,
+	fx.Annotate(NewStockSubscriberCfg, fx.ResultTags(`name:"stockSubscriber"`)),
+	fx.Annotate(NewShipmentSubscriberCfg, fx.ResultTags(`name:"shipmentSubscriber"`)),
+	fx.Annotate(NewPaymentSubscriberCfg, fx.ResultTags(`name:"paymentSubscriber"`)),
+	fx.Annotate(NewReviewSubscriberCfg, fx.ResultTags(`name:"reviewSubscriber"`)),
+	fx.Annotate(NewFunnelSubscriberCfg, fx.ResultTags(`name:"funnelSubscriber"`)),
+	NewCurrencyCfg,
+	NewAdminCfg,
 )
 
 type Config struct {
@@ -22,7 +30,14 @@ type Config struct {
 	Database           yugabyte.YugabyteDBConfig
 	RabbitMQ           messagequeue.RabbitMQConfig
 	OrderSubscriber    messagequeue.SubscriberConfig
+	StockSubscriber    messagequeue.SubscriberConfig
+	ShipmentSubscriber messagequeue.SubscriberConfig
+	PaymentSubscriber  messagequeue.SubscriberConfig
+	ReviewSubscriber   messagequeue.SubscriberConfig
+	FunnelSubscriber   messagequeue.SubscriberConfig
 	StatisticPublisher messagequeue.PublisherConfig
+	Currency           domain.CurrencyConfig
+	Admin              domain.AdminConfig
 }
 
 func NewHTTPServerCfg(cfg *Config) mhttp.HTTPServerConfig {
@@ -44,3 +59,31 @@ func NewPublisherCfg(cfg *Config) messagequeue.PublisherConfig {
 func NewSubscriberCfg(cfg *Config) messagequeue.SubscriberConfig {
 	return cfg.OrderSubscriber
 }
+
+func NewStockSubscriberCfg(cfg *Config) messagequeue.SubscriberConfig {
+	return cfg.StockSubscriber
+}
+
+func NewShipmentSubscriberCfg(cfg *Config) messagequeue.SubscriberConfig {
+	return cfg.ShipmentSubscriber
+}
+
+func NewPaymentSubscriberCfg(cfg *Config) messagequeue.SubscriberConfig {
+	return cfg.PaymentSubscriber
+}
+
+func NewReviewSubscriberCfg(cfg *Config) messagequeue.SubscriberConfig {
+	return cfg.ReviewSubscriber
+}
+
+func NewFunnelSubscriberCfg(cfg *Config) messagequeue.SubscriberConfig {
+	return cfg.FunnelSubscriber
+}
+
+func NewCurrencyCfg(cfg *Config) domain.CurrencyConfig {
+	return cfg.Currency
+}
+
+func NewAdminCfg(cfg *Config) domain.AdminConfig {
+	return cfg.Admin
+}
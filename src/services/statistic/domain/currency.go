@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+)
+
+// ErrExchangeRateNotFound, returned when no exchange rate is stored between two currencies
+var ErrExchangeRateNotFound = errors.New("exchange rate not found")
+
+// CurrencyConfig, config of the currencies revenue is reported in
+type CurrencyConfig struct {
+	// ReportingCurrency, currency of the marketplace statistics and of sellers without a reporting currency
+	ReportingCurrency string
+	// ExchangeRatesFile, json file of exchange rates loaded on startup, skipped when empty
+	ExchangeRatesFile string
+}
+
+// AdminConfig, config of the admin endpoints
+type AdminConfig struct {
+	// Token, expected in the X-Admin-Token header, admin endpoints are disabled when empty
+	Token string
+}
+
+// ExchangeRate, how many units of QuoteCurrency one unit of BaseCurrency is worth
+type ExchangeRate struct {
+	yugabyte.Model
+	BaseCurrency  string  `json:"base_currency" gorm:"size:3;uniqueIndex:idx_exchange_rates_pair"`
+	QuoteCurrency string  `json:"quote_currency" gorm:"size:3;uniqueIndex:idx_exchange_rates_pair"`
+	Rate          float64 `json:"rate"`
+}
+
+// SellerCurrency, the currency a seller's statistics are reported in
+type SellerCurrency struct {
+	yugabyte.Model
+	SellerID          int64  `json:"seller_id" gorm:"uniqueIndex"`
+	ReportingCurrency string `json:"reporting_currency" gorm:"size:3"`
+}
+
+// StatisticsRevenue, revenue and refunds of a statistics in one of the currencies orders were paid in, before conversion.
+// Ordered is the amount of the orders placed and not cancelled, Paid the part of it buyers paid for and Discount what
+// vouchers took off the revenue
+type StatisticsRevenue struct {
+	yugabyte.Model
+	StatisticsID uint        `json:"-" gorm:"index"`
+	Revenue      money.Money `json:"revenue" gorm:"embedded;embeddedPrefix:revenue_"`
+	Refunded     money.Money `json:"refunded" gorm:"embedded;embeddedPrefix:refunded_"`
+	Ordered      money.Money `json:"ordered" gorm:"embedded;embeddedPrefix:ordered_"`
+	Paid         money.Money `json:"paid" gorm:"embedded;embeddedPrefix:paid_"`
+	Discount     money.Money `json:"discount" gorm:"embedded;embeddedPrefix:discount_"`
+}
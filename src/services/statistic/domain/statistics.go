@@ -2,35 +2,218 @@ package domain
 
 import (
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/db/yugabyte"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
 	"gorm.io/datatypes"
 )
 
 const StatisticDateFormat = "2006-01-02"
 
+// MarketplaceSellerID, seller id of the statistics aggregated over every seller of the marketplace
+const MarketplaceSellerID int64 = 0
+
 type PayloadEventOrder struct {
-	OrderID          int64   `json:"order_id"`
-	OrderDate        string  `json:"order_date"`
-	OrderStatus      int64   `json:"order_status"`
-	TotalRevenue     float64 `json:"total_revenue"`
-	TotalProductSold int64   `json:"total_product_sold"`
+	OrderID          int64       `json:"order_id"`
+	SellerID         int64       `json:"seller_id"`
+	BuyerID          int64       `json:"buyer_id"`
+	OrderDate        string      `json:"order_date"`
+	OrderStatus      int64       `json:"order_status"`
+	TotalRevenue     money.Money `json:"total_revenue"`
+	TotalProductSold int64       `json:"total_product_sold"`
+	RefundedAmount   money.Money `json:"refunded_amount"`
+	RefundedQuantity int64       `json:"refunded_quantity"`
+	FirstRefund      bool        `json:"first_refund"`
+	// Discount and VoucherCode, what the voucher of the order took off TotalRevenue
+	Discount    money.Money `json:"discount"`
+	VoucherCode string      `json:"voucher_code,omitempty"`
+	// CategoryID, category most of the products of a new order belong to, zero when none has a category
+	CategoryID int64 `json:"category_id,omitempty"`
+}
+
+// PayloadEventLowStock, event published by the buyer service when an order makes a product's stock run low
+type PayloadEventLowStock struct {
+	ProductID int64  `json:"product_id"`
+	SellerID  int64  `json:"seller_id"`
+	Stock     int64  `json:"stock"`
+	Date      string `json:"date"`
+}
+
+const (
+	ShipmentStatusShipped   = "shipped"
+	ShipmentStatusDelivered = "delivered"
+)
+
+// PayloadEventShipment, event published by the buyer service when an order is shipped or delivered, LeadTimeSeconds
+// is the time from placing the order to the event and OnTime whether a shipped order made the shipping SLA
+type PayloadEventShipment struct {
+	OrderID         int64  `json:"order_id"`
+	SellerID        int64  `json:"seller_id"`
+	Status          string `json:"status"`
+	LeadTimeSeconds int64  `json:"lead_time_seconds"`
+	OnTime          bool   `json:"on_time"`
+	Date            string `json:"date"`
+}
+
+const PaymentStatusSucceeded = "succeeded"
+
+// PayloadEventPayment, event published by the buyer service when the payment of an order succeeded or failed
+type PayloadEventPayment struct {
+	OrderID   int64       `json:"order_id"`
+	SellerID  int64       `json:"seller_id"`
+	Status    string      `json:"status"`
+	Amount    money.Money `json:"amount"`
+	OrderDate string      `json:"order_date"`
+}
+
+// PayloadEventReview, a buyer rated a product of a completed order of the seller from 1 to 5 stars at Date
+type PayloadEventReview struct {
+	ReviewID  int64  `json:"review_id"`
+	OrderID   int64  `json:"order_id"`
+	ProductID int64  `json:"product_id"`
+	SellerID  int64  `json:"seller_id"`
+	Rating    int64  `json:"rating"`
+	Date      string `json:"date"`
+}
+
+const (
+	FunnelStepView     = "view"
+	FunnelStepCart     = "cart"
+	FunnelStepCheckout = "checkout"
+)
+
+// PayloadEventFunnel, a buyer viewed a product of the seller, added it to the cart or started checking out
+type PayloadEventFunnel struct {
+	Step      string `json:"step"`
+	SellerID  int64  `json:"seller_id"`
+	ProductID int64  `json:"product_id"`
+	Date      string `json:"date"`
+}
+
+// RatingDistribution, number of reviews per rating
+type RatingDistribution struct {
+	One   int64 `json:"one"`
+	Two   int64 `json:"two"`
+	Three int64 `json:"three"`
+	Four  int64 `json:"four"`
+	Five  int64 `json:"five"`
+}
+
+// Add, counts a review with rating, ratings outside 1 to 5 are ignored
+func (rd RatingDistribution) Add(rating int64) RatingDistribution {
+	switch rating {
+	case 1:
+		rd.One += 1
+	case 2:
+		rd.Two += 1
+	case 3:
+		rd.Three += 1
+	case 4:
+		rd.Four += 1
+	case 5:
+		rd.Five += 1
+	}
+	return rd
 }
 
 type PayloadEventStatistic struct {
-	TotalRevenue   float64 `json:"total_revenue"`
-	CompletedOrder int64   `json:"completed_order"`
-	CanceledOrder  int64   `json:"canceled_order"`
-	TotalOrder     int64   `json:"total_order"`
-	Date           string  `json:"date"`
+	SellerID       int64       `json:"seller_id"`
+	TotalRevenue   money.Money `json:"total_revenue"`
+	CompletedOrder int64       `json:"completed_order"`
+	CanceledOrder  int64       `json:"canceled_order"`
+	TotalOrder     int64       `json:"total_order"`
+	RefundedAmount money.Money `json:"refunded_amount"`
+	RefundedOrders int64       `json:"refunded_orders"`
+	// ShippedOrders, OnTimeShipments and ShippingLeadTime, see Statistics
+	ShippedOrders    int64 `json:"shipped_orders"`
+	OnTimeShipments  int64 `json:"on_time_shipments"`
+	ShippingLeadTime int64 `json:"shipping_lead_time"`
+	// DiscountAmount, see Statistics
+	DiscountAmount money.Money `json:"discount_amount"`
+	// ReviewCount, RatingSum and Ratings, see Statistics
+	ReviewCount int64              `json:"review_count"`
+	RatingSum   int64              `json:"rating_sum"`
+	Ratings     RatingDistribution `json:"ratings"`
+	// ProductViews, CartAdds and CheckoutsStarted, see Statistics
+	ProductViews     int64 `json:"product_views"`
+	CartAdds         int64 `json:"cart_adds"`
+	CheckoutsStarted int64 `json:"checkouts_started"`
+	// UniqueBuyers, NewBuyers and ReturningBuyers, see Statistics
+	UniqueBuyers    int64  `json:"unique_buyers"`
+	NewBuyers       int64  `json:"new_buyers"`
+	ReturningBuyers int64  `json:"returning_buyers"`
+	Date            string `json:"date"`
 }
 
+// Statistics, daily statistics of a seller, MarketplaceSellerID holds the marketplace wide statistics.
+// TotalRevenue and RefundedAmount are converted into the seller's reporting currency, OriginalRevenues keeps
+// them per currency orders were paid in. RefundedOrders counts the orders refunded at least once.
+// ShippedOrders and DeliveredOrders count the orders shipped and delivered that day, ShippingLeadTime sums the
+// seconds from placing to shipping those orders and OnTimeShipments counts the ones shipped within the SLA.
+// PaidRevenue is the amount of the day's orders buyers paid for and UnpaidRevenue the amount still awaiting payment.
+// DiscountAmount is what vouchers took off the completed orders, GrossRevenue their revenue before the discount
+// and Promotions the usage and revenue of every voucher used that day.
+// ReviewCount counts the reviews buyers wrote that day, RatingSum sums their ratings and Ratings counts them per rating.
+// ProductViews, CartAdds and CheckoutsStarted count how often buyers viewed a product, added one to the cart and
+// attempted to check out whether or not an order was placed, the steps of the funnel before TotalOrder.
+// UniqueBuyers counts the distinct buyers who placed an order that day, NewBuyers the ones ordering for the first
+// time and ReturningBuyers the ones who ordered on an earlier day already
 type Statistics struct {
 	yugabyte.Model
-	TotalRevenue     int64 `json:"total_revenue"`
-	TotalProductSold int64 `json:"total_product_sold"`
-	CompletedOrder   int64 `json:"completed_order"`
-	CancelledOrder   int64 `json:"cancelled_order"`
-	TotalOrder       int64 `json:"total_order"`
+	SellerID         int64       `json:"seller_id" gorm:"index:idx_statistics_seller_date"`
+	TotalRevenue     money.Money `json:"total_revenue" gorm:"embedded;embeddedPrefix:total_revenue_"`
+	TotalProductSold int64       `json:"total_product_sold"`
+	CompletedOrder   int64       `json:"completed_order"`
+	CancelledOrder   int64       `json:"cancelled_order"`
+	TotalOrder       int64       `json:"total_order"`
+	LowStockEvents   int64       `json:"low_stock_events"`
+	RefundedAmount   money.Money `json:"refunded_amount" gorm:"embedded;embeddedPrefix:refunded_amount_"`
+	RefundedOrders   int64       `json:"refunded_orders"`
+	ShippedOrders    int64       `json:"shipped_orders"`
+	OnTimeShipments  int64       `json:"on_time_shipments"`
+	ShippingLeadTime int64       `json:"shipping_lead_time"`
+	DeliveredOrders  int64       `json:"delivered_orders"`
+	PaidOrders       int64       `json:"paid_orders"`
+	PaidRevenue      money.Money `json:"paid_revenue" gorm:"embedded;embeddedPrefix:paid_revenue_"`
+	UnpaidRevenue    money.Money `json:"unpaid_revenue" gorm:"embedded;embeddedPrefix:unpaid_revenue_"`
+	DiscountAmount   money.Money `json:"discount_amount" gorm:"embedded;embeddedPrefix:discount_amount_"`
+	GrossRevenue     money.Money `json:"gross_revenue" gorm:"embedded;embeddedPrefix:gross_revenue_"`
+
+	ReviewCount int64              `json:"review_count"`
+	RatingSum   int64              `json:"rating_sum"`
+	Ratings     RatingDistribution `json:"ratings" gorm:"embedded;embeddedPrefix:rating_"`
+
+	ProductViews     int64 `json:"product_views"`
+	CartAdds         int64 `json:"cart_adds"`
+	CheckoutsStarted int64 `json:"checkouts_started"`
+
+	UniqueBuyers    int64 `json:"unique_buyers"`
+	NewBuyers       int64 `json:"new_buyers"`
+	ReturningBuyers int64 `json:"returning_buyers"`
+
+	OriginalRevenues []StatisticsRevenue   `json:"original_revenues,omitempty"`
+	Promotions       []StatisticsPromotion `json:"promotions,omitempty"`
 
 	DateStr string         `json:"date" gorm:"-"`
-	Date    datatypes.Date `json:"-"`
+	Date    datatypes.Date `json:"-" gorm:"index:idx_statistics_seller_date"`
+}
+
+// StatisticsPromotion, usage of a voucher in a statistics, per currency orders were paid in. UsedOrders counts the
+// orders placed with the voucher and not cancelled, CompletedOrders the completed ones, Discount and Revenue what
+// the voucher took off the completed orders and what they earned after the discount
+type StatisticsPromotion struct {
+	yugabyte.Model
+	StatisticsID    uint        `json:"-" gorm:"index"`
+	VoucherCode     string      `json:"voucher_code"`
+	UsedOrders      int64       `json:"used_orders"`
+	CompletedOrders int64       `json:"completed_orders"`
+	Discount        money.Money `json:"discount" gorm:"embedded;embeddedPrefix:discount_"`
+	Revenue         money.Money `json:"revenue" gorm:"embedded;embeddedPrefix:revenue_"`
+}
+
+// StatisticsBuyer, a buyer who placed an order of the seller on Date, the set of buyers of a day holds each buyer
+// once hence its size is the number of unique buyers
+type StatisticsBuyer struct {
+	yugabyte.Model
+	SellerID int64          `gorm:"uniqueIndex:idx_statistics_buyers_seller_buyer_date"`
+	BuyerID  int64          `gorm:"uniqueIndex:idx_statistics_buyers_seller_buyer_date"`
+	Date     datatypes.Date `gorm:"uniqueIndex:idx_statistics_buyers_seller_buyer_date"`
 }
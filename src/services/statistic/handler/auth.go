@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminTokenHeader, header carrying the admin token
+const AdminTokenHeader = "X-Admin-Token"
+
+// AdminAuth, add the middleware function guarding admin only endpoints
+func (h *handler) AdminAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if h.AdminCfg.Token == "" {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"errors": "admin endpoints are disabled"})
+			return
+		}
+
+		token := c.GetHeader(AdminTokenHeader)
+		if subtle.ConstantTimeCompare([]byte(token), []byte(h.AdminCfg.Token)) != 1 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"errors": "request does not have valid authentication"})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	httpgin "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/http/gin"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/statistic/domain"
+)
+
+// ExchangeRates, lists the stored exchange rates
+func (h *handler) ExchangeRates(ctx *gin.Context) {
+	res, err := h.CurrencyUsecase.ExchangeRates(ctx)
+	if err != nil {
+		ctx.Error(err)
+		ctx.JSON(http.StatusInternalServerError, ExchangeRatesResponse{
+			Error: "something happened on our end, please try at a later time",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ExchangeRatesResponse{
+		Data: &res,
+	})
+}
+
+// SaveExchangeRates, stores exchange rates, replacing the rate of currency pairs already stored
+func (h *handler) SaveExchangeRates(ctx *gin.Context) {
+	request := new(SaveExchangeRatesRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		httpgin.AbortWithBindError[[]domain.ExchangeRate](ctx, err)
+		return
+	}
+
+	rates := make([]domain.ExchangeRate, 0, len(request.Rates))
+	for _, v := range request.Rates {
+		rates = append(rates, domain.ExchangeRate{
+			BaseCurrency:  v.BaseCurrency,
+			QuoteCurrency: v.QuoteCurrency,
+			Rate:          v.Rate,
+		})
+	}
+
+	res, err := h.CurrencyUsecase.SaveExchangeRates(ctx, rates)
+	if err != nil {
+		ctx.Error(err)
+		ctx.JSON(http.StatusInternalServerError, ExchangeRatesResponse{
+			Error: "something happened on our end, please try at a later time",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ExchangeRatesResponse{
+		Data: &res,
+	})
+}
+
+// SetSellerCurrency, sets the currency a seller's statistics are reported in
+func (h *handler) SetSellerCurrency(ctx *gin.Context) {
+	sellerId, err := strconv.ParseInt(ctx.Param("seller_id"), 10, 64)
+	if err != nil || sellerId <= 0 {
+		ctx.JSON(http.StatusBadRequest, SellerCurrencyResponse{
+			Error: "invalid seller_id, expect a positive number",
+		})
+		return
+	}
+
+	request := new(SellerCurrencyRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		httpgin.AbortWithBindError[domain.SellerCurrency](ctx, err)
+		return
+	}
+
+	res, err := h.CurrencyUsecase.SetReportingCurrency(ctx, sellerId, request.ReportingCurrency)
+	if err != nil {
+		ctx.Error(err)
+		ctx.JSON(http.StatusInternalServerError, SellerCurrencyResponse{
+			Error: "something happened on our end, please try at a later time",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, SellerCurrencyResponse{
+		Data: res,
+	})
+}
@@ -0,0 +1,171 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/validation"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/statistic/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/statistic/usecase"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/statistic/usecase/mocks"
+)
+
+func Test_handler_SetSellerCurrency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name     string
+		token    string
+		request  func() *http.Request
+		usecase  func() usecase.CurrencyUsecase
+		wantCode int
+		want     SellerCurrencyResponse
+	}{
+		{
+			name:     "success",
+			token:    "secret",
+			wantCode: http.StatusOK,
+			request: func() *http.Request {
+				req, _ := http.NewRequest(http.MethodPut, "/statistic/sellers/2/currency", strings.NewReader(`{"reporting_currency":"sgd"}`))
+				req.Header.Set("Content-Type", "application/json")
+				req.Header.Set(AdminTokenHeader, "secret")
+				return req
+			},
+			usecase: func() usecase.CurrencyUsecase {
+				m := mocks.NewMockCurrencyUsecase(ctrl)
+				m.EXPECT().SetReportingCurrency(gomock.Any(), int64(2), "sgd").Return(&domain.SellerCurrency{
+					SellerID:          2,
+					ReportingCurrency: "SGD",
+				}, nil)
+				return m
+			},
+			want: SellerCurrencyResponse{
+				Data: &domain.SellerCurrency{
+					SellerID:          2,
+					ReportingCurrency: "SGD",
+				},
+			},
+		},
+		{
+			name:     "invalid seller id",
+			token:    "secret",
+			wantCode: http.StatusBadRequest,
+			request: func() *http.Request {
+				req, _ := http.NewRequest(http.MethodPut, "/statistic/sellers/abc/currency", strings.NewReader(`{"reporting_currency":"SGD"}`))
+				req.Header.Set("Content-Type", "application/json")
+				req.Header.Set(AdminTokenHeader, "secret")
+				return req
+			},
+			usecase: func() usecase.CurrencyUsecase {
+				return mocks.NewMockCurrencyUsecase(ctrl)
+			},
+			want: SellerCurrencyResponse{
+				Error: "invalid seller_id, expect a positive number",
+			},
+		},
+		{
+			name:     "invalid currency",
+			token:    "secret",
+			wantCode: http.StatusUnprocessableEntity,
+			request: func() *http.Request {
+				req, _ := http.NewRequest(http.MethodPut, "/statistic/sellers/2/currency", strings.NewReader(`{"reporting_currency":"RUPIAH"}`))
+				req.Header.Set("Content-Type", "application/json")
+				req.Header.Set(AdminTokenHeader, "secret")
+				return req
+			},
+			usecase: func() usecase.CurrencyUsecase {
+				return mocks.NewMockCurrencyUsecase(ctrl)
+			},
+			want: SellerCurrencyResponse{
+				Error: "invalid request",
+				Errors: []validation.FieldError{
+					{Field: "reporting_currency", Message: "must be exactly 3 character(s) long"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+
+			sut := NewStatisticsHandler(Params{
+				CurrencyUsecase: tt.usecase(),
+				AdminCfg:        domain.AdminConfig{Token: tt.token},
+			})
+
+			router := ProvideGinEngine(sut)
+			router.ServeHTTP(recorder, tt.request())
+
+			var response SellerCurrencyResponse
+			json.Unmarshal(recorder.Body.Bytes(), &response)
+
+			assert.Equal(t, tt.want, response)
+
+			assert.Equal(t, tt.wantCode, recorder.Code)
+		})
+	}
+}
+
+func Test_handler_AdminAuth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name     string
+		token    string
+		header   string
+		wantCode int
+		want     map[string]string
+	}{
+		{
+			name:     "admin endpoints disabled",
+			wantCode: http.StatusForbidden,
+			want:     map[string]string{"errors": "admin endpoints are disabled"},
+		},
+		{
+			name:     "missing token",
+			token:    "secret",
+			wantCode: http.StatusUnauthorized,
+			want:     map[string]string{"errors": "request does not have valid authentication"},
+		},
+		{
+			name:     "invalid token",
+			token:    "secret",
+			header:   "wrong",
+			wantCode: http.StatusUnauthorized,
+			want:     map[string]string{"errors": "request does not have valid authentication"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+
+			sut := NewStatisticsHandler(Params{
+				CurrencyUsecase: mocks.NewMockCurrencyUsecase(ctrl),
+				AdminCfg:        domain.AdminConfig{Token: tt.token},
+			})
+
+			req, _ := http.NewRequest(http.MethodPut, "/statistic/exchange-rates", strings.NewReader(`{"rates":[]}`))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.header != "" {
+				req.Header.Set(AdminTokenHeader, tt.header)
+			}
+
+			router := ProvideGinEngine(sut)
+			router.ServeHTTP(recorder, req)
+
+			var response map[string]string
+			json.Unmarshal(recorder.Body.Bytes(), &response)
+
+			assert.Equal(t, tt.want, response)
+
+			assert.Equal(t, tt.wantCode, recorder.Code)
+		})
+	}
+}
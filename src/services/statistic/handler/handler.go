@@ -2,6 +2,7 @@ package handler
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/validation"
 	"go.uber.org/fx"
 )
 
@@ -9,13 +10,25 @@ var Module = fx.Options(
 	fx.Provide(NewStatisticsHandler),
 	fx.Provide(ProvideGinEngine),
 	fx.Invoke(SubscribeOrder),
+	fx.Invoke(SubscribeLowStock),
+	fx.Invoke(SubscribeShipment),
+	fx.Invoke(SubscribePayment),
+	fx.Invoke(SubscribeReview),
+	fx.Invoke(SubscribeFunnel),
 )
 
 func ProvideGinEngine(handler Handler) *gin.Engine {
+	validation.UseJSONFieldNames()
+
 	router := gin.Default()
 
 	//router get statistics
 	router.GET("/statistic", handler.Statistics)
 
+	//router exchange rates and reporting currencies
+	router.GET("/statistic/exchange-rates", handler.ExchangeRates)
+	router.PUT("/statistic/exchange-rates", handler.AdminAuth(), handler.SaveExchangeRates)
+	router.PUT("/statistic/sellers/:seller_id/currency", handler.AdminAuth(), handler.SetSellerCurrency)
+
 	return router
 }
@@ -9,3 +9,20 @@ type GetStatisticRequest struct {
 	Date string `json:"date"`
 }
 type GetStatisticResponse = httpdomain.ResponseModel[domain.Statistics]
+
+type ExchangeRateRequest struct {
+	BaseCurrency  string  `json:"base_currency" binding:"required,len=3,alpha"`
+	QuoteCurrency string  `json:"quote_currency" binding:"required,len=3,alpha"`
+	Rate          float64 `json:"rate" binding:"gt=0"`
+}
+
+type SaveExchangeRatesRequest struct {
+	Rates []ExchangeRateRequest `json:"rates" binding:"required,min=1,dive"`
+}
+
+type SellerCurrencyRequest struct {
+	ReportingCurrency string `json:"reporting_currency" binding:"required,len=3,alpha"`
+}
+
+type ExchangeRatesResponse = httpdomain.ResponseModel[[]domain.ExchangeRate]
+type SellerCurrencyResponse = httpdomain.ResponseModel[domain.SellerCurrency]
@@ -3,6 +3,7 @@ package handler
 import (
 	"log"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -14,20 +15,30 @@ import (
 
 type Handler interface {
 	Statistics(*gin.Context)
+	ExchangeRates(*gin.Context)
+	SaveExchangeRates(*gin.Context)
+	SetSellerCurrency(*gin.Context)
+	AdminAuth() gin.HandlerFunc
 }
 
 type handler struct {
 	StatisticsUsecase usecase.StatisticsUsecase
+	CurrencyUsecase   usecase.CurrencyUsecase
+	AdminCfg          domain.AdminConfig
 }
 
 type Params struct {
 	fx.In
 	StatisticsUsecase usecase.StatisticsUsecase
+	CurrencyUsecase   usecase.CurrencyUsecase
+	AdminCfg          domain.AdminConfig
 }
 
 func NewStatisticsHandler(param Params) Handler {
 	return &handler{
 		StatisticsUsecase: param.StatisticsUsecase,
+		CurrencyUsecase:   param.CurrencyUsecase,
+		AdminCfg:          param.AdminCfg,
 	}
 }
 
@@ -47,7 +58,19 @@ func (h *handler) Statistics(ctx *gin.Context) {
 		}
 	}
 
-	res, err := h.StatisticsUsecase.GetStatistics(ctx, date)
+	// statistics of the whole marketplace unless a seller is requested
+	sellerId := domain.MarketplaceSellerID
+	if strSellerId := ctx.Query("seller_id"); strSellerId != "" {
+		sellerId, err = strconv.ParseInt(strSellerId, 10, 64)
+		if err != nil || sellerId < 0 {
+			ctx.JSON(http.StatusBadRequest, GetStatisticResponse{
+				Error: "invalid seller_id, expect a positive number",
+			})
+			return
+		}
+	}
+
+	res, err := h.StatisticsUsecase.GetStatistics(ctx, sellerId, date)
 	if err != nil {
 		ctx.Error(err)
 		ctx.JSON(http.StatusInternalServerError, GetStatisticResponse{
@@ -79,3 +102,98 @@ func SubscribeOrder(
 		}
 	}()
 }
+
+func SubscribeLowStock(
+	repoCoreRabbitMQ messagequeue.Subscriber[domain.PayloadEventLowStock],
+	usecase usecase.StatisticsUsecase) {
+	go func() {
+		err := repoCoreRabbitMQ.Subscribe(messagequeue.SubscribeConfig{
+			AutoAck: true,
+		}, func(msg domain.PayloadEventLowStock) {
+			if msg.Date == "" {
+				log.Println("invalid message: date can't be empty")
+				return
+			}
+			usecase.HandleLowStockEvent(msg)
+		})
+		if err != nil {
+			log.Println(err)
+		}
+	}()
+}
+
+func SubscribeShipment(
+	repoCoreRabbitMQ messagequeue.Subscriber[domain.PayloadEventShipment],
+	usecase usecase.StatisticsUsecase) {
+	go func() {
+		err := repoCoreRabbitMQ.Subscribe(messagequeue.SubscribeConfig{
+			AutoAck: true,
+		}, func(msg domain.PayloadEventShipment) {
+			if msg.Date == "" {
+				log.Println("invalid message: date can't be empty")
+				return
+			}
+			usecase.HandleShipmentEvent(msg)
+		})
+		if err != nil {
+			log.Println(err)
+		}
+	}()
+}
+
+func SubscribePayment(
+	repoCoreRabbitMQ messagequeue.Subscriber[domain.PayloadEventPayment],
+	usecase usecase.StatisticsUsecase) {
+	go func() {
+		err := repoCoreRabbitMQ.Subscribe(messagequeue.SubscribeConfig{
+			AutoAck: true,
+		}, func(msg domain.PayloadEventPayment) {
+			if msg.OrderDate == "" {
+				log.Println("invalid message: order date can't be empty")
+				return
+			}
+			usecase.HandlePaymentEvent(msg)
+		})
+		if err != nil {
+			log.Println(err)
+		}
+	}()
+}
+
+func SubscribeReview(
+	repoCoreRabbitMQ messagequeue.Subscriber[domain.PayloadEventReview],
+	usecase usecase.StatisticsUsecase) {
+	go func() {
+		err := repoCoreRabbitMQ.Subscribe(messagequeue.SubscribeConfig{
+			AutoAck: true,
+		}, func(msg domain.PayloadEventReview) {
+			if msg.Date == "" {
+				log.Println("invalid message: review date can't be empty")
+				return
+			}
+			usecase.HandleReviewEvent(msg)
+		})
+		if err != nil {
+			log.Println(err)
+		}
+	}()
+}
+
+func SubscribeFunnel(
+	repoCoreRabbitMQ messagequeue.Subscriber[domain.PayloadEventFunnel],
+	usecase usecase.StatisticsUsecase) {
+	go func() {
+		err := repoCoreRabbitMQ.Subscribe(messagequeue.SubscribeConfig{
+			AutoAck: true,
+		}, func(msg domain.PayloadEventFunnel) {
+			if msg.Date == "" {
+				log.Println("invalid message: funnel date can't be empty")
+				return
+			}
+			usecase.HandleFunnelEvent(msg)
+		})
+		if err != nil {
+			log.Println(err)
+		}
+	}()
+}
@@ -42,7 +42,7 @@ func Test_handler_Statistics(t *testing.T) {
 			},
 			usecase: func() usecase.StatisticsUsecase {
 				m := mocks.NewMockStatisticsUsecase(ctrl)
-				m.EXPECT().GetStatistics(gomock.Any(), gomock.Any()).Return(&domain.Statistics{
+				m.EXPECT().GetStatistics(gomock.Any(), domain.MarketplaceSellerID, gomock.Any()).Return(&domain.Statistics{
 					Date: datatypes.Date(time.Date(2022, 01, 01, 0, 0, 0, 0, time.Local)),
 				}, nil)
 				return m
@@ -51,6 +51,52 @@ func Test_handler_Statistics(t *testing.T) {
 				Data: &domain.Statistics{},
 			},
 		},
+		{
+			name:     "success seller",
+			wantCode: http.StatusOK,
+			request: func() *http.Request {
+				req, _ := http.NewRequest(http.MethodGet, "/statistic", nil)
+				values := req.URL.Query()
+				values.Add("date", "2022-01-01")
+				values.Add("seller_id", "2")
+				req.URL.RawQuery = values.Encode()
+				req.Header.Set("Content-Type", "application/json")
+				return req
+			},
+			usecase: func() usecase.StatisticsUsecase {
+				m := mocks.NewMockStatisticsUsecase(ctrl)
+				m.EXPECT().GetStatistics(gomock.Any(), int64(2), gomock.Any()).Return(&domain.Statistics{
+					SellerID:       2,
+					LowStockEvents: 1,
+				}, nil)
+				return m
+			},
+			want: GetStatisticResponse{
+				Data: &domain.Statistics{
+					SellerID:       2,
+					LowStockEvents: 1,
+				},
+			},
+		},
+		{
+			name:     "invalid seller id",
+			wantCode: http.StatusBadRequest,
+			request: func() *http.Request {
+				req, _ := http.NewRequest(http.MethodGet, "/statistic", nil)
+				values := req.URL.Query()
+				values.Add("seller_id", "abc")
+				req.URL.RawQuery = values.Encode()
+				req.Header.Set("Content-Type", "application/json")
+				return req
+			},
+			usecase: func() usecase.StatisticsUsecase {
+				m := mocks.NewMockStatisticsUsecase(ctrl)
+				return m
+			},
+			want: GetStatisticResponse{
+				Error: "invalid seller_id, expect a positive number",
+			},
+		},
 		{
 			name:     "invalid date format",
 			wantCode: http.StatusBadRequest,
@@ -83,7 +129,7 @@ func Test_handler_Statistics(t *testing.T) {
 			},
 			usecase: func() usecase.StatisticsUsecase {
 				m := mocks.NewMockStatisticsUsecase(ctrl)
-				m.EXPECT().GetStatistics(gomock.Any(), gomock.Any()).Return(nil, errors.New("mock error"))
+				m.EXPECT().GetStatistics(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("mock error"))
 				return m
 			},
 			want: GetStatisticResponse{
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/statistic/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CurrencyRepository interface {
+	GetExchangeRate(ctx context.Context, base, quote string) (*domain.ExchangeRate, error)
+	GetExchangeRates(ctx context.Context) ([]domain.ExchangeRate, error)
+	SaveExchangeRates(ctx context.Context, rates []domain.ExchangeRate) error
+	GetSellerCurrency(ctx context.Context, sellerId int64) (*domain.SellerCurrency, error)
+	SaveSellerCurrency(ctx context.Context, currency domain.SellerCurrency) (*domain.SellerCurrency, error)
+}
+
+type currencyRepository struct {
+	db *gorm.DB
+}
+
+func NewCurrencyRepository(db *gorm.DB) CurrencyRepository {
+	return &currencyRepository{
+		db: db,
+	}
+}
+
+// GetExchangeRate, returns the exchange rate from base to quote currency, nil when it is not stored
+func (cr *currencyRepository) GetExchangeRate(ctx context.Context, base, quote string) (*domain.ExchangeRate, error) {
+	result := domain.ExchangeRate{}
+
+	query := cr.db.WithContext(ctx)
+	if err := query.Where("base_currency = ?", base).Where("quote_currency = ?", quote).First(&result).Error; err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	} else if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+
+	return &result, nil
+}
+
+// GetExchangeRates, returns every stored exchange rate
+func (cr *currencyRepository) GetExchangeRates(ctx context.Context) ([]domain.ExchangeRate, error) {
+	var result []domain.ExchangeRate
+
+	query := cr.db.WithContext(ctx)
+	if err := query.Order("base_currency, quote_currency").Find(&result).Error; err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// SaveExchangeRates, inserts the exchange rates, replacing the rate of currency pairs already stored
+func (cr *currencyRepository) SaveExchangeRates(ctx context.Context, rates []domain.ExchangeRate) error {
+	if len(rates) == 0 {
+		return nil
+	}
+
+	return cr.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "base_currency"}, {Name: "quote_currency"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rate", "updated_at"}),
+	}).Create(&rates).Error
+}
+
+// GetSellerCurrency, returns the reporting currency of a seller, nil when the seller has not chosen one
+func (cr *currencyRepository) GetSellerCurrency(ctx context.Context, sellerId int64) (*domain.SellerCurrency, error) {
+	result := domain.SellerCurrency{}
+
+	query := cr.db.WithContext(ctx)
+	if err := query.Where("seller_id = ?", sellerId).First(&result).Error; err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	} else if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+
+	return &result, nil
+}
+
+// SaveSellerCurrency, sets the reporting currency of a seller
+func (cr *currencyRepository) SaveSellerCurrency(ctx context.Context, currency domain.SellerCurrency) (*domain.SellerCurrency, error) {
+	err := cr.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "seller_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"reporting_currency", "updated_at"}),
+	}).Create(&currency).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &currency, nil
+}
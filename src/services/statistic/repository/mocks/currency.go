@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: currency.go
+
+// Package mock_repository is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/statistic/domain"
+)
+
+// MockCurrencyRepository is a mock of CurrencyRepository interface.
+type MockCurrencyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCurrencyRepositoryMockRecorder
+}
+
+// MockCurrencyRepositoryMockRecorder is the mock recorder for MockCurrencyRepository.
+type MockCurrencyRepositoryMockRecorder struct {
+	mock *MockCurrencyRepository
+}
+
+// NewMockCurrencyRepository creates a new mock instance.
+func NewMockCurrencyRepository(ctrl *gomock.Controller) *MockCurrencyRepository {
+	mock := &MockCurrencyRepository{ctrl: ctrl}
+	mock.recorder = &MockCurrencyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCurrencyRepository) EXPECT() *MockCurrencyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetExchangeRate mocks base method.
+func (m *MockCurrencyRepository) GetExchangeRate(ctx context.Context, base, quote string) (*domain.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExchangeRate", ctx, base, quote)
+	ret0, _ := ret[0].(*domain.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExchangeRate indicates an expected call of GetExchangeRate.
+func (mr *MockCurrencyRepositoryMockRecorder) GetExchangeRate(ctx, base, quote interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchangeRate", reflect.TypeOf((*MockCurrencyRepository)(nil).GetExchangeRate), ctx, base, quote)
+}
+
+// GetExchangeRates mocks base method.
+func (m *MockCurrencyRepository) GetExchangeRates(ctx context.Context) ([]domain.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExchangeRates", ctx)
+	ret0, _ := ret[0].([]domain.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExchangeRates indicates an expected call of GetExchangeRates.
+func (mr *MockCurrencyRepositoryMockRecorder) GetExchangeRates(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchangeRates", reflect.TypeOf((*MockCurrencyRepository)(nil).GetExchangeRates), ctx)
+}
+
+// GetSellerCurrency mocks base method.
+func (m *MockCurrencyRepository) GetSellerCurrency(ctx context.Context, sellerId int64) (*domain.SellerCurrency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSellerCurrency", ctx, sellerId)
+	ret0, _ := ret[0].(*domain.SellerCurrency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSellerCurrency indicates an expected call of GetSellerCurrency.
+func (mr *MockCurrencyRepositoryMockRecorder) GetSellerCurrency(ctx, sellerId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSellerCurrency", reflect.TypeOf((*MockCurrencyRepository)(nil).GetSellerCurrency), ctx, sellerId)
+}
+
+// SaveExchangeRates mocks base method.
+func (m *MockCurrencyRepository) SaveExchangeRates(ctx context.Context, rates []domain.ExchangeRate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveExchangeRates", ctx, rates)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveExchangeRates indicates an expected call of SaveExchangeRates.
+func (mr *MockCurrencyRepositoryMockRecorder) SaveExchangeRates(ctx, rates interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveExchangeRates", reflect.TypeOf((*MockCurrencyRepository)(nil).SaveExchangeRates), ctx, rates)
+}
+
+// SaveSellerCurrency mocks base method.
+func (m *MockCurrencyRepository) SaveSellerCurrency(ctx context.Context, currency domain.SellerCurrency) (*domain.SellerCurrency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveSellerCurrency", ctx, currency)
+	ret0, _ := ret[0].(*domain.SellerCurrency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveSellerCurrency indicates an expected call of SaveSellerCurrency.
+func (mr *MockCurrencyRepositoryMockRecorder) SaveSellerCurrency(ctx, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSellerCurrency", reflect.TypeOf((*MockCurrencyRepository)(nil).SaveSellerCurrency), ctx, currency)
+}
@@ -36,6 +36,22 @@ func (m *MockStatisticsRepository) EXPECT() *MockStatisticsRepositoryMockRecorde
 	return m.recorder
 }
 
+// AddBuyer mocks base method.
+func (m *MockStatisticsRepository) AddBuyer(ctx context.Context, sellerId, buyerId int64, date time.Time) (bool, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBuyer", ctx, sellerId, buyerId, date)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AddBuyer indicates an expected call of AddBuyer.
+func (mr *MockStatisticsRepositoryMockRecorder) AddBuyer(ctx, sellerId, buyerId, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBuyer", reflect.TypeOf((*MockStatisticsRepository)(nil).AddBuyer), ctx, sellerId, buyerId, date)
+}
+
 // Create mocks base method.
 func (m *MockStatisticsRepository) Create(ctx context.Context, stat domain.Statistics) (*domain.Statistics, error) {
 	m.ctrl.T.Helper()
@@ -52,18 +68,18 @@ func (mr *MockStatisticsRepositoryMockRecorder) Create(ctx, stat interface{}) *g
 }
 
 // GetByDate mocks base method.
-func (m *MockStatisticsRepository) GetByDate(ctx context.Context, date time.Time) (*domain.Statistics, error) {
+func (m *MockStatisticsRepository) GetByDate(ctx context.Context, sellerId int64, date time.Time) (*domain.Statistics, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByDate", ctx, date)
+	ret := m.ctrl.Call(m, "GetByDate", ctx, sellerId, date)
 	ret0, _ := ret[0].(*domain.Statistics)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByDate indicates an expected call of GetByDate.
-func (mr *MockStatisticsRepositoryMockRecorder) GetByDate(ctx, date interface{}) *gomock.Call {
+func (mr *MockStatisticsRepositoryMockRecorder) GetByDate(ctx, sellerId, date interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByDate", reflect.TypeOf((*MockStatisticsRepository)(nil).GetByDate), ctx, date)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByDate", reflect.TypeOf((*MockStatisticsRepository)(nil).GetByDate), ctx, sellerId, date)
 }
 
 // PublishEvent mocks base method.
@@ -93,4 +109,4 @@ func (m *MockStatisticsRepository) Update(ctx context.Context, stat domain.Stati
 func (mr *MockStatisticsRepositoryMockRecorder) Update(ctx, stat interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockStatisticsRepository)(nil).Update), ctx, stat)
-}
\ No newline at end of file
+}
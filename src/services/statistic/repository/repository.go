@@ -12,13 +12,19 @@ var Module = fx.Options(
 	fx.Provide(yugabyte.NewDatabase),
 	fx.Provide(messagequeue.NewRabbitMQ),
 	fx.Provide(messagequeue.NewRabbitMQSubscriber[domain.PayloadEventOrder]),
+	fx.Provide(fx.Annotate(messagequeue.NewRabbitMQSubscriber[domain.PayloadEventLowStock], fx.ParamTags(`name:"stockSubscriber"`))),
+	fx.Provide(fx.Annotate(messagequeue.NewRabbitMQSubscriber[domain.PayloadEventShipment], fx.ParamTags(`name:"shipmentSubscriber"`))),
+	fx.Provide(fx.Annotate(messagequeue.NewRabbitMQSubscriber[domain.PayloadEventPayment], fx.ParamTags(`name:"paymentSubscriber"`))),
+	fx.Provide(fx.Annotate(messagequeue.NewRabbitMQSubscriber[domain.PayloadEventReview], fx.ParamTags(`name:"reviewSubscriber"`))),
+	fx.Provide(fx.Annotate(messagequeue.NewRabbitMQSubscriber[domain.PayloadEventFunnel], fx.ParamTags(`name:"funnelSubscriber"`))),
 	fx.Provide(messagequeue.NewRabbitMQPublisher[domain.PayloadEventStatistic]),
 	fx.Provide(NewStatisticsRepository),
+	fx.Provide(NewCurrencyRepository),
 	fx.Invoke(AutoMigrateEntities),
 )
 
 func AutoMigrateEntities(db *gorm.DB) error {
-	if err := db.AutoMigrate(&domain.Statistics{}); err != nil {
+	if err := db.AutoMigrate(&domain.Statistics{}, &domain.StatisticsRevenue{}, &domain.StatisticsPromotion{}, &domain.StatisticsBuyer{}, &domain.ExchangeRate{}, &domain.SellerCurrency{}); err != nil {
 		return err
 	}
 	return nil
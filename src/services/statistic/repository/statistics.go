@@ -8,13 +8,15 @@ import (
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/statistic/domain"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type StatisticsRepository interface {
-	GetByDate(ctx context.Context, date time.Time) (*domain.Statistics, error)
+	GetByDate(ctx context.Context, sellerId int64, date time.Time) (*domain.Statistics, error)
 	Create(ctx context.Context, stat domain.Statistics) (*domain.Statistics, error)
 	Update(ctx context.Context, stat domain.Statistics) (*domain.Statistics, error)
 	PublishEvent(ctx context.Context, event domain.PayloadEventStatistic) error
+	AddBuyer(ctx context.Context, sellerId, buyerId int64, date time.Time) (first bool, returning bool, err error)
 }
 
 type statisticsRepository struct {
@@ -29,11 +31,11 @@ func NewStatisticsRepository(db *gorm.DB, repoCoreRabbitMQ messagequeue.Publishe
 	}
 }
 
-func (sr *statisticsRepository) GetByDate(ctx context.Context, date time.Time) (*domain.Statistics, error) {
+func (sr *statisticsRepository) GetByDate(ctx context.Context, sellerId int64, date time.Time) (*domain.Statistics, error) {
 	result := domain.Statistics{}
 
 	query := sr.db.WithContext(ctx)
-	if err := query.Where("Date = ?", datatypes.Date(date)).First(&result).Error; err != nil && err != gorm.ErrRecordNotFound {
+	if err := query.Where("seller_id = ?", sellerId).Where("Date = ?", datatypes.Date(date)).Preload("OriginalRevenues").Preload("Promotions").First(&result).Error; err != nil && err != gorm.ErrRecordNotFound {
 		return nil, err
 	} else if err == gorm.ErrRecordNotFound {
 		return nil, nil
@@ -51,7 +53,7 @@ func (sr *statisticsRepository) Create(ctx context.Context, req domain.Statistic
 }
 
 func (sr *statisticsRepository) Update(ctx context.Context, req domain.Statistics) (*domain.Statistics, error) {
-	res, err := sr.GetByDate(ctx, time.Time(req.Date))
+	res, err := sr.GetByDate(ctx, req.SellerID, time.Time(req.Date))
 
 	if err != nil {
 		return nil, err
@@ -62,9 +64,35 @@ func (sr *statisticsRepository) Update(ctx context.Context, req domain.Statistic
 	res.CompletedOrder = req.CompletedOrder
 	res.CancelledOrder = req.CancelledOrder
 	res.TotalOrder = req.TotalOrder
+	res.LowStockEvents = req.LowStockEvents
+	res.RefundedAmount = req.RefundedAmount
+	res.RefundedOrders = req.RefundedOrders
+	res.ShippedOrders = req.ShippedOrders
+	res.OnTimeShipments = req.OnTimeShipments
+	res.ShippingLeadTime = req.ShippingLeadTime
+	res.DeliveredOrders = req.DeliveredOrders
+	res.PaidOrders = req.PaidOrders
+	res.PaidRevenue = req.PaidRevenue
+	res.UnpaidRevenue = req.UnpaidRevenue
+	res.DiscountAmount = req.DiscountAmount
+	res.GrossRevenue = req.GrossRevenue
+	res.ReviewCount = req.ReviewCount
+	res.RatingSum = req.RatingSum
+	res.Ratings = req.Ratings
+	res.ProductViews = req.ProductViews
+	res.CartAdds = req.CartAdds
+	res.CheckoutsStarted = req.CheckoutsStarted
+	res.UniqueBuyers = req.UniqueBuyers
+	res.NewBuyers = req.NewBuyers
+	res.ReturningBuyers = req.ReturningBuyers
+	res.OriginalRevenues = req.OriginalRevenues
+	res.Promotions = req.Promotions
 	res.DateStr = req.DateStr
 	res.Date = req.Date
-	sr.db.Save(&res)
+	// the revenue per currency and the promotions change in place so they need a full upsert
+	if err := sr.db.WithContext(ctx).Session(&gorm.Session{FullSaveAssociations: true}).Save(&res).Error; err != nil {
+		return nil, err
+	}
 
 	res.DateStr = time.Time(res.Date).Format(domain.StatisticDateFormat)
 	return res, nil
@@ -78,3 +106,30 @@ func (sr *statisticsRepository) PublishEvent(ctx context.Context, event domain.P
 
 	return nil
 }
+
+// AddBuyer, adds a buyer to the buyers of the seller at date, first is set when the buyer was not among them yet
+// and returning when the buyer is also among the buyers of an earlier day
+func (sr *statisticsRepository) AddBuyer(ctx context.Context, sellerId, buyerId int64, date time.Time) (bool, bool, error) {
+	query := sr.db.WithContext(ctx)
+
+	// the unique index on seller, buyer and date keeps a buyer ordering twice a day from being counted twice
+	result := query.Clauses(clause.OnConflict{DoNothing: true}).Create(&domain.StatisticsBuyer{
+		SellerID: sellerId,
+		BuyerID:  buyerId,
+		Date:     datatypes.Date(date),
+	})
+	if result.Error != nil {
+		return false, false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return false, false, nil
+	}
+
+	var earlier domain.StatisticsBuyer
+	err := query.Where("seller_id = ? AND buyer_id = ? AND date < ?", sellerId, buyerId, datatypes.Date(date)).Take(&earlier).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return false, false, err
+	}
+
+	return true, err == nil, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/statistic/domain"
 	"gorm.io/datatypes"
 	"gorm.io/driver/postgres"
@@ -57,16 +58,17 @@ func Test_statisticsRepository_GetByDate(t *testing.T) {
 			name: "success",
 			date: date,
 			want: &domain.Statistics{
-				TotalRevenue: 10000,
+				SellerID:     1,
+				TotalRevenue: money.New(10000, "IDR"),
 				DateStr:      "2022-01-01",
 				Date:         datatypes.Date(date),
 			},
 			mock: func() {
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT * FROM "statistics" WHERE Date = $1 AND "statistics"."deleted_at" IS NULL ORDER BY "statistics"."id" LIMIT 1`)).
-					WithArgs(date).
-					WillReturnRows(sqlmock.NewRows([]string{"TotalRevenue", "Date"}).
-						AddRow(10000, date))
+					`SELECT * FROM "statistics" WHERE seller_id = $1 AND Date = $2 AND "statistics"."deleted_at" IS NULL ORDER BY "statistics"."id" LIMIT 1`)).
+					WithArgs(int64(1), date).
+					WillReturnRows(sqlmock.NewRows([]string{"SellerID", "total_revenue_amount", "total_revenue_currency", "Date"}).
+						AddRow(1, 10000, "IDR", date))
 			},
 		},
 		{
@@ -76,8 +78,8 @@ func Test_statisticsRepository_GetByDate(t *testing.T) {
 			wantErr: true,
 			mock: func() {
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT * FROM "statistics" WHERE Date = $1 AND "statistics"."deleted_at" IS NULL ORDER BY "statistics"."id" LIMIT 1`)).
-					WithArgs(date).WillReturnError(errors.New("mock error"))
+					`SELECT * FROM "statistics" WHERE seller_id = $1 AND Date = $2 AND "statistics"."deleted_at" IS NULL ORDER BY "statistics"."id" LIMIT 1`)).
+					WithArgs(int64(1), date).WillReturnError(errors.New("mock error"))
 			},
 		},
 	}
@@ -85,7 +87,7 @@ func Test_statisticsRepository_GetByDate(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mock()
 			sr := NewStatisticsRepository(gormdb, nil)
-			res, err := sr.GetByDate(context.TODO(), tt.date)
+			res, err := sr.GetByDate(context.TODO(), 1, tt.date)
 			if tt.wantErr {
 				require.Error(t, err)
 			} else {
@@ -110,7 +112,8 @@ func Test_statisticsRepository_Create(t *testing.T) {
 		{
 			name: "success",
 			statistic: domain.Statistics{
-				TotalRevenue:     10000,
+				SellerID:         1,
+				TotalRevenue:     money.New(10000, "IDR"),
 				TotalProductSold: 2,
 				CompletedOrder:   1,
 				CancelledOrder:   0,
@@ -118,7 +121,8 @@ func Test_statisticsRepository_Create(t *testing.T) {
 				Date:             date,
 			},
 			want: &domain.Statistics{
-				TotalRevenue:     10000,
+				SellerID:         1,
+				TotalRevenue:     money.New(10000, "IDR"),
 				TotalProductSold: 2,
 				CompletedOrder:   1,
 				CancelledOrder:   0,
@@ -128,8 +132,8 @@ func Test_statisticsRepository_Create(t *testing.T) {
 			mock: func() {
 				mock.ExpectBegin()
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`INSERT INTO "statistics" ("created_at","updated_at","deleted_at","total_revenue","total_product_sold","completed_order","cancelled_order","total_order","date") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`)).
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), int64(10000), int64(2), int64(1), int64(0), int64(1), date).
+					`INSERT INTO "statistics" ("created_at","updated_at","deleted_at","seller_id","total_revenue_amount","total_revenue_currency","total_product_sold","completed_order","cancelled_order","total_order","low_stock_events","refunded_amount_amount","refunded_amount_currency","refunded_orders","shipped_orders","on_time_shipments","shipping_lead_time","delivered_orders","paid_orders","paid_revenue_amount","paid_revenue_currency","unpaid_revenue_amount","unpaid_revenue_currency","discount_amount_amount","discount_amount_currency","gross_revenue_amount","gross_revenue_currency","review_count","rating_sum","rating_one","rating_two","rating_three","rating_four","rating_five","product_views","cart_adds","checkouts_started","unique_buyers","new_buyers","returning_buyers","date") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22,$23,$24,$25,$26,$27,$28,$29,$30,$31,$32,$33,$34,$35,$36,$37,$38,$39,$40,$41) RETURNING "id"`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), int64(1), int64(10000), "IDR", int64(2), int64(1), int64(0), int64(1), int64(0), int64(0), "", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), "", int64(0), "", int64(0), "", int64(0), "", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), date).
 					WillReturnRows(sqlmock.NewRows([]string{"TotalRevenue", "TotalProductSold", "CompletedOrder", "CancelledOrder", "TotalOrder", "Date"}).
 						AddRow(10000, 2, 1, 0, 1, date))
 				mock.ExpectCommit()
@@ -138,7 +142,8 @@ func Test_statisticsRepository_Create(t *testing.T) {
 		{
 			name: "error",
 			statistic: domain.Statistics{
-				TotalRevenue:     10000,
+				SellerID:         1,
+				TotalRevenue:     money.New(10000, "IDR"),
 				TotalProductSold: 2,
 				CompletedOrder:   1,
 				CancelledOrder:   0,
@@ -150,8 +155,8 @@ func Test_statisticsRepository_Create(t *testing.T) {
 			mock: func() {
 				mock.ExpectBegin()
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`INSERT INTO "statistics" ("created_at","updated_at","deleted_at","total_revenue","total_product_sold","completed_order","cancelled_order","total_order","date") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`)).
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), int64(10000), int64(2), int64(1), int64(0), int64(1), date).
+					`INSERT INTO "statistics" ("created_at","updated_at","deleted_at","seller_id","total_revenue_amount","total_revenue_currency","total_product_sold","completed_order","cancelled_order","total_order","low_stock_events","refunded_amount_amount","refunded_amount_currency","refunded_orders","shipped_orders","on_time_shipments","shipping_lead_time","delivered_orders","paid_orders","paid_revenue_amount","paid_revenue_currency","unpaid_revenue_amount","unpaid_revenue_currency","discount_amount_amount","discount_amount_currency","gross_revenue_amount","gross_revenue_currency","review_count","rating_sum","rating_one","rating_two","rating_three","rating_four","rating_five","product_views","cart_adds","checkouts_started","unique_buyers","new_buyers","returning_buyers","date") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22,$23,$24,$25,$26,$27,$28,$29,$30,$31,$32,$33,$34,$35,$36,$37,$38,$39,$40,$41) RETURNING "id"`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), int64(1), int64(10000), "IDR", int64(2), int64(1), int64(0), int64(1), int64(0), int64(0), "", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), "", int64(0), "", int64(0), "", int64(0), "", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), date).
 					WillReturnError(errors.New("mock error"))
 			},
 		},
@@ -170,6 +175,7 @@ func Test_statisticsRepository_Create(t *testing.T) {
 			if tt.want == nil {
 				assert.Nil(t, res)
 			} else {
+				assert.Equal(t, tt.want.SellerID, res.SellerID)
 				assert.Equal(t, tt.want.TotalRevenue, res.TotalRevenue)
 				assert.Equal(t, tt.want.TotalProductSold, res.TotalProductSold)
 				assert.Equal(t, tt.want.CompletedOrder, res.CompletedOrder)
@@ -195,7 +201,8 @@ func Test_statisticsRepository_Update(t *testing.T) {
 		{
 			name: "success",
 			statistic: domain.Statistics{
-				TotalRevenue:     10000,
+				SellerID:         1,
+				TotalRevenue:     money.New(10000, "IDR"),
 				TotalProductSold: 2,
 				CompletedOrder:   1,
 				CancelledOrder:   0,
@@ -205,18 +212,36 @@ func Test_statisticsRepository_Update(t *testing.T) {
 			wantErr: false,
 			mock: func() {
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT * FROM "statistics" WHERE Date = $1 AND "statistics"."deleted_at" IS NULL ORDER BY "statistics"."id" LIMIT 1`)).
-					WithArgs(date).WillReturnRows(sqlmock.NewRows([]string{"TotalRevenue", "Date"}).
-					AddRow(10000, date))
+					`SELECT * FROM "statistics" WHERE seller_id = $1 AND Date = $2 AND "statistics"."deleted_at" IS NULL ORDER BY "statistics"."id" LIMIT 1`)).
+					WithArgs(int64(1), date).WillReturnRows(sqlmock.NewRows([]string{"SellerID", "total_revenue_amount", "total_revenue_currency", "Date"}).
+					AddRow(1, 10000, "IDR", date))
 				mock.ExpectBegin()
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`INSERT INTO "statistics" ("created_at","updated_at","deleted_at","total_revenue","total_product_sold","completed_order","cancelled_order","total_order","date") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`)).
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), int64(10000), int64(2), int64(1), int64(0), int64(1), date).
+					`INSERT INTO "statistics" ("created_at","updated_at","deleted_at","seller_id","total_revenue_amount","total_revenue_currency","total_product_sold","completed_order","cancelled_order","total_order","low_stock_events","refunded_amount_amount","refunded_amount_currency","refunded_orders","shipped_orders","on_time_shipments","shipping_lead_time","delivered_orders","paid_orders","paid_revenue_amount","paid_revenue_currency","unpaid_revenue_amount","unpaid_revenue_currency","discount_amount_amount","discount_amount_currency","gross_revenue_amount","gross_revenue_currency","review_count","rating_sum","rating_one","rating_two","rating_three","rating_four","rating_five","product_views","cart_adds","checkouts_started","unique_buyers","new_buyers","returning_buyers","date") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22,$23,$24,$25,$26,$27,$28,$29,$30,$31,$32,$33,$34,$35,$36,$37,$38,$39,$40,$41) RETURNING "id"`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), int64(1), int64(10000), "IDR", int64(2), int64(1), int64(0), int64(1), int64(0), int64(0), "", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), "", int64(0), "", int64(0), "", int64(0), "", int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), date).
 					WillReturnRows(sqlmock.NewRows([]string{"TotalRevenue", "TotalProductSold", "CompletedOrder", "CancelledOrder", "TotalOrder", "Date"}).
 						AddRow(10000, 2, 1, 0, 1, date))
 				mock.ExpectCommit()
 			},
 		},
+		{
+			name: "error save",
+			statistic: domain.Statistics{
+				SellerID: 1,
+				Date:     date,
+			},
+			wantErr: true,
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					`SELECT * FROM "statistics" WHERE seller_id = $1 AND Date = $2 AND "statistics"."deleted_at" IS NULL ORDER BY "statistics"."id" LIMIT 1`)).
+					WithArgs(int64(1), date).WillReturnRows(sqlmock.NewRows([]string{"SellerID", "Date"}).
+					AddRow(1, date))
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "statistics"`)).
+					WillReturnError(errors.New("mock error"))
+				mock.ExpectRollback()
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -232,3 +257,84 @@ func Test_statisticsRepository_Update(t *testing.T) {
 		})
 	}
 }
+
+func Test_statisticsRepository_AddBuyer(t *testing.T) {
+	date := time.Date(2022, 1, 2, 0, 0, 0, 0, time.Local)
+	insert := `INSERT INTO "statistics_buyers" ("created_at","updated_at","deleted_at","seller_id","buyer_id","date") VALUES ($1,$2,$3,$4,$5,$6) ON CONFLICT DO NOTHING RETURNING "id"`
+	earlier := `SELECT * FROM "statistics_buyers" WHERE (seller_id = $1 AND buyer_id = $2 AND date < $3) AND "statistics_buyers"."deleted_at" IS NULL LIMIT 1`
+
+	tests := []struct {
+		name          string
+		mock          func()
+		wantFirst     bool
+		wantReturning bool
+		wantErr       bool
+	}{
+		{
+			name: "new buyer",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(insert)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), int64(2), int64(7), datatypes.Date(date)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectCommit()
+				mock.ExpectQuery(regexp.QuoteMeta(earlier)).
+					WithArgs(int64(2), int64(7), datatypes.Date(date)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			},
+			wantFirst: true,
+		},
+		{
+			name: "returning buyer",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(insert)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), int64(2), int64(7), datatypes.Date(date)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectCommit()
+				mock.ExpectQuery(regexp.QuoteMeta(earlier)).
+					WithArgs(int64(2), int64(7), datatypes.Date(date)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+			},
+			wantFirst:     true,
+			wantReturning: true,
+		},
+		{
+			name: "buyer already ordered today",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(insert)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), int64(2), int64(7), datatypes.Date(date)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "error",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(insert)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), int64(2), int64(7), datatypes.Date(date)).
+					WillReturnError(errors.New("mock error"))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			sr := NewStatisticsRepository(gormdb, nil)
+			first, returning, err := sr.AddBuyer(context.TODO(), 2, 7, date)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantFirst, first)
+			assert.Equal(t, tt.wantReturning, returning)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
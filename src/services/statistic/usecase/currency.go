@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/statistic/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/statistic/repository"
+)
+
+type CurrencyUsecase interface {
+	ExchangeRates(ctx context.Context) ([]domain.ExchangeRate, error)
+	SaveExchangeRates(ctx context.Context, rates []domain.ExchangeRate) ([]domain.ExchangeRate, error)
+	ReportingCurrency(ctx context.Context, sellerId int64) (string, error)
+	SetReportingCurrency(ctx context.Context, sellerId int64, currency string) (*domain.SellerCurrency, error)
+	Convert(ctx context.Context, amount money.Money, currency string) (money.Money, error)
+}
+
+type currencyUsecase struct {
+	currencyRepo repository.CurrencyRepository
+	currencyCfg  domain.CurrencyConfig
+}
+
+func NewCurrencyUsecase(currencyRepo repository.CurrencyRepository, currencyCfg domain.CurrencyConfig) CurrencyUsecase {
+	return &currencyUsecase{
+		currencyRepo: currencyRepo,
+		currencyCfg:  currencyCfg,
+	}
+}
+
+// ExchangeRates, returns every stored exchange rate
+func (cu *currencyUsecase) ExchangeRates(ctx context.Context) ([]domain.ExchangeRate, error) {
+	return cu.currencyRepo.GetExchangeRates(ctx)
+}
+
+// SaveExchangeRates, stores the exchange rates, replacing the rate of currency pairs already stored
+func (cu *currencyUsecase) SaveExchangeRates(ctx context.Context, rates []domain.ExchangeRate) ([]domain.ExchangeRate, error) {
+	for i := range rates {
+		rates[i].BaseCurrency = strings.ToUpper(rates[i].BaseCurrency)
+		rates[i].QuoteCurrency = strings.ToUpper(rates[i].QuoteCurrency)
+	}
+
+	if err := cu.currencyRepo.SaveExchangeRates(ctx, rates); err != nil {
+		return nil, err
+	}
+
+	return cu.currencyRepo.GetExchangeRates(ctx)
+}
+
+// ReportingCurrency, returns the currency a seller's statistics are reported in, falling back to the
+// marketplace reporting currency
+func (cu *currencyUsecase) ReportingCurrency(ctx context.Context, sellerId int64) (string, error) {
+	if sellerId == domain.MarketplaceSellerID {
+		return cu.defaultCurrency(), nil
+	}
+
+	res, err := cu.currencyRepo.GetSellerCurrency(ctx, sellerId)
+	if err != nil {
+		return "", err
+	}
+
+	if res == nil {
+		return cu.defaultCurrency(), nil
+	}
+
+	return res.ReportingCurrency, nil
+}
+
+// SetReportingCurrency, sets the currency a seller's statistics are reported in
+func (cu *currencyUsecase) SetReportingCurrency(ctx context.Context, sellerId int64, currency string) (*domain.SellerCurrency, error) {
+	return cu.currencyRepo.SaveSellerCurrency(ctx, domain.SellerCurrency{
+		SellerID:          sellerId,
+		ReportingCurrency: strings.ToUpper(currency),
+	})
+}
+
+// Convert, converts amount into currency using the stored exchange rate, the inverse rate is used when
+// only the opposite currency pair is stored
+func (cu *currencyUsecase) Convert(ctx context.Context, amount money.Money, currency string) (money.Money, error) {
+	currency = strings.ToUpper(currency)
+	if amount.Currency == currency {
+		return amount, nil
+	}
+
+	rate, err := cu.currencyRepo.GetExchangeRate(ctx, amount.Currency, currency)
+	if err != nil {
+		return money.Money{}, err
+	}
+	if rate != nil {
+		return amount.Convert(rate.Rate, currency), nil
+	}
+
+	inverse, err := cu.currencyRepo.GetExchangeRate(ctx, currency, amount.Currency)
+	if err != nil {
+		return money.Money{}, err
+	}
+	if inverse != nil && inverse.Rate != 0 {
+		return amount.Convert(1/inverse.Rate, currency), nil
+	}
+
+	return money.Money{}, fmt.Errorf("%w: %s to %s", domain.ErrExchangeRateNotFound, amount.Currency, currency)
+}
+
+// defaultCurrency, the marketplace reporting currency
+func (cu *currencyUsecase) defaultCurrency() string {
+	if cu.currencyCfg.ReportingCurrency == "" {
+		return money.DefaultCurrency
+	}
+	return strings.ToUpper(cu.currencyCfg.ReportingCurrency)
+}
+
+// LoadExchangeRates, stores the exchange rates of the configured file on startup
+func LoadExchangeRates(cfg domain.CurrencyConfig, usecase CurrencyUsecase) error {
+	if cfg.ExchangeRatesFile == "" {
+		return nil
+	}
+
+	content, err := os.ReadFile(cfg.ExchangeRatesFile)
+	if err != nil {
+		return fmt.Errorf("read exchange rates file: %w", err)
+	}
+
+	var rates []domain.ExchangeRate
+	if err := json.Unmarshal(content, &rates); err != nil {
+		return fmt.Errorf("parse exchange rates file: %w", err)
+	}
+
+	if _, err := usecase.SaveExchangeRates(context.Background(), rates); err != nil {
+		return err
+	}
+
+	log.Printf("loaded %d exchange rates from %s", len(rates), cfg.ExchangeRatesFile)
+	return nil
+}
@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/statistic/domain"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/statistic/repository"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/statistic/repository/mocks"
+)
+
+func Test_currencyUsecase_Convert(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name     string
+		amount   money.Money
+		currency string
+		want     money.Money
+		wantErr  error
+		repo     func() repository.CurrencyRepository
+	}{
+		{
+			name:     "same currency",
+			amount:   money.New(1000, "IDR"),
+			currency: "idr",
+			want:     money.New(1000, "IDR"),
+			repo: func() repository.CurrencyRepository {
+				return mocks.NewMockCurrencyRepository(ctrl)
+			},
+		},
+		{
+			name:     "direct rate",
+			amount:   money.New(150, "USD"),
+			currency: "IDR",
+			want:     money.New(2325000, "IDR"),
+			repo: func() repository.CurrencyRepository {
+				m := mocks.NewMockCurrencyRepository(ctrl)
+				m.EXPECT().GetExchangeRate(gomock.Any(), "USD", "IDR").Return(&domain.ExchangeRate{Rate: 15500}, nil)
+				return m
+			},
+		},
+		{
+			name:     "inverse rate",
+			amount:   money.New(1000, "SGD"),
+			currency: "USD",
+			want:     money.New(735, "USD"),
+			repo: func() repository.CurrencyRepository {
+				m := mocks.NewMockCurrencyRepository(ctrl)
+				m.EXPECT().GetExchangeRate(gomock.Any(), "SGD", "USD").Return(nil, nil)
+				m.EXPECT().GetExchangeRate(gomock.Any(), "USD", "SGD").Return(&domain.ExchangeRate{Rate: 1.36}, nil)
+				return m
+			},
+		},
+		{
+			name:     "rate not found",
+			amount:   money.New(1000, "EUR"),
+			currency: "JPY",
+			wantErr:  domain.ErrExchangeRateNotFound,
+			repo: func() repository.CurrencyRepository {
+				m := mocks.NewMockCurrencyRepository(ctrl)
+				m.EXPECT().GetExchangeRate(gomock.Any(), "EUR", "JPY").Return(nil, nil)
+				m.EXPECT().GetExchangeRate(gomock.Any(), "JPY", "EUR").Return(nil, nil)
+				return m
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cu := NewCurrencyUsecase(tt.repo(), domain.CurrencyConfig{})
+			got, err := cu.Convert(context.TODO(), tt.amount, tt.currency)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("currencyUsecase.Convert() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("currencyUsecase.Convert() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_currencyUsecase_ReportingCurrency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name     string
+		sellerId int64
+		cfg      domain.CurrencyConfig
+		want     string
+		repo     func() repository.CurrencyRepository
+	}{
+		{
+			name:     "marketplace",
+			sellerId: domain.MarketplaceSellerID,
+			cfg:      domain.CurrencyConfig{ReportingCurrency: "usd"},
+			want:     "USD",
+			repo: func() repository.CurrencyRepository {
+				return mocks.NewMockCurrencyRepository(ctrl)
+			},
+		},
+		{
+			name:     "seller without reporting currency",
+			sellerId: 2,
+			want:     money.DefaultCurrency,
+			repo: func() repository.CurrencyRepository {
+				m := mocks.NewMockCurrencyRepository(ctrl)
+				m.EXPECT().GetSellerCurrency(gomock.Any(), int64(2)).Return(nil, nil)
+				return m
+			},
+		},
+		{
+			name:     "seller reporting currency",
+			sellerId: 2,
+			cfg:      domain.CurrencyConfig{ReportingCurrency: "IDR"},
+			want:     "SGD",
+			repo: func() repository.CurrencyRepository {
+				m := mocks.NewMockCurrencyRepository(ctrl)
+				m.EXPECT().GetSellerCurrency(gomock.Any(), int64(2)).Return(&domain.SellerCurrency{SellerID: 2, ReportingCurrency: "SGD"}, nil)
+				return m
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cu := NewCurrencyUsecase(tt.repo(), tt.cfg)
+			got, err := cu.ReportingCurrency(context.TODO(), tt.sellerId)
+			if err != nil {
+				t.Errorf("currencyUsecase.ReportingCurrency() error = %v", err)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("currencyUsecase.ReportingCurrency() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
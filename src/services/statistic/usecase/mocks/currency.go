@@ -0,0 +1,112 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: currency.go
+
+// Package mock_usecase is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	money "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/statistic/domain"
+)
+
+// MockCurrencyUsecase is a mock of CurrencyUsecase interface.
+type MockCurrencyUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCurrencyUsecaseMockRecorder
+}
+
+// MockCurrencyUsecaseMockRecorder is the mock recorder for MockCurrencyUsecase.
+type MockCurrencyUsecaseMockRecorder struct {
+	mock *MockCurrencyUsecase
+}
+
+// NewMockCurrencyUsecase creates a new mock instance.
+func NewMockCurrencyUsecase(ctrl *gomock.Controller) *MockCurrencyUsecase {
+	mock := &MockCurrencyUsecase{ctrl: ctrl}
+	mock.recorder = &MockCurrencyUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCurrencyUsecase) EXPECT() *MockCurrencyUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Convert mocks base method.
+func (m *MockCurrencyUsecase) Convert(ctx context.Context, amount money.Money, currency string) (money.Money, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Convert", ctx, amount, currency)
+	ret0, _ := ret[0].(money.Money)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Convert indicates an expected call of Convert.
+func (mr *MockCurrencyUsecaseMockRecorder) Convert(ctx, amount, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Convert", reflect.TypeOf((*MockCurrencyUsecase)(nil).Convert), ctx, amount, currency)
+}
+
+// ExchangeRates mocks base method.
+func (m *MockCurrencyUsecase) ExchangeRates(ctx context.Context) ([]domain.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExchangeRates", ctx)
+	ret0, _ := ret[0].([]domain.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExchangeRates indicates an expected call of ExchangeRates.
+func (mr *MockCurrencyUsecaseMockRecorder) ExchangeRates(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExchangeRates", reflect.TypeOf((*MockCurrencyUsecase)(nil).ExchangeRates), ctx)
+}
+
+// ReportingCurrency mocks base method.
+func (m *MockCurrencyUsecase) ReportingCurrency(ctx context.Context, sellerId int64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportingCurrency", ctx, sellerId)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReportingCurrency indicates an expected call of ReportingCurrency.
+func (mr *MockCurrencyUsecaseMockRecorder) ReportingCurrency(ctx, sellerId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportingCurrency", reflect.TypeOf((*MockCurrencyUsecase)(nil).ReportingCurrency), ctx, sellerId)
+}
+
+// SaveExchangeRates mocks base method.
+func (m *MockCurrencyUsecase) SaveExchangeRates(ctx context.Context, rates []domain.ExchangeRate) ([]domain.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveExchangeRates", ctx, rates)
+	ret0, _ := ret[0].([]domain.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveExchangeRates indicates an expected call of SaveExchangeRates.
+func (mr *MockCurrencyUsecaseMockRecorder) SaveExchangeRates(ctx, rates interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveExchangeRates", reflect.TypeOf((*MockCurrencyUsecase)(nil).SaveExchangeRates), ctx, rates)
+}
+
+// SetReportingCurrency mocks base method.
+func (m *MockCurrencyUsecase) SetReportingCurrency(ctx context.Context, sellerId int64, currency string) (*domain.SellerCurrency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReportingCurrency", ctx, sellerId, currency)
+	ret0, _ := ret[0].(*domain.SellerCurrency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetReportingCurrency indicates an expected call of SetReportingCurrency.
+func (mr *MockCurrencyUsecaseMockRecorder) SetReportingCurrency(ctx, sellerId, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReportingCurrency", reflect.TypeOf((*MockCurrencyUsecase)(nil).SetReportingCurrency), ctx, sellerId, currency)
+}
@@ -5,60 +5,120 @@
 package mocks
 
 import (
-        context "context"
-        reflect "reflect"
-        time "time"
+	context "context"
+	reflect "reflect"
+	time "time"
 
-        gomock "github.com/golang/mock/gomock"
-        domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/statistic/domain"
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/statistic/domain"
 )
 
 // MockStatisticsUsecase is a mock of StatisticsUsecase interface.
 type MockStatisticsUsecase struct {
-        ctrl     *gomock.Controller
-        recorder *MockStatisticsUsecaseMockRecorder
+	ctrl     *gomock.Controller
+	recorder *MockStatisticsUsecaseMockRecorder
 }
 
 // MockStatisticsUsecaseMockRecorder is the mock recorder for MockStatisticsUsecase.
 type MockStatisticsUsecaseMockRecorder struct {
-        mock *MockStatisticsUsecase
+	mock *MockStatisticsUsecase
 }
 
 // NewMockStatisticsUsecase creates a new mock instance.
 func NewMockStatisticsUsecase(ctrl *gomock.Controller) *MockStatisticsUsecase {
-        mock := &MockStatisticsUsecase{ctrl: ctrl}
-        mock.recorder = &MockStatisticsUsecaseMockRecorder{mock}
-        return mock
+	mock := &MockStatisticsUsecase{ctrl: ctrl}
+	mock.recorder = &MockStatisticsUsecaseMockRecorder{mock}
+	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockStatisticsUsecase) EXPECT() *MockStatisticsUsecaseMockRecorder {
-        return m.recorder
+	return m.recorder
 }
 
 // GetStatistics mocks base method.
-func (m *MockStatisticsUsecase) GetStatistics(ctx context.Context, date time.Time) (*domain.Statistics, error) {
-        m.ctrl.T.Helper()
-        ret := m.ctrl.Call(m, "GetStatistics", ctx, date)
-        ret0, _ := ret[0].(*domain.Statistics)
-        ret1, _ := ret[1].(error)
-        return ret0, ret1
+func (m *MockStatisticsUsecase) GetStatistics(ctx context.Context, sellerId int64, date time.Time) (*domain.Statistics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatistics", ctx, sellerId, date)
+	ret0, _ := ret[0].(*domain.Statistics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // GetStatistics indicates an expected call of GetStatistics.
-func (mr *MockStatisticsUsecaseMockRecorder) GetStatistics(ctx, date interface{}) *gomock.Call {
-        mr.mock.ctrl.T.Helper()
-        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatistics", reflect.TypeOf((*MockStatisticsUsecase)(nil).GetStatistics), ctx, date)
+func (mr *MockStatisticsUsecaseMockRecorder) GetStatistics(ctx, sellerId, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatistics", reflect.TypeOf((*MockStatisticsUsecase)(nil).GetStatistics), ctx, sellerId, date)
+}
+
+// HandleFunnelEvent mocks base method.
+func (m *MockStatisticsUsecase) HandleFunnelEvent(arg0 domain.PayloadEventFunnel) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleFunnelEvent", arg0)
+}
+
+// HandleFunnelEvent indicates an expected call of HandleFunnelEvent.
+func (mr *MockStatisticsUsecaseMockRecorder) HandleFunnelEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleFunnelEvent", reflect.TypeOf((*MockStatisticsUsecase)(nil).HandleFunnelEvent), arg0)
+}
+
+// HandleLowStockEvent mocks base method.
+func (m *MockStatisticsUsecase) HandleLowStockEvent(arg0 domain.PayloadEventLowStock) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleLowStockEvent", arg0)
+}
+
+// HandleLowStockEvent indicates an expected call of HandleLowStockEvent.
+func (mr *MockStatisticsUsecaseMockRecorder) HandleLowStockEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleLowStockEvent", reflect.TypeOf((*MockStatisticsUsecase)(nil).HandleLowStockEvent), arg0)
 }
 
 // HandleOrderEvent mocks base method.
 func (m *MockStatisticsUsecase) HandleOrderEvent(arg0 domain.PayloadEventOrder) {
-        m.ctrl.T.Helper()
-        m.ctrl.Call(m, "HandleOrderEvent", arg0)
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleOrderEvent", arg0)
 }
 
 // HandleOrderEvent indicates an expected call of HandleOrderEvent.
 func (mr *MockStatisticsUsecaseMockRecorder) HandleOrderEvent(arg0 interface{}) *gomock.Call {
-        mr.mock.ctrl.T.Helper()
-        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleOrderEvent", reflect.TypeOf((*MockStatisticsUsecase)(nil).HandleOrderEvent), arg0)
-}
\ No newline at end of file
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleOrderEvent", reflect.TypeOf((*MockStatisticsUsecase)(nil).HandleOrderEvent), arg0)
+}
+
+// HandlePaymentEvent mocks base method.
+func (m *MockStatisticsUsecase) HandlePaymentEvent(arg0 domain.PayloadEventPayment) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandlePaymentEvent", arg0)
+}
+
+// HandlePaymentEvent indicates an expected call of HandlePaymentEvent.
+func (mr *MockStatisticsUsecaseMockRecorder) HandlePaymentEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandlePaymentEvent", reflect.TypeOf((*MockStatisticsUsecase)(nil).HandlePaymentEvent), arg0)
+}
+
+// HandleReviewEvent mocks base method.
+func (m *MockStatisticsUsecase) HandleReviewEvent(arg0 domain.PayloadEventReview) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleReviewEvent", arg0)
+}
+
+// HandleReviewEvent indicates an expected call of HandleReviewEvent.
+func (mr *MockStatisticsUsecaseMockRecorder) HandleReviewEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleReviewEvent", reflect.TypeOf((*MockStatisticsUsecase)(nil).HandleReviewEvent), arg0)
+}
+
+// HandleShipmentEvent mocks base method.
+func (m *MockStatisticsUsecase) HandleShipmentEvent(arg0 domain.PayloadEventShipment) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleShipmentEvent", arg0)
+}
+
+// HandleShipmentEvent indicates an expected call of HandleShipmentEvent.
+func (mr *MockStatisticsUsecaseMockRecorder) HandleShipmentEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleShipmentEvent", reflect.TypeOf((*MockStatisticsUsecase)(nil).HandleShipmentEvent), arg0)
+}
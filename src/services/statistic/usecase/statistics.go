@@ -5,6 +5,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
 	buyerdomain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/statistic/domain"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/statistic/repository"
@@ -12,22 +13,30 @@ import (
 )
 
 type StatisticsUsecase interface {
-	GetStatistics(ctx context.Context, date time.Time) (*domain.Statistics, error)
+	GetStatistics(ctx context.Context, sellerId int64, date time.Time) (*domain.Statistics, error)
 	HandleOrderEvent(domain.PayloadEventOrder)
+	HandleLowStockEvent(domain.PayloadEventLowStock)
+	HandleShipmentEvent(domain.PayloadEventShipment)
+	HandlePaymentEvent(domain.PayloadEventPayment)
+	HandleReviewEvent(domain.PayloadEventReview)
+	HandleFunnelEvent(domain.PayloadEventFunnel)
 }
 
 type statisticsUsecase struct {
-	statisticsRepo repository.StatisticsRepository
+	statisticsRepo  repository.StatisticsRepository
+	currencyUsecase CurrencyUsecase
 }
 
-func NewStatisticsUsecase(statisticsRepo repository.StatisticsRepository) StatisticsUsecase {
+func NewStatisticsUsecase(statisticsRepo repository.StatisticsRepository, currencyUsecase CurrencyUsecase) StatisticsUsecase {
 	return &statisticsUsecase{
-		statisticsRepo: statisticsRepo,
+		statisticsRepo:  statisticsRepo,
+		currencyUsecase: currencyUsecase,
 	}
 }
 
-func (su *statisticsUsecase) GetStatistics(ctx context.Context, date time.Time) (*domain.Statistics, error) {
-	res, err := su.statisticsRepo.GetByDate(ctx, date)
+// GetStatistics, returns the statistics of a seller, MarketplaceSellerID returns the marketplace wide statistics
+func (su *statisticsUsecase) GetStatistics(ctx context.Context, sellerId int64, date time.Time) (*domain.Statistics, error) {
+	res, err := su.statisticsRepo.GetByDate(ctx, sellerId, date)
 	if err != nil {
 		return nil, err
 	}
@@ -35,6 +44,7 @@ func (su *statisticsUsecase) GetStatistics(ctx context.Context, date time.Time)
 	return res, nil
 }
 
+// HandleOrderEvent, updates the statistics of the order's seller as well as the marketplace wide statistics
 func (su *statisticsUsecase) HandleOrderEvent(msg domain.PayloadEventOrder) {
 	ctx := context.Background()
 
@@ -44,41 +54,327 @@ func (su *statisticsUsecase) HandleOrderEvent(msg domain.PayloadEventOrder) {
 		return
 	}
 
-	res, err := su.statisticsRepo.GetByDate(ctx, orderDate)
+	for _, sellerId := range statisticSellers(msg.SellerID) {
+		first, returning, err := su.addBuyer(ctx, sellerId, msg, orderDate)
+		if err != nil {
+			log.Println("[HandleOrderEvent] error", err)
+			return
+		}
+
+		resFinal, err := su.saveStatistics(ctx, sellerId, orderDate, func(statistics domain.Statistics) (domain.Statistics, error) {
+			return su.convertRevenue(ctx, updateBuyerData(updateStatisticsData(statistics, msg), first, returning))
+		})
+		if err != nil {
+			log.Println("[HandleOrderEvent] error", err)
+			return
+		}
+
+		err = su.statisticsRepo.PublishEvent(ctx, newStatisticEvent(*resFinal))
+		if err != nil {
+			log.Println("[HandleOrderEvent] error", err)
+		}
+	}
+}
+
+// HandleLowStockEvent, counts the low stock events of the seller as well as the marketplace wide ones
+func (su *statisticsUsecase) HandleLowStockEvent(msg domain.PayloadEventLowStock) {
+	ctx := context.Background()
+
+	date, err := time.Parse(domain.StatisticDateFormat, msg.Date)
 	if err != nil {
-		log.Println("[HandleOrderEvent] error", err)
+		log.Println("[HandleLowStockEvent] error", err)
 		return
 	}
 
-	var resFinal *domain.Statistics
-	if res == nil {
-		// statistic object not found, create new one
-		resFinal, err = su.statisticsRepo.Create(ctx, updateStatisticsData(domain.Statistics{}, msg))
+	for _, sellerId := range statisticSellers(msg.SellerID) {
+		_, err := su.saveStatistics(ctx, sellerId, date, func(statistics domain.Statistics) (domain.Statistics, error) {
+			statistics.LowStockEvents += 1
+			return statistics, nil
+		})
 		if err != nil {
-			log.Println("[HandleOrderEvent] error", err)
+			log.Println("[HandleLowStockEvent] error", err)
 			return
 		}
-	} else {
-		// statistic object found, update
-		resFinal, err = su.statisticsRepo.Update(ctx, updateStatisticsData(*res, msg))
+	}
+}
+
+// HandleShipmentEvent, records the fulfilment of the seller's orders as well as the marketplace wide one
+func (su *statisticsUsecase) HandleShipmentEvent(msg domain.PayloadEventShipment) {
+	ctx := context.Background()
+
+	date, err := time.Parse(domain.StatisticDateFormat, msg.Date)
+	if err != nil {
+		log.Println("[HandleShipmentEvent] error", err)
+		return
+	}
+
+	for _, sellerId := range statisticSellers(msg.SellerID) {
+		resFinal, err := su.saveStatistics(ctx, sellerId, date, func(statistics domain.Statistics) (domain.Statistics, error) {
+			return updateFulfilmentData(statistics, msg), nil
+		})
 		if err != nil {
-			log.Println("[HandleOrderEvent] error", err)
+			log.Println("[HandleShipmentEvent] error", err)
 			return
 		}
+
+		err = su.statisticsRepo.PublishEvent(ctx, newStatisticEvent(*resFinal))
+		if err != nil {
+			log.Println("[HandleShipmentEvent] error", err)
+		}
 	}
+}
 
-	evt := domain.PayloadEventStatistic{
-		TotalRevenue:   float64(resFinal.TotalRevenue),
-		CompletedOrder: resFinal.CompletedOrder,
-		CanceledOrder:  resFinal.CancelledOrder,
-		TotalOrder:     resFinal.TotalOrder,
-		Date:           resFinal.DateStr,
+// HandlePaymentEvent, adds a paid order to the revenue paid for of the seller as well as the marketplace wide one,
+// failed payments leave the order unpaid
+func (su *statisticsUsecase) HandlePaymentEvent(msg domain.PayloadEventPayment) {
+	if msg.Status != domain.PaymentStatusSucceeded {
+		return
 	}
 
-	err = su.statisticsRepo.PublishEvent(ctx, evt)
+	ctx := context.Background()
+
+	orderDate, err := time.Parse(domain.StatisticDateFormat, msg.OrderDate)
 	if err != nil {
-		log.Println("[HandleOrderEvent] error", err)
+		log.Println("[HandlePaymentEvent] error", err)
+		return
 	}
+
+	for _, sellerId := range statisticSellers(msg.SellerID) {
+		_, err := su.saveStatistics(ctx, sellerId, orderDate, func(statistics domain.Statistics) (domain.Statistics, error) {
+			statistics.PaidOrders += 1
+			statistics.OriginalRevenues = addOriginalPaid(statistics.OriginalRevenues, msg.Amount)
+			return su.convertRevenue(ctx, statistics)
+		})
+		if err != nil {
+			log.Println("[HandlePaymentEvent] error", err)
+			return
+		}
+	}
+}
+
+// HandleReviewEvent, counts a review into the ratings of the seller as well as the marketplace wide ones
+func (su *statisticsUsecase) HandleReviewEvent(msg domain.PayloadEventReview) {
+	ctx := context.Background()
+
+	date, err := time.Parse(domain.StatisticDateFormat, msg.Date)
+	if err != nil {
+		log.Println("[HandleReviewEvent] error", err)
+		return
+	}
+
+	for _, sellerId := range statisticSellers(msg.SellerID) {
+		resFinal, err := su.saveStatistics(ctx, sellerId, date, func(statistics domain.Statistics) (domain.Statistics, error) {
+			statistics.ReviewCount += 1
+			statistics.RatingSum += msg.Rating
+			statistics.Ratings = statistics.Ratings.Add(msg.Rating)
+			return statistics, nil
+		})
+		if err != nil {
+			log.Println("[HandleReviewEvent] error", err)
+			return
+		}
+
+		err = su.statisticsRepo.PublishEvent(ctx, newStatisticEvent(*resFinal))
+		if err != nil {
+			log.Println("[HandleReviewEvent] error", err)
+		}
+	}
+}
+
+// HandleFunnelEvent, counts a product view, add to cart or started checkout of the seller as well as the
+// marketplace wide ones
+func (su *statisticsUsecase) HandleFunnelEvent(msg domain.PayloadEventFunnel) {
+	ctx := context.Background()
+
+	date, err := time.Parse(domain.StatisticDateFormat, msg.Date)
+	if err != nil {
+		log.Println("[HandleFunnelEvent] error", err)
+		return
+	}
+
+	for _, sellerId := range statisticSellers(msg.SellerID) {
+		resFinal, err := su.saveStatistics(ctx, sellerId, date, func(statistics domain.Statistics) (domain.Statistics, error) {
+			return updateFunnelData(statistics, msg), nil
+		})
+		if err != nil {
+			log.Println("[HandleFunnelEvent] error", err)
+			return
+		}
+
+		err = su.statisticsRepo.PublishEvent(ctx, newStatisticEvent(*resFinal))
+		if err != nil {
+			log.Println("[HandleFunnelEvent] error", err)
+		}
+	}
+}
+
+// addBuyer, adds the buyer of a new order to the buyers of the seller at date, see
+// StatisticsRepository.AddBuyer, the other order events do not change the buyers
+func (su *statisticsUsecase) addBuyer(ctx context.Context, sellerId int64, msg domain.PayloadEventOrder, date time.Time) (bool, bool, error) {
+	if msg.OrderStatus != buyerdomain.OrderStatusNewInt || msg.BuyerID == 0 {
+		return false, false, nil
+	}
+	return su.statisticsRepo.AddBuyer(ctx, sellerId, msg.BuyerID, date)
+}
+
+// saveStatistics, applies update to the statistics of a seller at date, creating them when they do not exist yet
+func (su *statisticsUsecase) saveStatistics(ctx context.Context, sellerId int64, date time.Time, update func(domain.Statistics) (domain.Statistics, error)) (*domain.Statistics, error) {
+	res, err := su.statisticsRepo.GetByDate(ctx, sellerId, date)
+	if err != nil {
+		return nil, err
+	}
+
+	if res == nil {
+		// statistic object not found, create new one
+		stat, err := update(domain.Statistics{
+			SellerID: sellerId,
+			DateStr:  date.Format(domain.StatisticDateFormat),
+			Date:     datatypes.Date(date),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return su.statisticsRepo.Create(ctx, stat)
+	}
+
+	// statistic object found, update
+	stat, err := update(*res)
+	if err != nil {
+		return nil, err
+	}
+	return su.statisticsRepo.Update(ctx, stat)
+}
+
+// convertRevenue, sums the revenue and refunds of every currency into the reporting currency of the seller, the
+// whole day is converted again so a change of rate or reporting currency applies to the entire day
+func (su *statisticsUsecase) convertRevenue(ctx context.Context, statistics domain.Statistics) (domain.Statistics, error) {
+	currency, err := su.currencyUsecase.ReportingCurrency(ctx, statistics.SellerID)
+	if err != nil {
+		return statistics, err
+	}
+
+	revenue := money.New(0, currency)
+	refunded := money.New(0, currency)
+	paid := money.New(0, currency)
+	unpaid := money.New(0, currency)
+	discount := money.New(0, currency)
+	for _, v := range statistics.OriginalRevenues {
+		if revenue, err = su.addConverted(ctx, revenue, v.Revenue); err != nil {
+			return statistics, err
+		}
+		if refunded, err = su.addConverted(ctx, refunded, v.Refunded); err != nil {
+			return statistics, err
+		}
+		if paid, err = su.addConverted(ctx, paid, v.Paid); err != nil {
+			return statistics, err
+		}
+		if unpaid, err = su.addConverted(ctx, unpaid, money.New(v.Ordered.Amount-v.Paid.Amount, v.Revenue.Currency)); err != nil {
+			return statistics, err
+		}
+		if discount, err = su.addConverted(ctx, discount, v.Discount); err != nil {
+			return statistics, err
+		}
+	}
+
+	statistics.TotalRevenue = revenue
+	statistics.RefundedAmount = refunded
+	statistics.PaidRevenue = paid
+	statistics.UnpaidRevenue = unpaid
+	statistics.DiscountAmount = discount
+	statistics.GrossRevenue = money.New(revenue.Amount+discount.Amount, currency)
+	return statistics, nil
+}
+
+// addConverted, adds amount converted into the currency of total to total
+func (su *statisticsUsecase) addConverted(ctx context.Context, total, amount money.Money) (money.Money, error) {
+	if amount.IsZero() {
+		return total, nil
+	}
+
+	converted, err := su.currencyUsecase.Convert(ctx, amount, total.Currency)
+	if err != nil {
+		return total, err
+	}
+
+	return total.Add(converted)
+}
+
+// newStatisticEvent, the event published to the analytic service once statistics changed
+func newStatisticEvent(statistics domain.Statistics) domain.PayloadEventStatistic {
+	return domain.PayloadEventStatistic{
+		SellerID:         statistics.SellerID,
+		TotalRevenue:     statistics.TotalRevenue,
+		CompletedOrder:   statistics.CompletedOrder,
+		CanceledOrder:    statistics.CancelledOrder,
+		TotalOrder:       statistics.TotalOrder,
+		RefundedAmount:   statistics.RefundedAmount,
+		RefundedOrders:   statistics.RefundedOrders,
+		ShippedOrders:    statistics.ShippedOrders,
+		OnTimeShipments:  statistics.OnTimeShipments,
+		ShippingLeadTime: statistics.ShippingLeadTime,
+		DiscountAmount:   statistics.DiscountAmount,
+		ReviewCount:      statistics.ReviewCount,
+		RatingSum:        statistics.RatingSum,
+		Ratings:          statistics.Ratings,
+		ProductViews:     statistics.ProductViews,
+		CartAdds:         statistics.CartAdds,
+		CheckoutsStarted: statistics.CheckoutsStarted,
+		UniqueBuyers:     statistics.UniqueBuyers,
+		NewBuyers:        statistics.NewBuyers,
+		ReturningBuyers:  statistics.ReturningBuyers,
+		Date:             statistics.DateStr,
+	}
+}
+
+// updateFulfilmentData, counts a shipped or delivered order into statistics
+func updateFulfilmentData(statistics domain.Statistics, msg domain.PayloadEventShipment) domain.Statistics {
+	switch msg.Status {
+	case domain.ShipmentStatusShipped:
+		statistics.ShippedOrders += 1
+		statistics.ShippingLeadTime += msg.LeadTimeSeconds
+		if msg.OnTime {
+			statistics.OnTimeShipments += 1
+		}
+	case domain.ShipmentStatusDelivered:
+		statistics.DeliveredOrders += 1
+	}
+	return statistics
+}
+
+// updateFunnelData, counts a step of the funnel into statistics, unknown steps are ignored
+func updateFunnelData(statistics domain.Statistics, msg domain.PayloadEventFunnel) domain.Statistics {
+	switch msg.Step {
+	case domain.FunnelStepView:
+		statistics.ProductViews += 1
+	case domain.FunnelStepCart:
+		statistics.CartAdds += 1
+	case domain.FunnelStepCheckout:
+		statistics.CheckoutsStarted += 1
+	}
+	return statistics
+}
+
+// updateBuyerData, counts the buyer of an order into statistics on the buyer's first order of the day
+func updateBuyerData(statistics domain.Statistics, first, returning bool) domain.Statistics {
+	if !first {
+		return statistics
+	}
+
+	statistics.UniqueBuyers += 1
+	if returning {
+		statistics.ReturningBuyers += 1
+	} else {
+		statistics.NewBuyers += 1
+	}
+	return statistics
+}
+
+// statisticSellers, the sellers whose statistics are affected by an event of sellerId
+func statisticSellers(sellerId int64) []int64 {
+	if sellerId == domain.MarketplaceSellerID {
+		return []int64{domain.MarketplaceSellerID}
+	}
+	return []int64{sellerId, domain.MarketplaceSellerID}
 }
 
 func updateStatisticsData(statistics domain.Statistics, msg domain.PayloadEventOrder) (result domain.Statistics) {
@@ -89,29 +385,156 @@ func updateStatisticsData(statistics domain.Statistics, msg domain.PayloadEventO
 	}
 
 	result = domain.Statistics{
+		SellerID:         statistics.SellerID,
 		TotalRevenue:     statistics.TotalRevenue,
 		TotalProductSold: statistics.TotalProductSold,
 		CompletedOrder:   statistics.CompletedOrder,
 		CancelledOrder:   statistics.CancelledOrder,
 		TotalOrder:       statistics.TotalOrder,
+		LowStockEvents:   statistics.LowStockEvents,
+		RefundedAmount:   statistics.RefundedAmount,
+		RefundedOrders:   statistics.RefundedOrders,
+		ShippedOrders:    statistics.ShippedOrders,
+		OnTimeShipments:  statistics.OnTimeShipments,
+		ShippingLeadTime: statistics.ShippingLeadTime,
+		DeliveredOrders:  statistics.DeliveredOrders,
+		PaidOrders:       statistics.PaidOrders,
+		PaidRevenue:      statistics.PaidRevenue,
+		UnpaidRevenue:    statistics.UnpaidRevenue,
+		DiscountAmount:   statistics.DiscountAmount,
+		GrossRevenue:     statistics.GrossRevenue,
+		ReviewCount:      statistics.ReviewCount,
+		RatingSum:        statistics.RatingSum,
+		Ratings:          statistics.Ratings,
+		ProductViews:     statistics.ProductViews,
+		CartAdds:         statistics.CartAdds,
+		CheckoutsStarted: statistics.CheckoutsStarted,
+		UniqueBuyers:     statistics.UniqueBuyers,
+		NewBuyers:        statistics.NewBuyers,
+		ReturningBuyers:  statistics.ReturningBuyers,
+		OriginalRevenues: statistics.OriginalRevenues,
+		Promotions:       statistics.Promotions,
 		DateStr:          msg.OrderDate,
 		Date:             datatypes.Date(date),
 	}
 
+	// index of the promotion of the order's voucher
+	var i int
+
 	switch msg.OrderStatus {
 
 	case buyerdomain.OrderStatusCompletedInt:
-		result.TotalRevenue += int64(msg.TotalRevenue)
+		result.OriginalRevenues = addOriginalRevenue(statistics.OriginalRevenues, msg.TotalRevenue)
+		if !msg.Discount.IsZero() {
+			result.OriginalRevenues = addOriginalDiscount(result.OriginalRevenues, msg.Discount)
+		}
+		if msg.VoucherCode != "" {
+			result.Promotions, i = promotion(statistics.Promotions, msg.VoucherCode, msg.TotalRevenue.Currency)
+			result.Promotions[i].CompletedOrders += 1
+			result.Promotions[i].Discount.Amount += msg.Discount.Amount
+			result.Promotions[i].Revenue.Amount += msg.TotalRevenue.Amount
+		}
 		result.TotalProductSold += msg.TotalProductSold
 		result.CompletedOrder += 1
 		return result
 
 	case buyerdomain.OrderStatusCancelledInt:
+		// only unpaid orders can be cancelled
+		if !msg.TotalRevenue.IsZero() {
+			result.OriginalRevenues = addOriginalOrdered(statistics.OriginalRevenues, money.New(-msg.TotalRevenue.Amount, msg.TotalRevenue.Currency))
+		}
+		if msg.VoucherCode != "" {
+			result.Promotions, i = promotion(statistics.Promotions, msg.VoucherCode, msg.TotalRevenue.Currency)
+			result.Promotions[i].UsedOrders -= 1
+		}
 		result.CancelledOrder += 1
 		return result
 
+	case buyerdomain.OrderStatusRefundedInt:
+		result.OriginalRevenues = addOriginalRefund(statistics.OriginalRevenues, msg.RefundedAmount)
+		result.TotalProductSold -= msg.RefundedQuantity
+		if msg.FirstRefund {
+			result.RefundedOrders += 1
+		}
+		return result
+
 	default:
+		if !msg.TotalRevenue.IsZero() {
+			result.OriginalRevenues = addOriginalOrdered(statistics.OriginalRevenues, msg.TotalRevenue)
+		}
+		if msg.VoucherCode != "" {
+			result.Promotions, i = promotion(statistics.Promotions, msg.VoucherCode, msg.TotalRevenue.Currency)
+			result.Promotions[i].UsedOrders += 1
+		}
 		result.TotalOrder += 1
 		return result
 	}
 }
+
+// addOriginalRevenue, adds revenue to the revenue of its currency, revenues is left untouched
+func addOriginalRevenue(revenues []domain.StatisticsRevenue, revenue money.Money) []domain.StatisticsRevenue {
+	result, i := originalRevenue(revenues, revenue.Currency)
+	result[i].Revenue.Amount += revenue.Amount
+	return result
+}
+
+// addOriginalRefund, adds refunded to the refunds of its currency, revenues is left untouched
+func addOriginalRefund(revenues []domain.StatisticsRevenue, refunded money.Money) []domain.StatisticsRevenue {
+	result, i := originalRevenue(revenues, refunded.Currency)
+	result[i].Refunded = money.New(result[i].Refunded.Amount+refunded.Amount, refunded.Currency)
+	return result
+}
+
+// addOriginalOrdered, adds ordered to the amount ordered in its currency, revenues is left untouched
+func addOriginalOrdered(revenues []domain.StatisticsRevenue, ordered money.Money) []domain.StatisticsRevenue {
+	result, i := originalRevenue(revenues, ordered.Currency)
+	result[i].Ordered = money.New(result[i].Ordered.Amount+ordered.Amount, ordered.Currency)
+	return result
+}
+
+// addOriginalDiscount, adds discount to the discounts of its currency, revenues is left untouched
+func addOriginalDiscount(revenues []domain.StatisticsRevenue, discount money.Money) []domain.StatisticsRevenue {
+	result, i := originalRevenue(revenues, discount.Currency)
+	result[i].Discount = money.New(result[i].Discount.Amount+discount.Amount, discount.Currency)
+	return result
+}
+
+// addOriginalPaid, adds paid to the amount paid in its currency, revenues is left untouched
+func addOriginalPaid(revenues []domain.StatisticsRevenue, paid money.Money) []domain.StatisticsRevenue {
+	result, i := originalRevenue(revenues, paid.Currency)
+	result[i].Paid = money.New(result[i].Paid.Amount+paid.Amount, paid.Currency)
+	return result
+}
+
+// originalRevenue, copies revenues and returns the index of the revenue of currency, appending it when missing
+func originalRevenue(revenues []domain.StatisticsRevenue, currency string) ([]domain.StatisticsRevenue, int) {
+	result := make([]domain.StatisticsRevenue, len(revenues), len(revenues)+1)
+	copy(result, revenues)
+
+	for i := range result {
+		if result[i].Revenue.Currency == currency {
+			return result, i
+		}
+	}
+
+	return append(result, domain.StatisticsRevenue{Revenue: money.New(0, currency)}), len(result)
+}
+
+// promotion, copies promotions and returns the index of the promotion of voucherCode in currency, appending it
+// when missing
+func promotion(promotions []domain.StatisticsPromotion, voucherCode, currency string) ([]domain.StatisticsPromotion, int) {
+	result := make([]domain.StatisticsPromotion, len(promotions), len(promotions)+1)
+	copy(result, promotions)
+
+	for i := range result {
+		if result[i].VoucherCode == voucherCode && result[i].Revenue.Currency == currency {
+			return result, i
+		}
+	}
+
+	return append(result, domain.StatisticsPromotion{
+		VoucherCode: voucherCode,
+		Discount:    money.New(0, currency),
+		Revenue:     money.New(0, currency),
+	}), len(result)
+}
@@ -8,9 +8,12 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/pkg/money"
+	buyerdomain "github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/buyer/domain"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/statistic/domain"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/statistic/repository"
 	"github.com/tokopedia-workshop-2022/seller-analytics-solution/src/services/statistic/repository/mocks"
+	"gorm.io/datatypes"
 )
 
 func Test_statisticsUsecase_GetStatistics(t *testing.T) {
@@ -33,15 +36,15 @@ func Test_statisticsUsecase_GetStatistics(t *testing.T) {
 			wantErr: true,
 			repo: func() repository.StatisticsRepository {
 				m := mocks.NewMockStatisticsRepository(ctrl)
-				m.EXPECT().GetByDate(gomock.Any(), date).Return(nil, errors.New("mock error"))
+				m.EXPECT().GetByDate(gomock.Any(), int64(1), date).Return(nil, errors.New("mock error"))
 				return m
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			au := NewStatisticsUsecase(tt.repo())
-			got, err := au.GetStatistics(context.TODO(), tt.date)
+			au := NewStatisticsUsecase(tt.repo(), nil)
+			got, err := au.GetStatistics(context.TODO(), 1, tt.date)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("statisticsUsecase.GetStatistics() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -52,3 +55,635 @@ func Test_statisticsUsecase_GetStatistics(t *testing.T) {
 		})
 	}
 }
+
+func Test_statisticsUsecase_HandleOrderEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	date := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	m := mocks.NewMockStatisticsRepository(ctrl)
+	currencyRepo := mocks.NewMockCurrencyRepository(ctrl)
+
+	// the seller reports in SGD and already sold in SGD today
+	currencyRepo.EXPECT().GetSellerCurrency(gomock.Any(), int64(2)).Return(&domain.SellerCurrency{
+		SellerID:          2,
+		ReportingCurrency: "SGD",
+	}, nil)
+	currencyRepo.EXPECT().GetExchangeRate(gomock.Any(), "USD", "SGD").Return(&domain.ExchangeRate{
+		BaseCurrency:  "USD",
+		QuoteCurrency: "SGD",
+		Rate:          1.36,
+	}, nil)
+	m.EXPECT().GetByDate(gomock.Any(), int64(2), date).Return(&domain.Statistics{
+		SellerID:         2,
+		TotalRevenue:     money.New(1000, "SGD"),
+		CompletedOrder:   1,
+		OriginalRevenues: []domain.StatisticsRevenue{{StatisticsID: 1, Revenue: money.New(1000, "SGD")}},
+		Date:             datatypes.Date(date),
+	}, nil)
+	m.EXPECT().Update(gomock.Any(), domain.Statistics{
+		SellerID:         2,
+		TotalRevenue:     money.New(2360, "SGD"),
+		RefundedAmount:   money.New(0, "SGD"),
+		PaidRevenue:      money.New(0, "SGD"),
+		UnpaidRevenue:    money.New(0, "SGD"),
+		DiscountAmount:   money.New(0, "SGD"),
+		GrossRevenue:     money.New(2360, "SGD"),
+		TotalProductSold: 1,
+		CompletedOrder:   2,
+		OriginalRevenues: []domain.StatisticsRevenue{
+			{StatisticsID: 1, Revenue: money.New(1000, "SGD")},
+			{Revenue: money.New(1000, "USD")},
+		},
+		DateStr: "2022-01-01",
+		Date:    datatypes.Date(date),
+	}).DoAndReturn(func(ctx context.Context, stat domain.Statistics) (*domain.Statistics, error) {
+		return &stat, nil
+	})
+	m.EXPECT().PublishEvent(gomock.Any(), domain.PayloadEventStatistic{
+		SellerID:       2,
+		TotalRevenue:   money.New(2360, "SGD"),
+		RefundedAmount: money.New(0, "SGD"),
+		DiscountAmount: money.New(0, "SGD"),
+		CompletedOrder: 2,
+		Date:           "2022-01-01",
+	}).Return(nil)
+
+	// the marketplace reports in IDR, only the inverse rate is stored
+	currencyRepo.EXPECT().GetExchangeRate(gomock.Any(), "USD", "IDR").Return(nil, nil)
+	currencyRepo.EXPECT().GetExchangeRate(gomock.Any(), "IDR", "USD").Return(&domain.ExchangeRate{
+		BaseCurrency:  "IDR",
+		QuoteCurrency: "USD",
+		Rate:          0.0001,
+	}, nil)
+	m.EXPECT().GetByDate(gomock.Any(), domain.MarketplaceSellerID, date).Return(nil, nil)
+	m.EXPECT().Create(gomock.Any(), domain.Statistics{
+		TotalRevenue:     money.New(10000000, "IDR"),
+		RefundedAmount:   money.New(0, "IDR"),
+		PaidRevenue:      money.New(0, "IDR"),
+		UnpaidRevenue:    money.New(0, "IDR"),
+		DiscountAmount:   money.New(0, "IDR"),
+		GrossRevenue:     money.New(10000000, "IDR"),
+		TotalProductSold: 1,
+		CompletedOrder:   1,
+		OriginalRevenues: []domain.StatisticsRevenue{{Revenue: money.New(1000, "USD")}},
+		DateStr:          "2022-01-01",
+		Date:             datatypes.Date(date),
+	}).DoAndReturn(func(ctx context.Context, stat domain.Statistics) (*domain.Statistics, error) {
+		return &stat, nil
+	})
+	m.EXPECT().PublishEvent(gomock.Any(), domain.PayloadEventStatistic{
+		TotalRevenue:   money.New(10000000, "IDR"),
+		RefundedAmount: money.New(0, "IDR"),
+		DiscountAmount: money.New(0, "IDR"),
+		CompletedOrder: 1,
+		Date:           "2022-01-01",
+	}).Return(nil)
+
+	su := NewStatisticsUsecase(m, NewCurrencyUsecase(currencyRepo, domain.CurrencyConfig{ReportingCurrency: "IDR"}))
+	su.HandleOrderEvent(domain.PayloadEventOrder{
+		OrderID:          1,
+		SellerID:         2,
+		OrderDate:        "2022-01-01",
+		OrderStatus:      buyerdomain.OrderStatusCompletedInt,
+		TotalRevenue:     money.New(1000, "USD"),
+		TotalProductSold: 1,
+	})
+}
+
+func Test_statisticsUsecase_HandleLowStockEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	date := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	m := mocks.NewMockStatisticsRepository(ctrl)
+	// the seller's statistics do not exist yet
+	m.EXPECT().GetByDate(gomock.Any(), int64(2), date).Return(nil, nil)
+	m.EXPECT().Create(gomock.Any(), domain.Statistics{
+		SellerID:       2,
+		LowStockEvents: 1,
+		DateStr:        "2022-01-01",
+		Date:           datatypes.Date(date),
+	}).Return(&domain.Statistics{}, nil)
+	// the marketplace statistics are updated
+	m.EXPECT().GetByDate(gomock.Any(), domain.MarketplaceSellerID, date).Return(&domain.Statistics{
+		TotalOrder:     3,
+		LowStockEvents: 1,
+		Date:           datatypes.Date(date),
+	}, nil)
+	m.EXPECT().Update(gomock.Any(), domain.Statistics{
+		TotalOrder:     3,
+		LowStockEvents: 2,
+		Date:           datatypes.Date(date),
+	}).Return(&domain.Statistics{}, nil)
+
+	su := NewStatisticsUsecase(m, nil)
+	su.HandleLowStockEvent(domain.PayloadEventLowStock{
+		ProductID: 1,
+		SellerID:  2,
+		Stock:     3,
+		Date:      "2022-01-01",
+	})
+}
+
+func Test_statisticsUsecase_HandleShipmentEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	date := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	m := mocks.NewMockStatisticsRepository(ctrl)
+	// the seller's statistics do not exist yet
+	m.EXPECT().GetByDate(gomock.Any(), int64(2), date).Return(nil, nil)
+	m.EXPECT().Create(gomock.Any(), domain.Statistics{
+		SellerID:         2,
+		ShippedOrders:    1,
+		OnTimeShipments:  1,
+		ShippingLeadTime: 3600,
+		DateStr:          "2022-01-01",
+		Date:             datatypes.Date(date),
+	}).Return(&domain.Statistics{SellerID: 2, ShippedOrders: 1, OnTimeShipments: 1, ShippingLeadTime: 3600, DateStr: "2022-01-01"}, nil)
+	m.EXPECT().PublishEvent(gomock.Any(), domain.PayloadEventStatistic{
+		SellerID:         2,
+		ShippedOrders:    1,
+		OnTimeShipments:  1,
+		ShippingLeadTime: 3600,
+		Date:             "2022-01-01",
+	}).Return(nil)
+	// the marketplace statistics are updated
+	m.EXPECT().GetByDate(gomock.Any(), domain.MarketplaceSellerID, date).Return(&domain.Statistics{
+		SellerID:         domain.MarketplaceSellerID,
+		ShippedOrders:    1,
+		ShippingLeadTime: 7200,
+		DateStr:          "2022-01-01",
+		Date:             datatypes.Date(date),
+	}, nil)
+	m.EXPECT().Update(gomock.Any(), domain.Statistics{
+		SellerID:         domain.MarketplaceSellerID,
+		ShippedOrders:    2,
+		OnTimeShipments:  1,
+		ShippingLeadTime: 10800,
+		DateStr:          "2022-01-01",
+		Date:             datatypes.Date(date),
+	}).Return(&domain.Statistics{SellerID: domain.MarketplaceSellerID, ShippedOrders: 2, OnTimeShipments: 1, ShippingLeadTime: 10800, DateStr: "2022-01-01"}, nil)
+	m.EXPECT().PublishEvent(gomock.Any(), domain.PayloadEventStatistic{
+		SellerID:         domain.MarketplaceSellerID,
+		ShippedOrders:    2,
+		OnTimeShipments:  1,
+		ShippingLeadTime: 10800,
+		Date:             "2022-01-01",
+	}).Return(nil)
+
+	su := NewStatisticsUsecase(m, nil)
+	su.HandleShipmentEvent(domain.PayloadEventShipment{
+		OrderID:         1,
+		SellerID:        2,
+		Status:          domain.ShipmentStatusShipped,
+		LeadTimeSeconds: 3600,
+		OnTime:          true,
+		Date:            "2022-01-01",
+	})
+}
+
+func Test_statisticsUsecase_HandlePaymentEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	date := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	m := mocks.NewMockStatisticsRepository(ctrl)
+	currencyRepo := mocks.NewMockCurrencyRepository(ctrl)
+	currencyRepo.EXPECT().GetSellerCurrency(gomock.Any(), int64(2)).Return(nil, nil)
+
+	// two orders of the day were placed, one of them gets paid
+	for _, sellerId := range []int64{2, domain.MarketplaceSellerID} {
+		m.EXPECT().GetByDate(gomock.Any(), sellerId, date).Return(&domain.Statistics{
+			SellerID:         sellerId,
+			TotalOrder:       2,
+			OriginalRevenues: []domain.StatisticsRevenue{{Revenue: money.New(0, "IDR"), Ordered: money.New(300, "IDR")}},
+			Date:             datatypes.Date(date),
+		}, nil)
+		m.EXPECT().Update(gomock.Any(), domain.Statistics{
+			SellerID:         sellerId,
+			TotalRevenue:     money.New(0, "IDR"),
+			RefundedAmount:   money.New(0, "IDR"),
+			PaidRevenue:      money.New(100, "IDR"),
+			UnpaidRevenue:    money.New(200, "IDR"),
+			DiscountAmount:   money.New(0, "IDR"),
+			GrossRevenue:     money.New(0, "IDR"),
+			TotalOrder:       2,
+			PaidOrders:       1,
+			OriginalRevenues: []domain.StatisticsRevenue{{Revenue: money.New(0, "IDR"), Ordered: money.New(300, "IDR"), Paid: money.New(100, "IDR")}},
+			Date:             datatypes.Date(date),
+		}).Return(&domain.Statistics{}, nil)
+	}
+
+	su := NewStatisticsUsecase(m, NewCurrencyUsecase(currencyRepo, domain.CurrencyConfig{ReportingCurrency: "IDR"}))
+	su.HandlePaymentEvent(domain.PayloadEventPayment{
+		OrderID:   1,
+		SellerID:  2,
+		Status:    "succeeded",
+		Amount:    money.New(100, "IDR"),
+		OrderDate: "2022-01-01",
+	})
+	// failed payments leave the statistics untouched
+	su.HandlePaymentEvent(domain.PayloadEventPayment{
+		OrderID:   2,
+		SellerID:  2,
+		Status:    "failed",
+		Amount:    money.New(200, "IDR"),
+		OrderDate: "2022-01-01",
+	})
+}
+
+func Test_statisticsUsecase_HandleReviewEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	date := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	m := mocks.NewMockStatisticsRepository(ctrl)
+
+	// the seller already got a 5 star review that day, the marketplace has no statistics for the day yet
+	m.EXPECT().GetByDate(gomock.Any(), int64(2), date).Return(&domain.Statistics{
+		SellerID:    2,
+		ReviewCount: 1,
+		RatingSum:   5,
+		Ratings:     domain.RatingDistribution{Five: 1},
+		DateStr:     "2022-01-01",
+		Date:        datatypes.Date(date),
+	}, nil)
+	m.EXPECT().Update(gomock.Any(), domain.Statistics{
+		SellerID:    2,
+		ReviewCount: 2,
+		RatingSum:   8,
+		Ratings:     domain.RatingDistribution{Three: 1, Five: 1},
+		DateStr:     "2022-01-01",
+		Date:        datatypes.Date(date),
+	}).Return(&domain.Statistics{SellerID: 2, ReviewCount: 2, RatingSum: 8, Ratings: domain.RatingDistribution{Three: 1, Five: 1}, DateStr: "2022-01-01"}, nil)
+	m.EXPECT().PublishEvent(gomock.Any(), domain.PayloadEventStatistic{
+		SellerID:    2,
+		ReviewCount: 2,
+		RatingSum:   8,
+		Ratings:     domain.RatingDistribution{Three: 1, Five: 1},
+		Date:        "2022-01-01",
+	}).Return(nil)
+
+	m.EXPECT().GetByDate(gomock.Any(), domain.MarketplaceSellerID, date).Return(nil, nil)
+	m.EXPECT().Create(gomock.Any(), domain.Statistics{
+		SellerID:    domain.MarketplaceSellerID,
+		ReviewCount: 1,
+		RatingSum:   3,
+		Ratings:     domain.RatingDistribution{Three: 1},
+		DateStr:     "2022-01-01",
+		Date:        datatypes.Date(date),
+	}).Return(&domain.Statistics{SellerID: domain.MarketplaceSellerID, ReviewCount: 1, RatingSum: 3, Ratings: domain.RatingDistribution{Three: 1}, DateStr: "2022-01-01"}, nil)
+	m.EXPECT().PublishEvent(gomock.Any(), domain.PayloadEventStatistic{
+		SellerID:    domain.MarketplaceSellerID,
+		ReviewCount: 1,
+		RatingSum:   3,
+		Ratings:     domain.RatingDistribution{Three: 1},
+		Date:        "2022-01-01",
+	}).Return(nil)
+
+	su := NewStatisticsUsecase(m, nil)
+	su.HandleReviewEvent(domain.PayloadEventReview{
+		ReviewID:  1,
+		OrderID:   1,
+		ProductID: 1,
+		SellerID:  2,
+		Rating:    3,
+		Date:      "2022-01-01",
+	})
+}
+
+func Test_updateFulfilmentData(t *testing.T) {
+	statistics := domain.Statistics{
+		SellerID:         2,
+		ShippedOrders:    1,
+		ShippingLeadTime: 100,
+	}
+
+	tests := []struct {
+		name string
+		msg  domain.PayloadEventShipment
+		want domain.Statistics
+	}{
+		{
+			name: "shipped on time",
+			msg:  domain.PayloadEventShipment{Status: domain.ShipmentStatusShipped, LeadTimeSeconds: 50, OnTime: true},
+			want: domain.Statistics{SellerID: 2, ShippedOrders: 2, OnTimeShipments: 1, ShippingLeadTime: 150},
+		},
+		{
+			name: "shipped late",
+			msg:  domain.PayloadEventShipment{Status: domain.ShipmentStatusShipped, LeadTimeSeconds: 500},
+			want: domain.Statistics{SellerID: 2, ShippedOrders: 2, ShippingLeadTime: 600},
+		},
+		{
+			name: "delivered",
+			msg:  domain.PayloadEventShipment{Status: domain.ShipmentStatusDelivered, LeadTimeSeconds: 900},
+			want: domain.Statistics{SellerID: 2, ShippedOrders: 1, ShippingLeadTime: 100, DeliveredOrders: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := updateFulfilmentData(statistics, tt.msg); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("updateFulfilmentData() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_statisticsUsecase_addBuyer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	date := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	m := mocks.NewMockStatisticsRepository(ctrl)
+	su := &statisticsUsecase{statisticsRepo: m}
+
+	tests := []struct {
+		name          string
+		msg           domain.PayloadEventOrder
+		mock          func()
+		wantFirst     bool
+		wantReturning bool
+	}{
+		{
+			name: "returning buyer",
+			msg:  domain.PayloadEventOrder{SellerID: 2, BuyerID: 7, OrderStatus: buyerdomain.OrderStatusNewInt},
+			mock: func() {
+				m.EXPECT().AddBuyer(gomock.Any(), int64(2), int64(7), date).Return(true, true, nil)
+			},
+			wantFirst:     true,
+			wantReturning: true,
+		},
+		{
+			name: "completed order",
+			msg:  domain.PayloadEventOrder{SellerID: 2, BuyerID: 7, OrderStatus: buyerdomain.OrderStatusCompletedInt},
+			mock: func() {},
+		},
+		{
+			name: "order without buyer",
+			msg:  domain.PayloadEventOrder{SellerID: 2, OrderStatus: buyerdomain.OrderStatusNewInt},
+			mock: func() {},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			first, returning, err := su.addBuyer(context.TODO(), 2, tt.msg, date)
+			if err != nil {
+				t.Errorf("statisticsUsecase.addBuyer() error = %v", err)
+				return
+			}
+			if first != tt.wantFirst || returning != tt.wantReturning {
+				t.Errorf("statisticsUsecase.addBuyer() = %v, %v, want %v, %v", first, returning, tt.wantFirst, tt.wantReturning)
+			}
+		})
+	}
+}
+
+func Test_updateBuyerData(t *testing.T) {
+	statistics := domain.Statistics{SellerID: 2, UniqueBuyers: 3, NewBuyers: 2, ReturningBuyers: 1}
+
+	tests := []struct {
+		name      string
+		first     bool
+		returning bool
+		want      domain.Statistics
+	}{
+		{
+			name:  "new buyer",
+			first: true,
+			want:  domain.Statistics{SellerID: 2, UniqueBuyers: 4, NewBuyers: 3, ReturningBuyers: 1},
+		},
+		{
+			name:      "returning buyer",
+			first:     true,
+			returning: true,
+			want:      domain.Statistics{SellerID: 2, UniqueBuyers: 4, NewBuyers: 2, ReturningBuyers: 2},
+		},
+		{
+			name:      "buyer already ordered today",
+			returning: true,
+			want:      statistics,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := updateBuyerData(statistics, tt.first, tt.returning); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("updateBuyerData() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_updateFunnelData(t *testing.T) {
+	statistics := domain.Statistics{
+		SellerID:         2,
+		ProductViews:     10,
+		CartAdds:         4,
+		CheckoutsStarted: 2,
+	}
+
+	tests := []struct {
+		name string
+		msg  domain.PayloadEventFunnel
+		want domain.Statistics
+	}{
+		{
+			name: "product viewed",
+			msg:  domain.PayloadEventFunnel{Step: domain.FunnelStepView, SellerID: 2, ProductID: 1},
+			want: domain.Statistics{SellerID: 2, ProductViews: 11, CartAdds: 4, CheckoutsStarted: 2},
+		},
+		{
+			name: "added to cart",
+			msg:  domain.PayloadEventFunnel{Step: domain.FunnelStepCart, SellerID: 2, ProductID: 1},
+			want: domain.Statistics{SellerID: 2, ProductViews: 10, CartAdds: 5, CheckoutsStarted: 2},
+		},
+		{
+			name: "checkout started",
+			msg:  domain.PayloadEventFunnel{Step: domain.FunnelStepCheckout, SellerID: 2},
+			want: domain.Statistics{SellerID: 2, ProductViews: 10, CartAdds: 4, CheckoutsStarted: 3},
+		},
+		{
+			name: "unknown step",
+			msg:  domain.PayloadEventFunnel{Step: "wishlist", SellerID: 2, ProductID: 1},
+			want: statistics,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := updateFunnelData(statistics, tt.msg); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("updateFunnelData() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_updateStatisticsData(t *testing.T) {
+	date := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	statistics := domain.Statistics{
+		SellerID:         2,
+		TotalProductSold: 3,
+		TotalOrder:       2,
+		LowStockEvents:   1,
+	}
+
+	tests := []struct {
+		name string
+		msg  domain.PayloadEventOrder
+		want domain.Statistics
+	}{
+		{
+			name: "new order",
+			msg: domain.PayloadEventOrder{
+				SellerID:    2,
+				OrderDate:   "2022-01-01",
+				OrderStatus: buyerdomain.OrderStatusNewInt,
+			},
+			want: domain.Statistics{
+				SellerID:         2,
+				TotalProductSold: 3,
+				TotalOrder:       3,
+				LowStockEvents:   1,
+				DateStr:          "2022-01-01",
+				Date:             datatypes.Date(date),
+			},
+		},
+		{
+			name: "new order awaiting payment",
+			msg: domain.PayloadEventOrder{
+				SellerID:     2,
+				OrderDate:    "2022-01-01",
+				OrderStatus:  buyerdomain.OrderStatusNewInt,
+				TotalRevenue: money.New(100, "IDR"),
+			},
+			want: domain.Statistics{
+				SellerID:         2,
+				OriginalRevenues: []domain.StatisticsRevenue{{Revenue: money.New(0, "IDR"), Ordered: money.New(100, "IDR")}},
+				TotalProductSold: 3,
+				TotalOrder:       3,
+				LowStockEvents:   1,
+				DateStr:          "2022-01-01",
+				Date:             datatypes.Date(date),
+			},
+		},
+		{
+			name: "cancelled order",
+			msg: domain.PayloadEventOrder{
+				SellerID:     2,
+				OrderDate:    "2022-01-01",
+				OrderStatus:  buyerdomain.OrderStatusCancelledInt,
+				TotalRevenue: money.New(100, "IDR"),
+			},
+			want: domain.Statistics{
+				SellerID:         2,
+				OriginalRevenues: []domain.StatisticsRevenue{{Revenue: money.New(0, "IDR"), Ordered: money.New(-100, "IDR")}},
+				TotalProductSold: 3,
+				CancelledOrder:   1,
+				TotalOrder:       2,
+				LowStockEvents:   1,
+				DateStr:          "2022-01-01",
+				Date:             datatypes.Date(date),
+			},
+		},
+		{
+			name: "completed order",
+			msg: domain.PayloadEventOrder{
+				SellerID:         2,
+				OrderDate:        "2022-01-01",
+				OrderStatus:      buyerdomain.OrderStatusCompletedInt,
+				TotalRevenue:     money.New(100, "IDR"),
+				TotalProductSold: 2,
+			},
+			want: domain.Statistics{
+				SellerID:         2,
+				OriginalRevenues: []domain.StatisticsRevenue{{Revenue: money.New(100, "IDR")}},
+				TotalProductSold: 5,
+				CompletedOrder:   1,
+				TotalOrder:       2,
+				LowStockEvents:   1,
+				DateStr:          "2022-01-01",
+				Date:             datatypes.Date(date),
+			},
+		},
+		{
+			name: "new order with voucher",
+			msg: domain.PayloadEventOrder{
+				SellerID:     2,
+				OrderDate:    "2022-01-01",
+				OrderStatus:  buyerdomain.OrderStatusNewInt,
+				TotalRevenue: money.New(90, "IDR"),
+				Discount:     money.New(10, "IDR"),
+				VoucherCode:  "PROMO10",
+			},
+			want: domain.Statistics{
+				SellerID:         2,
+				OriginalRevenues: []domain.StatisticsRevenue{{Revenue: money.New(0, "IDR"), Ordered: money.New(90, "IDR")}},
+				Promotions:       []domain.StatisticsPromotion{{VoucherCode: "PROMO10", UsedOrders: 1, Discount: money.New(0, "IDR"), Revenue: money.New(0, "IDR")}},
+				TotalProductSold: 3,
+				TotalOrder:       3,
+				LowStockEvents:   1,
+				DateStr:          "2022-01-01",
+				Date:             datatypes.Date(date),
+			},
+		},
+		{
+			name: "completed order with voucher",
+			msg: domain.PayloadEventOrder{
+				SellerID:         2,
+				OrderDate:        "2022-01-01",
+				OrderStatus:      buyerdomain.OrderStatusCompletedInt,
+				TotalRevenue:     money.New(90, "IDR"),
+				TotalProductSold: 2,
+				Discount:         money.New(10, "IDR"),
+				VoucherCode:      "PROMO10",
+			},
+			want: domain.Statistics{
+				SellerID:         2,
+				OriginalRevenues: []domain.StatisticsRevenue{{Revenue: money.New(90, "IDR"), Discount: money.New(10, "IDR")}},
+				Promotions:       []domain.StatisticsPromotion{{VoucherCode: "PROMO10", CompletedOrders: 1, Discount: money.New(10, "IDR"), Revenue: money.New(90, "IDR")}},
+				TotalProductSold: 5,
+				CompletedOrder:   1,
+				TotalOrder:       2,
+				LowStockEvents:   1,
+				DateStr:          "2022-01-01",
+				Date:             datatypes.Date(date),
+			},
+		},
+		{
+			name: "refunded order",
+			msg: domain.PayloadEventOrder{
+				SellerID:         2,
+				OrderDate:        "2022-01-01",
+				OrderStatus:      buyerdomain.OrderStatusRefundedInt,
+				RefundedAmount:   money.New(40, "IDR"),
+				RefundedQuantity: 1,
+				FirstRefund:      true,
+			},
+			want: domain.Statistics{
+				SellerID:         2,
+				OriginalRevenues: []domain.StatisticsRevenue{{Revenue: money.New(0, "IDR"), Refunded: money.New(40, "IDR")}},
+				TotalProductSold: 2,
+				TotalOrder:       2,
+				LowStockEvents:   1,
+				RefundedOrders:   1,
+				DateStr:          "2022-01-01",
+				Date:             datatypes.Date(date),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := updateStatisticsData(statistics, tt.msg); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("updateStatisticsData() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,4 +4,6 @@ import "go.uber.org/fx"
 
 var Module = fx.Options(
 	fx.Provide(NewStatisticsUsecase),
+	fx.Provide(NewCurrencyUsecase),
+	fx.Invoke(LoadExchangeRates),
 )